@@ -29,12 +29,13 @@ Examples:
 
 	mainCommands = `Main Commands:
   build-images Build images
-  deploy       Run your application on the cluster in the Deploy mode
-  dev          Run your application on the cluster in the Dev mode
+  deploy       Run your application on the cluster in the Deploy mode (diff, export, history, rollback)
+  dev          Run your application on the cluster in the Dev mode (attach)
   init         Init bootstraps a new project
   logs         Show logs of all containers of the component
   registry     List all components from the Devfile registry
   run          Run a specific command in the Dev mode
+  sync         Synchronize files between the local directory and the Dev container (pull)
 
 `
 
@@ -6,6 +6,9 @@ type Component struct {
 	DevfileData       *DevfileData      `json:"devfileData,omitempty"`
 	DevControlPlane   []DevControlPlane `json:"devControlPlane,omitempty"`
 	DevForwardedPorts []ForwardedPort   `json:"devForwardedPorts,omitempty"`
+	// DevApplicationStatus is the status of the application started by the Dev sessions, possibly per platform,
+	// when the run (or debug) command declares log patterns
+	DevApplicationStatus []ApplicationStatus `json:"devApplicationStatus,omitempty"`
 	// RunningIn is the overall running mode map of the component;
 	// this is computing as a merge of RunningOn (all the different running modes
 	// for each platform the component is running on).
@@ -28,6 +31,10 @@ type ForwardedPort struct {
 	ContainerPort int    `json:"containerPort"`
 	Exposure      string `json:"exposure,omitempty"`
 	Protocol      string `json:"protocol,omitempty"`
+	// IsReverse indicates that the port, inside the container, tunnels back to the local address and port
+	IsReverse bool `json:"isReverse,omitempty"`
+	// IsDynamic indicates that the port has been forwarded during the session, in addition to the ports forwarded at startup
+	IsDynamic bool `json:"isDynamic,omitempty"`
 }
 
 func (o ForwardedPort) GetPlatform() string {
@@ -45,6 +52,21 @@ func (o DevControlPlane) GetPlatform() string {
 	return o.Platform
 }
 
+// ApplicationStatus describes the status of the application started by the run (or debug) command of a Dev session,
+// as reported by the lines of its logs matching the patterns declared in the Devfile
+type ApplicationStatus struct {
+	Platform string `json:"platform,omitempty"`
+	// Ready indicates that the application is ready: a line of the logs matched the ready pattern
+	// since the command was last started, or no ready pattern is declared
+	Ready bool `json:"ready"`
+	// Errors are the last lines of the logs matching the error pattern since the command was last started
+	Errors []ContainerLogLine `json:"errors,omitempty"`
+}
+
+func (o ApplicationStatus) GetPlatform() string {
+	return o.Platform
+}
+
 type ConnectionData struct {
 	Name  string  `json:"name"`
 	Rules []Rules `json:"rules,omitempty"`
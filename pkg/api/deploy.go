@@ -0,0 +1,68 @@
+package api
+
+import "time"
+
+type ResourceDiffStatus string
+
+const (
+	// ResourceDiffCreated indicates that the resource does not exist on the cluster and would be created
+	ResourceDiffCreated ResourceDiffStatus = "created"
+	// ResourceDiffUpdated indicates that the resource exists on the cluster and would be modified
+	ResourceDiffUpdated ResourceDiffStatus = "updated"
+	// ResourceDiffUnchanged indicates that the resource exists on the cluster and would not be modified
+	ResourceDiffUnchanged ResourceDiffStatus = "unchanged"
+	// ResourceDiffDeleted indicates that the resource exists on the cluster but not in the Devfile, and would be pruned
+	ResourceDiffDeleted ResourceDiffStatus = "deleted"
+)
+
+// DeployDiff describes the changes the Deploy mode would make on the cluster
+type DeployDiff struct {
+	Resources []ResourceDiff `json:"resources"`
+	// Images are the names of the images which would be built and pushed
+	Images []string `json:"images,omitempty"`
+}
+
+// ResourceDiff describes the changes the Deploy mode would make to a resource
+type ResourceDiff struct {
+	Kind   string             `json:"kind"`
+	Name   string             `json:"name"`
+	Status ResourceDiffStatus `json:"status"`
+	// Diff is the unified diff between the resource currently deployed and the resource after the changes,
+	// empty if the resource is unchanged
+	Diff string `json:"diff,omitempty"`
+}
+
+// HasChanges returns true if at least one resource would be created, updated or deleted
+func (o DeployDiff) HasChanges() bool {
+	for _, r := range o.Resources {
+		if r.Status != ResourceDiffUnchanged {
+			return true
+		}
+	}
+	return false
+}
+
+// DeployHistory lists the deployments recorded by the Deploy mode
+type DeployHistory struct {
+	Revisions []DeployRevision `json:"revisions"`
+}
+
+// DeployRevision describes a deployment recorded by the Deploy mode
+type DeployRevision struct {
+	Revision int       `json:"revision"`
+	Date     time.Time `json:"date"`
+	// Images are the references of the images built and pushed by the deployment
+	Images []string `json:"images,omitempty"`
+	// ImageDigests are the digests of the images pushed by the deployment, by reference
+	ImageDigests map[string]string `json:"imageDigests,omitempty"`
+	// Resources are the kinds and names of the resources applied by the deployment
+	Resources []DeployedResource `json:"resources"`
+	// RollbackOf is the revision reapplied by this revision, or zero if the revision is not a rollback
+	RollbackOf int `json:"rollbackOf,omitempty"`
+}
+
+// DeployedResource identifies a resource applied by a deployment
+type DeployedResource struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+}
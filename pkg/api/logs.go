@@ -0,0 +1,13 @@
+package api
+
+// ContainerLogLine is a line of the logs of a container of the component
+type ContainerLogLine struct {
+	// Pod is the name of the pod, not known for the logs recorded by a session
+	Pod       string `json:"pod,omitempty"`
+	Container string `json:"container"`
+	// Mode is the running mode of the pod, either Dev or Deploy
+	Mode string `json:"mode"`
+	// Timestamp is the RFC3339 timestamp of the line, as returned by the platform
+	Timestamp string `json:"timestamp,omitempty"`
+	Message   string `json:"message"`
+}
@@ -16,6 +16,13 @@ package api
   "podman": {
     "client": {
       "version": "4.5.1"
+    },
+    "server": {
+      "version": "4.5.1"
+    },
+    "connection": {
+      "name": "podman-machine-default-root",
+      "rootless": false
     }
   }
 }
@@ -40,6 +47,16 @@ type ClusterClientInfo struct {
 
 type PodmanInfo struct {
 	Client *PodmanClientInfo `json:"client,omitempty"`
+	// Server is the version of the Podman service, for a remote connection only
+	Server     *PodmanClientInfo     `json:"server,omitempty"`
+	Connection *PodmanConnectionInfo `json:"connection,omitempty"`
+}
+
+type PodmanConnectionInfo struct {
+	// Name is the name of the system connection, or the URL of the Podman service; empty for the default connection
+	Name string `json:"name,omitempty"`
+	// Rootless is true if the Podman service runs as a non-root user
+	Rootless bool `json:"rootless"`
 }
 
 type PodmanClientInfo struct {
@@ -20,6 +20,9 @@ import (
 type DefaultApiRouter interface {
 	ComponentCommandPost(http.ResponseWriter, *http.Request)
 	ComponentGet(http.ResponseWriter, *http.Request)
+	ComponentPortForwardGet(http.ResponseWriter, *http.Request)
+	ComponentPortForwardLocalPortDelete(http.ResponseWriter, *http.Request)
+	ComponentPortForwardPost(http.ResponseWriter, *http.Request)
 	DevfileGet(http.ResponseWriter, *http.Request)
 	DevfilePut(http.ResponseWriter, *http.Request)
 	InstanceDelete(http.ResponseWriter, *http.Request)
@@ -69,6 +72,9 @@ type DevstateApiRouter interface {
 type DefaultApiServicer interface {
 	ComponentCommandPost(context.Context, ComponentCommandPostRequest) (ImplResponse, error)
 	ComponentGet(context.Context) (ImplResponse, error)
+	ComponentPortForwardGet(context.Context) (ImplResponse, error)
+	ComponentPortForwardLocalPortDelete(context.Context, int32) (ImplResponse, error)
+	ComponentPortForwardPost(context.Context, ComponentPortForwardPostRequest) (ImplResponse, error)
 	DevfileGet(context.Context) (ImplResponse, error)
 	DevfilePut(context.Context, DevfilePutRequest) (ImplResponse, error)
 	InstanceDelete(context.Context) (ImplResponse, error)
@@ -13,6 +13,8 @@ import (
 	"encoding/json"
 	"net/http"
 	"strings"
+
+	"github.com/gorilla/mux"
 )
 
 // DefaultApiController binds http requests to an api service and writes the service results to the http response
@@ -60,6 +62,24 @@ func (c *DefaultApiController) Routes() Routes {
 			"/api/v1/component",
 			c.ComponentGet,
 		},
+		{
+			"ComponentPortForwardGet",
+			strings.ToUpper("Get"),
+			"/api/v1/component/portForward",
+			c.ComponentPortForwardGet,
+		},
+		{
+			"ComponentPortForwardLocalPortDelete",
+			strings.ToUpper("Delete"),
+			"/api/v1/component/portForward/{localPort}",
+			c.ComponentPortForwardLocalPortDelete,
+		},
+		{
+			"ComponentPortForwardPost",
+			strings.ToUpper("Post"),
+			"/api/v1/component/portForward",
+			c.ComponentPortForwardPost,
+		},
 		{
 			"DevfileGet",
 			strings.ToUpper("Get"),
@@ -130,6 +150,63 @@ func (c *DefaultApiController) ComponentGet(w http.ResponseWriter, r *http.Reque
 
 }
 
+// ComponentPortForwardGet -
+func (c *DefaultApiController) ComponentPortForwardGet(w http.ResponseWriter, r *http.Request) {
+	result, err := c.service.ComponentPortForwardGet(r.Context())
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// ComponentPortForwardLocalPortDelete -
+func (c *DefaultApiController) ComponentPortForwardLocalPortDelete(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	localPortParam, err := parseInt32Parameter(params["localPort"], true)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+
+	result, err := c.service.ComponentPortForwardLocalPortDelete(r.Context(), localPortParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// ComponentPortForwardPost -
+func (c *DefaultApiController) ComponentPortForwardPost(w http.ResponseWriter, r *http.Request) {
+	componentPortForwardPostRequestParam := ComponentPortForwardPostRequest{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&componentPortForwardPostRequestParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertComponentPortForwardPostRequestRequired(componentPortForwardPostRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.ComponentPortForwardPost(r.Context(), componentPortForwardPostRequestParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
 // DevfileGet -
 func (c *DefaultApiController) DevfileGet(w http.ResponseWriter, r *http.Request) {
 	result, err := c.service.DevfileGet(r.Context())
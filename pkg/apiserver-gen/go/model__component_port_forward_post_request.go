@@ -0,0 +1,49 @@
+/*
+ * odo dev
+ *
+ * API interface for 'odo dev'
+ *
+ * API version: 0.1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+type ComponentPortForwardPostRequest struct {
+
+	// Name of the container
+	ContainerName string `json:"containerName"`
+
+	// Port in the container
+	ContainerPort int32 `json:"containerPort"`
+
+	// Local port to forward; a free port is assigned if not specified
+	LocalPort int32 `json:"localPort,omitempty"`
+}
+
+// AssertComponentPortForwardPostRequestRequired checks if the required fields are not zero-ed
+func AssertComponentPortForwardPostRequestRequired(obj ComponentPortForwardPostRequest) error {
+	elements := map[string]interface{}{
+		"containerName": obj.ContainerName,
+		"containerPort": obj.ContainerPort,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertRecurseComponentPortForwardPostRequestRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of ComponentPortForwardPostRequest (e.g. [][]ComponentPortForwardPostRequest), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseComponentPortForwardPostRequestRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aComponentPortForwardPostRequest, ok := obj.(ComponentPortForwardPostRequest)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertComponentPortForwardPostRequestRequired(aComponentPortForwardPostRequest)
+	})
+}
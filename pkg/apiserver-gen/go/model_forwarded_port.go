@@ -0,0 +1,63 @@
+/*
+ * odo dev
+ *
+ * API interface for 'odo dev'
+ *
+ * API version: 0.1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+type ForwardedPort struct {
+	Platform string `json:"platform,omitempty"`
+
+	ContainerName string `json:"containerName"`
+
+	PortName string `json:"portName,omitempty"`
+
+	IsDebug bool `json:"isDebug,omitempty"`
+
+	LocalAddress string `json:"localAddress"`
+
+	LocalPort int32 `json:"localPort"`
+
+	ContainerPort int32 `json:"containerPort"`
+
+	Exposure string `json:"exposure,omitempty"`
+
+	Protocol string `json:"protocol,omitempty"`
+
+	IsReverse bool `json:"isReverse,omitempty"`
+
+	IsDynamic bool `json:"isDynamic,omitempty"`
+}
+
+// AssertForwardedPortRequired checks if the required fields are not zero-ed
+func AssertForwardedPortRequired(obj ForwardedPort) error {
+	elements := map[string]interface{}{
+		"containerName": obj.ContainerName,
+		"localAddress":  obj.LocalAddress,
+		"localPort":     obj.LocalPort,
+		"containerPort": obj.ContainerPort,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertRecurseForwardedPortRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of ForwardedPort (e.g. [][]ForwardedPort), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseForwardedPortRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aForwardedPort, ok := obj.(ForwardedPort)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertForwardedPortRequired(aForwardedPort)
+	})
+}
@@ -2,20 +2,27 @@ package apiserver_impl
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
 	"path/filepath"
 
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
+
+	"github.com/redhat-developer/odo/pkg/api"
 	openapi "github.com/redhat-developer/odo/pkg/apiserver-gen/go"
 	"github.com/redhat-developer/odo/pkg/apiserver-impl/devstate"
 	"github.com/redhat-developer/odo/pkg/component/describe"
 	"github.com/redhat-developer/odo/pkg/devfile"
 	"github.com/redhat-developer/odo/pkg/devfile/validate"
 	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/log"
 	fcontext "github.com/redhat-developer/odo/pkg/odo/commonflags/context"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
 	"github.com/redhat-developer/odo/pkg/podman"
+	"github.com/redhat-developer/odo/pkg/portForward"
 	"github.com/redhat-developer/odo/pkg/preference"
 	"github.com/redhat-developer/odo/pkg/segment"
 	scontext "github.com/redhat-developer/odo/pkg/segment/context"
@@ -27,12 +34,13 @@ import (
 // This service should implement the business logic for every endpoint for the DefaultApi API.
 // Include any external packages or services that will be required by this service.
 type DefaultApiService struct {
-	cancel           context.CancelFunc
-	pushWatcher      chan<- struct{}
-	kubeClient       kclient.ClientInterface
-	podmanClient     podman.Client
-	stateClient      state.Client
-	preferenceClient preference.Client
+	cancel            context.CancelFunc
+	pushWatcher       chan<- struct{}
+	kubeClient        kclient.ClientInterface
+	podmanClient      podman.Client
+	stateClient       state.Client
+	portForwardClient portForward.Client
+	preferenceClient  preference.Client
 
 	devfileState devstate.DevfileState
 }
@@ -44,15 +52,17 @@ func NewDefaultApiService(
 	kubeClient kclient.ClientInterface,
 	podmanClient podman.Client,
 	stateClient state.Client,
+	portForwardClient portForward.Client,
 	preferenceClient preference.Client,
 ) openapi.DefaultApiServicer {
 	return &DefaultApiService{
-		cancel:           cancel,
-		pushWatcher:      pushWatcher,
-		kubeClient:       kubeClient,
-		podmanClient:     podmanClient,
-		stateClient:      stateClient,
-		preferenceClient: preferenceClient,
+		cancel:            cancel,
+		pushWatcher:       pushWatcher,
+		kubeClient:        kubeClient,
+		podmanClient:      podmanClient,
+		stateClient:       stateClient,
+		portForwardClient: portForwardClient,
+		preferenceClient:  preferenceClient,
 
 		devfileState: devstate.NewDevfileState(),
 	}
@@ -91,6 +101,109 @@ func (s *DefaultApiService) ComponentGet(ctx context.Context) (openapi.ImplRespo
 	return openapi.Response(http.StatusOK, value), nil
 }
 
+// ComponentPortForwardGet -
+func (s *DefaultApiService) ComponentPortForwardGet(ctx context.Context) (openapi.ImplResponse, error) {
+	fwPorts, err := s.stateClient.GetForwardedPorts(ctx)
+	if err != nil {
+		return openapi.Response(http.StatusInternalServerError, openapi.GeneralError{
+			Message: fmt.Sprintf("error getting the forwarded ports: %s", err),
+		}), nil
+	}
+	result := make([]openapi.ForwardedPort, 0, len(fwPorts))
+	for _, fwPort := range fwPorts {
+		result = append(result, toOpenapiForwardedPort(fwPort))
+	}
+	return openapi.Response(http.StatusOK, result), nil
+}
+
+// ComponentPortForwardPost -
+func (s *DefaultApiService) ComponentPortForwardPost(ctx context.Context, params openapi.ComponentPortForwardPostRequest) (openapi.ImplResponse, error) {
+	if s.portForwardClient == nil {
+		return openapi.Response(http.StatusInternalServerError, openapi.GeneralError{
+			Message: "ports can be forwarded only from an 'odo dev' session",
+		}), nil
+	}
+
+	devfileObj := odocontext.GetEffectiveDevfileObj(ctx)
+	if devfileObj == nil {
+		return openapi.Response(http.StatusInternalServerError, openapi.GeneralError{
+			Message: "no Devfile found",
+		}), nil
+	}
+	containers, err := devfileObj.Data.GetComponents(common.DevfileOptions{
+		FilterByName:     params.ContainerName,
+		ComponentOptions: common.ComponentOptions{ComponentType: v1alpha2.ContainerComponentType},
+	})
+	if err != nil {
+		return openapi.Response(http.StatusInternalServerError, openapi.GeneralError{
+			Message: fmt.Sprintf("error getting the containers of the Devfile: %s", err),
+		}), nil
+	}
+	if len(containers) == 0 {
+		return openapi.Response(http.StatusBadRequest, openapi.GeneralError{
+			Message: fmt.Sprintf("container %q not found in the Devfile", params.ContainerName),
+		}), nil
+	}
+
+	if params.ContainerPort <= 0 || params.ContainerPort > 65535 || params.LocalPort < 0 || params.LocalPort > 65535 {
+		return openapi.Response(http.StatusBadRequest, openapi.GeneralError{
+			Message: "the container port must be a number in the range [1, 65535], and the local port a number in the range [0, 65535], 0 to assign a free port",
+		}), nil
+	}
+
+	port := api.ForwardedPort{
+		ContainerName: params.ContainerName,
+		ContainerPort: int(params.ContainerPort),
+		LocalPort:     int(params.LocalPort),
+	}
+	fwPort, err := s.portForwardClient.AddPortForward(ctx, odocontext.GetComponentName(ctx), port, log.GetStderr())
+	if err != nil {
+		return openapi.Response(http.StatusInternalServerError, openapi.GeneralError{
+			Message: fmt.Sprintf("error forwarding the port: %s", err),
+		}), nil
+	}
+	return openapi.Response(http.StatusOK, toOpenapiForwardedPort(fwPort)), nil
+}
+
+// ComponentPortForwardLocalPortDelete -
+func (s *DefaultApiService) ComponentPortForwardLocalPortDelete(ctx context.Context, localPort int32) (openapi.ImplResponse, error) {
+	if s.portForwardClient == nil {
+		return openapi.Response(http.StatusNotFound, openapi.GeneralError{
+			Message: portForward.ErrPortNotForwarded.Error(),
+		}), nil
+	}
+	err := s.portForwardClient.RemovePortForward(ctx, int(localPort))
+	if err != nil {
+		if errors.Is(err, portForward.ErrPortNotForwarded) {
+			return openapi.Response(http.StatusNotFound, openapi.GeneralError{
+				Message: err.Error(),
+			}), nil
+		}
+		return openapi.Response(http.StatusInternalServerError, openapi.GeneralError{
+			Message: fmt.Sprintf("error stopping the port forwarding: %s", err),
+		}), nil
+	}
+	return openapi.Response(http.StatusOK, openapi.GeneralSuccess{
+		Message: fmt.Sprintf("Local port %d is not forwarded anymore", localPort),
+	}), nil
+}
+
+func toOpenapiForwardedPort(fwPort api.ForwardedPort) openapi.ForwardedPort {
+	return openapi.ForwardedPort{
+		Platform:      fwPort.Platform,
+		ContainerName: fwPort.ContainerName,
+		PortName:      fwPort.PortName,
+		IsDebug:       fwPort.IsDebug,
+		LocalAddress:  fwPort.LocalAddress,
+		LocalPort:     int32(fwPort.LocalPort),
+		ContainerPort: int32(fwPort.ContainerPort),
+		Exposure:      fwPort.Exposure,
+		Protocol:      fwPort.Protocol,
+		IsReverse:     fwPort.IsReverse,
+		IsDynamic:     fwPort.IsDynamic,
+	}
+}
+
 // InstanceDelete -
 func (s *DefaultApiService) InstanceDelete(ctx context.Context) (openapi.ImplResponse, error) {
 	s.cancel()
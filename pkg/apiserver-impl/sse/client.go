@@ -0,0 +1,76 @@
+package sse
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// maxEventSize is the maximum size of a line in the stream of events.
+// DevfileUpdated events contain the whole content of the Devfile.
+const maxEventSize = 10 * 1024 * 1024
+
+// ReceivedEvent is an event received from a stream of notifications
+type ReceivedEvent struct {
+	Name string
+	Data string
+}
+
+// Subscribe connects to the stream of notifications at url, and calls handler for each event received,
+// until the context is cancelled or the stream is closed by the server
+func Subscribe(ctx context.Context, url string, handler func(ReceivedEvent)) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unable to subscribe to notifications, server returned status %q", resp.Status)
+	}
+
+	err = readEvents(resp.Body, handler)
+	if ctx.Err() != nil {
+		return nil
+	}
+	return err
+}
+
+// readEvents parses the events from the stream in r, and calls handler for each event
+func readEvents(r io.Reader, handler func(ReceivedEvent)) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxEventSize)
+
+	var (
+		current ReceivedEvent
+		data    []string
+	)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "":
+			// An empty line terminates the event
+			if current.Name != "" {
+				current.Data = strings.Join(data, "\n")
+				handler(current)
+			}
+			current = ReceivedEvent{}
+			data = nil
+		case strings.HasPrefix(line, ":"):
+			// Comment, used for heartbeats
+		case strings.HasPrefix(line, "event:"):
+			current.Name = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			data = append(data, strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+		}
+	}
+	return scanner.Err()
+}
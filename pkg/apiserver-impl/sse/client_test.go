@@ -0,0 +1,56 @@
+package sse
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_readEvents(t *testing.T) {
+	tests := []struct {
+		name   string
+		stream string
+		want   []ReceivedEvent
+	}{
+		{
+			name:   "heartbeats only",
+			stream: ": heartbeat\n\n: heartbeat\n\n",
+			want:   nil,
+		},
+		{
+			name:   "events with and without data",
+			stream: "event: DevfileUpdated\n\n: heartbeat\n\nevent: ComponentStatusChanged\ndata: {\"state\":\"Ready\"}\n\n",
+			want: []ReceivedEvent{
+				{Name: "DevfileUpdated"},
+				{Name: "ComponentStatusChanged", Data: `{"state":"Ready"}`},
+			},
+		},
+		{
+			name:   "event with multiple data lines",
+			stream: "event: Output\ndata: line1\ndata: line2\n\n",
+			want: []ReceivedEvent{
+				{Name: "Output", Data: "line1\nline2"},
+			},
+		},
+		{
+			name:   "incomplete event is ignored",
+			stream: "event: Output\ndata: text",
+			want:   nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []ReceivedEvent
+			err := readEvents(strings.NewReader(tt.stream), func(ev ReceivedEvent) {
+				got = append(got, ev)
+			})
+			if err != nil {
+				t.Errorf("readEvents() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("readEvents() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
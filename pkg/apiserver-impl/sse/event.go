@@ -11,6 +11,19 @@ type EventType int
 const (
 	Heartbeat EventType = iota + 1
 	DevfileUpdated
+	ComponentStatusChanged
+	FilesSynced
+	Output
+	RunCommandHealthChanged
+)
+
+// Names of the events, as sent in the stream
+const (
+	DevfileUpdatedEventName          = "DevfileUpdated"
+	ComponentStatusChangedEventName  = "ComponentStatusChanged"
+	FilesSyncedEventName             = "FilesSynced"
+	OutputEventName                  = "Output"
+	RunCommandHealthChangedEventName = "RunCommandHealthChanged"
 )
 
 type Event struct {
@@ -24,7 +37,15 @@ func (e Event) toSseString() (string, error) {
 	case Heartbeat:
 		return ": heartbeat\n\n", nil
 	case DevfileUpdated:
-		eventName = "DevfileUpdated"
+		eventName = DevfileUpdatedEventName
+	case ComponentStatusChanged:
+		eventName = ComponentStatusChangedEventName
+	case FilesSynced:
+		eventName = FilesSyncedEventName
+	case Output:
+		eventName = OutputEventName
+	case RunCommandHealthChanged:
+		eventName = RunCommandHealthChangedEventName
 	default:
 		return "", fmt.Errorf("unrecognized event type:%v", e.eventType)
 	}
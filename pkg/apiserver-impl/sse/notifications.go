@@ -2,6 +2,7 @@ package sse
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"sync"
 	"time"
@@ -12,6 +13,10 @@ import (
 	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
 )
 
+// sessionEventsBufferSize is the number of events of the Dev session that can be queued
+// before the new events are dropped
+const sessionEventsBufferSize = 1000
+
 type Notifier struct {
 	fsys filesystem.Filesystem
 
@@ -31,6 +36,10 @@ type Notifier struct {
 
 	// cancelSubscriptionChan is a write-only channel where subscribers can cancel their registration and stop being broadcast new events coming from eventsChan.
 	cancelSubscriptionChan chan (<-chan Event)
+
+	// sessionEventsChan is a buffered channel where the events of the Dev session are queued before being sent to eventsChan,
+	// so the Dev session is not blocked by slow subscribers.
+	sessionEventsChan chan Event
 }
 
 func NewNotifier(ctx context.Context, fsys filesystem.Filesystem, devfilePath string, devfileFiles []string) (*Notifier, error) {
@@ -41,6 +50,7 @@ func NewNotifier(ctx context.Context, fsys filesystem.Filesystem, devfilePath st
 		subscribers:            make([]chan Event, 0),
 		newSubscriptionChan:    make(chan chan Event),
 		cancelSubscriptionChan: make(chan (<-chan Event)),
+		sessionEventsChan:      make(chan Event, sessionEventsBufferSize),
 	}
 
 	err := notifier.watchDevfileChanges(ctx, devfileFiles)
@@ -50,6 +60,17 @@ func NewNotifier(ctx context.Context, fsys filesystem.Filesystem, devfilePath st
 
 	go notifier.manageSubscriptions(ctx)
 
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case ev := <-notifier.sessionEventsChan:
+				notifier.eventsChan <- ev
+			}
+		}
+	}()
+
 	// Heartbeat as a keep-alive mechanism to prevent some clients from closing inactive connections (notifications might not be sent regularly).
 	go func() {
 		ticker := time.NewTicker(7 * time.Second)
@@ -135,6 +156,65 @@ func (n *Notifier) manageSubscriptions(ctx context.Context) {
 	}
 }
 
+// NotifyStatusChanged sends an event to the subscribers indicating that the state of the component changed
+func (n *Notifier) NotifyStatusChanged(state string) {
+	n.publishSessionEvent(Event{
+		eventType: ComponentStatusChanged,
+		data: map[string]string{
+			"state": state,
+		},
+	})
+}
+
+// NotifyFilesSynced sends an event to the subscribers indicating that files have been synced to the component
+func (n *Notifier) NotifyFilesSynced(changedFiles, deletedFiles []string) {
+	n.publishSessionEvent(Event{
+		eventType: FilesSynced,
+		data: map[string][]string{
+			"changedFiles": changedFiles,
+			"deletedFiles": deletedFiles,
+		},
+	})
+}
+
+// NotifyRunCommandHealthChanged sends an event to the subscribers indicating that the run command stopped, or runs again
+func (n *Notifier) NotifyRunCommandHealthChanged(healthy bool, reason string) {
+	n.publishSessionEvent(Event{
+		eventType: RunCommandHealthChanged,
+		data: map[string]interface{}{
+			"healthy": healthy,
+			"reason":  reason,
+		},
+	})
+}
+
+// OutputWriter returns a writer sending to the subscribers everything written to it
+func (n *Notifier) OutputWriter() io.Writer {
+	return outputWriter{notifier: n}
+}
+
+type outputWriter struct {
+	notifier *Notifier
+}
+
+func (o outputWriter) Write(p []byte) (int, error) {
+	o.notifier.publishSessionEvent(Event{
+		eventType: Output,
+		data: map[string]string{
+			"text": string(p),
+		},
+	})
+	return len(p), nil
+}
+
+func (n *Notifier) publishSessionEvent(ev Event) {
+	select {
+	case n.sessionEventsChan <- ev:
+	default:
+		klog.V(4).Infof("too many events queued, dropping event of type %v", ev.eventType)
+	}
+}
+
 func (n *Notifier) Routes() openapi.Routes {
 	return openapi.Routes{
 		{
@@ -17,6 +17,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/log"
 	"github.com/redhat-developer/odo/pkg/odo/cli/feature"
 	"github.com/redhat-developer/odo/pkg/podman"
+	"github.com/redhat-developer/odo/pkg/portForward"
 	"github.com/redhat-developer/odo/pkg/preference"
 	"github.com/redhat-developer/odo/pkg/state"
 	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
@@ -33,6 +34,8 @@ var swaggerFiles embed.FS
 
 type ApiServer struct {
 	PushWatcher <-chan struct{}
+	// Notifier sends the events of the Dev session to the subscribers of the notifications
+	Notifier *sse.Notifier
 }
 
 func StartServer(
@@ -46,6 +49,7 @@ func StartServer(
 	kubernetesClient kclient.ClientInterface,
 	podmanClient podman.Client,
 	stateClient state.Client,
+	portForwardClient portForward.Client,
 	preferenceClient preference.Client,
 	informerClient *informer.InformerClient,
 ) (ApiServer, error) {
@@ -56,6 +60,7 @@ func StartServer(
 		kubernetesClient,
 		podmanClient,
 		stateClient,
+		portForwardClient,
 		preferenceClient,
 	)
 	defaultApiController := openapi.NewDefaultApiController(defaultApiService)
@@ -157,5 +162,6 @@ func StartServer(
 
 	return ApiServer{
 		PushWatcher: pushWatcher,
+		Notifier:    sseNotifier,
 	}, nil
 }
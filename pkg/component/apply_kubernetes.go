@@ -6,6 +6,7 @@ import (
 	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"github.com/devfile/library/v2/pkg/devfile/parser"
 	devfilefs "github.com/devfile/library/v2/pkg/testingutil/filesystem"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/klog"
 
 	"github.com/redhat-developer/odo/pkg/kclient"
@@ -31,13 +32,82 @@ func ApplyKubernetes(
 	kubeClient kclient.ClientInterface,
 	path string,
 ) error {
+	uList, err := getKubernetesResources(devfile, kubernetes, kubeClient, path)
+	if err != nil {
+		return err
+	}
+	return ApplyKubernetesResources(mode, appName, componentName, devfile, uList, kubeClient)
+}
+
+// ApplyKubernetesResources creates the k8s resources (uList) on the cluster, with the same labels and annotations as ApplyKubernetes
+func ApplyKubernetesResources(
+	mode string,
+	appName string,
+	componentName string,
+	devfile parser.DevfileObj,
+	uList []unstructured.Unstructured,
+	kubeClient kclient.ClientInterface,
+) error {
+	labels, annotations := GetKubernetesLabelsAndAnnotations(mode, appName, componentName, devfile)
+	for _, u := range uList {
+		// Deploy the actual Kubernetes component and error out if there's an issue.
+		log.Sectionf("Deploying Kubernetes Component: %s", u.GetName())
+		err := service.PushKubernetesResource(kubeClient, u, labels, annotations, mode)
+		if err != nil {
+			return fmt.Errorf("failed to create service(s) associated with the component: %w", err)
+		}
+	}
+	return nil
+}
+
+// DryRunKubernetes applies the k8s resources defined by the `apply` command in dry-run mode,
+// with the same labels and annotations as ApplyKubernetes, and returns, for each resource,
+// the resource currently deployed and the resource as it would be after it is applied
+func DryRunKubernetes(
+	mode string,
+	appName string,
+	componentName string,
+	devfile parser.DevfileObj,
+	kubernetes devfilev1.Component,
+	kubeClient kclient.ClientInterface,
+	path string,
+) ([]service.DryRunResult, error) {
+	uList, err := getKubernetesResources(devfile, kubernetes, kubeClient, path)
+	if err != nil {
+		return nil, err
+	}
+	labels, annotations := GetKubernetesLabelsAndAnnotations(mode, appName, componentName, devfile)
+	result := make([]service.DryRunResult, 0, len(uList))
+	for _, u := range uList {
+		res, err := service.DryRunKubernetesResource(kubeClient, u, labels, annotations)
+		if err != nil {
+			return nil, fmt.Errorf("failed to apply %s/%s in dry-run mode: %w", u.GetKind(), u.GetName(), err)
+		}
+		result = append(result, res)
+	}
+	return result, nil
+}
+
+// getKubernetesResources returns the resources of the kubernetes devfile component
+func getKubernetesResources(
+	devfile parser.DevfileObj,
+	kubernetes devfilev1.Component,
+	kubeClient kclient.ClientInterface,
+	path string,
+) ([]unstructured.Unstructured, error) {
 	// TODO: Use GetK8sComponentAsUnstructured here and pass it to ValidateResourcesExistInK8sComponent
 	// Validate if the GVRs represented by Kubernetes inlined components are supported by the underlying cluster
 	kind, err := ValidateResourcesExistInK8sComponent(kubeClient, devfile, kubernetes, path)
 	if err != nil {
-		return fmt.Errorf("%s: %w", kind, err)
+		return nil, fmt.Errorf("%s: %w", kind, err)
 	}
 
+	// Get the Kubernetes component
+	return libdevfile.GetK8sComponentAsUnstructuredList(devfile, kubernetes.Name, path, devfilefs.DefaultFs{})
+}
+
+// GetKubernetesLabelsAndAnnotations returns the labels and annotations to inject into the resources deployed in the given mode
+func GetKubernetesLabelsAndAnnotations(mode string, appName string, componentName string, devfile parser.DevfileObj) (map[string]string, map[string]string) {
 	// Get the most common labels that's applicable to all resources being deployed.
 	// Set the mode. Regardless of what Kubernetes resource we are deploying.
 	runtime := GetComponentRuntimeFromDevfileMetadata(devfile.Data.GetMetadata())
@@ -49,19 +119,5 @@ func ApplyKubernetes(
 	// Retrieve the component type from the devfile and also inject it into the list of annotations
 	annotations := make(map[string]string)
 	odolabels.SetProjectType(annotations, GetComponentTypeFromDevfileMetadata(devfile.Data.GetMetadata()))
-
-	// Get the Kubernetes component
-	uList, err := libdevfile.GetK8sComponentAsUnstructuredList(devfile, kubernetes.Name, path, devfilefs.DefaultFs{})
-	if err != nil {
-		return err
-	}
-	for _, u := range uList {
-		// Deploy the actual Kubernetes component and error out if there's an issue.
-		log.Sectionf("Deploying Kubernetes Component: %s", u.GetName())
-		err = service.PushKubernetesResource(kubeClient, u, labels, annotations, mode)
-		if err != nil {
-			return fmt.Errorf("failed to create service(s) associated with the component: %w", err)
-		}
-	}
-	return nil
+	return labels, annotations
 }
@@ -99,7 +99,7 @@ func Log(platformClient platform.Client, componentName string, appName string, f
 
 	containerName := command.Exec.Component
 
-	return platformClient.GetPodLogs(pod.Name, containerName, follow)
+	return platformClient.GetPodLogs(pod.Name, containerName, platform.PodLogsOptions{Follow: follow})
 }
 
 // ListAllClusterComponents returns a list of all "components" on a cluster
@@ -204,7 +204,7 @@ func ListAllClusterComponents(client kclient.ClientInterface, namespace string)
 	return components, nil
 }
 
-func ListAllComponents(client kclient.ClientInterface, podmanClient podman.Client, namespace string, devObj *parser.DevfileObj, componentName string) ([]api.ComponentAbstract, string, error) {
+func ListAllComponents(client kclient.ClientInterface, podmanClient podman.Client, dockerClient podman.Client, namespace string, devObj *parser.DevfileObj, componentName string) ([]api.ComponentAbstract, string, error) {
 	var (
 		allComponents []api.ComponentAbstract
 	)
@@ -226,6 +226,15 @@ func ListAllComponents(client kclient.ClientInterface, podmanClient podman.Clien
 		allComponents = append(allComponents, podmanComponents...)
 	}
 
+	// DockerClient is nil if docker platform is not accessible, or not requested
+	if dockerClient != nil {
+		dockerComponents, err := dockerClient.ListAllComponents()
+		if err != nil {
+			return nil, "", err
+		}
+		allComponents = append(allComponents, dockerComponents...)
+	}
+
 	localComponent := api.ComponentAbstract{
 		Name:      componentName,
 		ManagedBy: "",
@@ -13,6 +13,7 @@ import (
 
 	"github.com/redhat-developer/odo/pkg/api"
 	"github.com/redhat-developer/odo/pkg/component"
+	"github.com/redhat-developer/odo/pkg/docker"
 	"github.com/redhat-developer/odo/pkg/kclient"
 	"github.com/redhat-developer/odo/pkg/log"
 	clierrors "github.com/redhat-developer/odo/pkg/odo/cli/errors"
@@ -66,6 +67,11 @@ func DescribeDevfileComponent(
 			return api.Component{}, nil, podman.NewPodmanNotFoundError(nil)
 		}
 		kubeClient = nil
+	case commonflags.PlatformDocker:
+		if podmanClient == nil {
+			return api.Component{}, nil, docker.NewDockerNotFoundError(nil)
+		}
+		kubeClient = nil
 	}
 
 	// TODO(feloy) Pass PID with `--pid` flag
@@ -95,6 +101,12 @@ func DescribeDevfileComponent(
 	}
 	forwardedPorts := filterByPlatform(ctx, isPlatformFeatureEnabled, allFwdPorts)
 
+	allAppStatus, err := stateClient.GetApplicationStatus(ctx)
+	if err != nil {
+		return api.Component{}, nil, err
+	}
+	appStatus := filterByPlatform(ctx, true, allAppStatus)
+
 	runningOn, err := GetRunningOn(ctx, componentName, kubeClient, podmanClient)
 	if err != nil {
 		return api.Component{}, nil, err
@@ -111,15 +123,16 @@ func DescribeDevfileComponent(
 	}
 
 	cmp := api.Component{
-		DevfilePath:       devfilePath,
-		DevfileData:       devfileData,
-		DevControlPlane:   devControlPlaneData,
-		DevForwardedPorts: forwardedPorts,
-		RunningIn:         api.MergeRunningModes(runningOn),
-		RunningOn:         runningOn,
-		ManagedBy:         "odo",
-		Ingresses:         ingresses,
-		Routes:            routes,
+		DevfilePath:          devfilePath,
+		DevfileData:          devfileData,
+		DevControlPlane:      devControlPlaneData,
+		DevForwardedPorts:    forwardedPorts,
+		DevApplicationStatus: appStatus,
+		RunningIn:            api.MergeRunningModes(runningOn),
+		RunningOn:            runningOn,
+		ManagedBy:            "odo",
+		Ingresses:            ingresses,
+		Routes:               routes,
 	}
 	if !isPlatformFeatureEnabled {
 		// Display RunningOn field only if the feature is enabled
@@ -165,6 +178,11 @@ func DescribeNamedComponent(
 			return api.Component{}, nil, podman.NewPodmanNotFoundError(nil)
 		}
 		kubeClient = nil
+	case commonflags.PlatformDocker:
+		if podmanClient == nil {
+			return api.Component{}, nil, docker.NewDockerNotFoundError(nil)
+		}
+		kubeClient = nil
 	}
 
 	runningOn, err := GetRunningOn(ctx, name, kubeClient, podmanClient)
@@ -220,7 +238,12 @@ func GetRunningOn(ctx context.Context, n string, kubeClient kclient.ClientInterf
 			runningOn[commonflags.PlatformCluster] = runningModesMap[kubeClient]
 		}
 		if podmanClient != nil && runningModesMap[podmanClient] != nil {
-			runningOn[commonflags.PlatformPodman] = runningModesMap[podmanClient]
+			// On the docker platform, the Podman client is the Docker client
+			podmanPlatform := commonflags.PlatformPodman
+			if fcontext.GetPlatform(ctx, "") == commonflags.PlatformDocker {
+				podmanPlatform = commonflags.PlatformDocker
+			}
+			runningOn[podmanPlatform] = runningModesMap[podmanClient]
 		}
 	}
 	return runningOn, nil
@@ -242,9 +265,9 @@ func filterByPlatform[T platformDependent](ctx context.Context, isFeatEnabled bo
 				result = append(result, p)
 			}
 		}
-	case commonflags.PlatformPodman:
+	case commonflags.PlatformPodman, commonflags.PlatformDocker:
 		for _, p := range all {
-			if p.GetPlatform() == commonflags.PlatformPodman {
+			if p.GetPlatform() == plt {
 				result = append(result, p)
 			}
 		}
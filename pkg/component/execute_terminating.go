@@ -18,6 +18,13 @@ import (
 
 const ShellExecutable string = "/bin/sh"
 
+// CommandOutputRecorder records the output of the Devfile commands executed in the containers
+type CommandOutputRecorder interface {
+	// RecordCommandOutput records the lines output by the command commandID in the container containerName,
+	// and err if the command failed
+	RecordCommandOutput(commandID string, containerName string, lines []string, err error)
+}
+
 func ExecuteTerminatingCommand(
 	ctx context.Context,
 	execClient exec.Client,
@@ -29,6 +36,7 @@ func ExecuteTerminatingCommand(
 	componentName string,
 	msg string,
 	directRun bool,
+	recorder CommandOutputRecorder,
 ) error {
 
 	if componentExists && command.Exec != nil && pointer.BoolDeref(command.Exec.HotReloadCapable, false) {
@@ -54,8 +62,13 @@ func ExecuteTerminatingCommand(
 		stdoutWriter, stdoutChannel, stderrWriter, stderrChannel = logger.CreateContainerOutputWriter()
 	}
 
-	cmdline := getCmdline(command, !directRun)
-	_, _, err := execClient.ExecuteCommand(ctx, cmdline, podName, command.Exec.Component, directRun, stdoutWriter, stderrWriter)
+	// The output is needed locally only to be recorded
+	teeOutput := !directRun && recorder != nil
+	cmdline := getCmdline(command, !directRun, teeOutput)
+	stdout, _, err := execClient.ExecuteCommand(ctx, cmdline, podName, command.Exec.Component, directRun, stdoutWriter, stderrWriter)
+	if teeOutput {
+		recorder.RecordCommandOutput(command.Id, command.Exec.Component, stdout, err)
+	}
 
 	if !directRun {
 		closeWriterAndWaitForAck(stdoutWriter, stdoutChannel, stderrWriter, stderrChannel)
@@ -78,7 +91,7 @@ func ExecuteTerminatingCommand(
 	return err
 }
 
-func getCmdline(command v1alpha2.Command, redirectToPid1 bool) []string {
+func getCmdline(command v1alpha2.Command, redirectToPid1 bool, teeOutput bool) []string {
 	// deal with environment variables
 	var cmdLine string
 	setEnvVariable := util.GetCommandStringFromEnvs(command.Exec.Env)
@@ -93,16 +106,24 @@ func getCmdline(command v1alpha2.Command, redirectToPid1 bool) []string {
 	// Redirecting to /proc/1/fd/* allows to redirect the process output to the output streams of PID 1 process inside the container.
 	// This way, returning the container logs with 'odo logs' or 'kubectl logs' would work seamlessly.
 	// See https://stackoverflow.com/questions/58716574/where-exactly-do-the-logs-of-kubernetes-pods-come-from-at-the-container-level
-	redirectString := ""
-	if redirectToPid1 {
-		redirectString = "1>>/proc/1/fd/1 2>>/proc/1/fd/2"
+	if redirectToPid1 && teeOutput {
+		// The output is also written to the standard output of the exec session, to be returned.
+		// As sh does not support pipefail, the exit status of the command is passed through the file descriptor 4,
+		// to be returned instead of the exit status of tee
+		cmdLine = "{ exec 3>&1; s=$( { { (" + cmdLine + ") 2>&1; echo $? >&4; } | tee -a /proc/1/fd/1 >&3; } 4>&1 ); exit $s; }"
+	} else {
+		redirectString := ""
+		if redirectToPid1 {
+			redirectString = "1>>/proc/1/fd/1 2>>/proc/1/fd/2"
+		}
+		cmdLine = "(" + cmdLine + ") " + redirectString
 	}
 	var cmd []string
 	if command.Exec.WorkingDir != "" {
 		// since we are using /bin/sh -c, the command needs to be within a single double quote instance, for example "cd /tmp && pwd"
-		cmd = []string{ShellExecutable, "-c", "cd " + command.Exec.WorkingDir + " && (" + cmdLine + ") " + redirectString}
+		cmd = []string{ShellExecutable, "-c", "cd " + command.Exec.WorkingDir + " && " + cmdLine}
 	} else {
-		cmd = []string{ShellExecutable, "-c", "(" + cmdLine + ") " + redirectString}
+		cmd = []string{ShellExecutable, "-c", cmdLine}
 	}
 	return cmd
 }
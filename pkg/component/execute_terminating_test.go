@@ -0,0 +1,57 @@
+package component
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+)
+
+func Test_getCmdline(t *testing.T) {
+	command := func(workingDir string) v1alpha2.Command {
+		return v1alpha2.Command{
+			Id: "build",
+			CommandUnion: v1alpha2.CommandUnion{
+				Exec: &v1alpha2.ExecCommand{
+					CommandLine: "npm install",
+					WorkingDir:  workingDir,
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name           string
+		command        v1alpha2.Command
+		redirectToPid1 bool
+		teeOutput      bool
+		want           []string
+	}{
+		{
+			name:    "direct run",
+			command: command(""),
+			want:    []string{ShellExecutable, "-c", "(npm install) "},
+		},
+		{
+			name:           "redirected to PID 1",
+			command:        command("/projects"),
+			redirectToPid1: true,
+			want:           []string{ShellExecutable, "-c", "cd /projects && (npm install) 1>>/proc/1/fd/1 2>>/proc/1/fd/2"},
+		},
+		{
+			name:           "redirected to PID 1 and returned",
+			command:        command("/projects"),
+			redirectToPid1: true,
+			teeOutput:      true,
+			want: []string{ShellExecutable, "-c",
+				"cd /projects && { exec 3>&1; s=$( { { (npm install) 2>&1; echo $? >&4; } | tee -a /proc/1/fd/1 >&3; } 4>&1 ); exit $s; }"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := getCmdline(tt.command, tt.redirectToPid1, tt.teeOutput)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getCmdline() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
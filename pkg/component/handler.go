@@ -32,6 +32,7 @@ type runHandler struct {
 	containersRunning     []string
 	msg                   string
 	directRun             bool
+	outputRecorder        CommandOutputRecorder
 
 	fs           filesystem.Filesystem
 	imageBackend image.Backend
@@ -48,6 +49,8 @@ type HandlerOptions struct {
 	ContainersRunning []string
 	Msg               string
 	DirectRun         bool
+	// OutputRecorder records the output of the exec commands, if not nil
+	OutputRecorder CommandOutputRecorder
 
 	// For apply Kubernetes / Openshift
 	Devfile parser.DevfileObj
@@ -77,6 +80,7 @@ func NewRunHandler(
 		containersRunning:     options.ContainersRunning,
 		msg:                   options.Msg,
 		directRun:             options.DirectRun,
+		outputRecorder:        options.OutputRecorder,
 
 		fs:           fs,
 		imageBackend: imageBackend,
@@ -137,7 +141,7 @@ func (a *runHandler) ExecuteTerminatingCommand(ctx context.Context, command devf
 		appName       = odocontext.GetApplication(a.ctx)
 	)
 	if isContainerRunning(command.Exec.Component, a.containersRunning) {
-		return ExecuteTerminatingCommand(ctx, a.execClient, a.platformClient, command, a.ComponentExists, a.podName, appName, componentName, a.msg, a.directRun)
+		return ExecuteTerminatingCommand(ctx, a.execClient, a.platformClient, command, a.ComponentExists, a.podName, appName, componentName, a.msg, a.directRun, a.outputRecorder)
 	}
 	switch platform := a.platformClient.(type) {
 	case kclient.ClientInterface:
@@ -8,8 +8,10 @@ import (
 )
 
 type Configuration struct {
+	ContainerHost                 *string       `env:"CONTAINER_HOST,noinit"`
 	DevfileProxy                  *string       `env:"DEVFILE_PROXY,noinit"`
 	DockerCmd                     string        `env:"DOCKER_CMD,default=docker"`
+	DockerCmdInitTimeout          time.Duration `env:"DOCKER_CMD_INIT_TIMEOUT,default=1s"`
 	Globalodoconfig               *string       `env:"GLOBALODOCONFIG,noinit"`
 	OdoDebugTelemetryFile         *string       `env:"ODO_DEBUG_TELEMETRY_FILE,noinit"`
 	OdoDisableTelemetry           *bool         `env:"ODO_DISABLE_TELEMETRY,noinit"`
@@ -23,6 +25,7 @@ type Configuration struct {
 	OdoContainerBackendGlobalArgs []string      `env:"ODO_CONTAINER_BACKEND_GLOBAL_ARGS,noinit,delimiter=;"`
 	OdoImageBuildArgs             []string      `env:"ODO_IMAGE_BUILD_ARGS,noinit,delimiter=;"`
 	OdoContainerRunArgs           []string      `env:"ODO_CONTAINER_RUN_ARGS,noinit,delimiter=;"`
+	XdgRuntimeDir                 *string       `env:"XDG_RUNTIME_DIR,noinit"`
 }
 
 // GetConfiguration initializes a Configuration for odo by using the system environment.
@@ -12,6 +12,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/devfile/image"
 	"github.com/redhat-developer/odo/pkg/kclient"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
+	"github.com/redhat-developer/odo/pkg/log"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
 	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
 )
@@ -44,18 +45,25 @@ func (o *DeployClient) Deploy(ctx context.Context) error {
 		return err
 	}
 
-	handler := component.NewRunHandler(
+	// The images and resources applied are recorded as a new revision of the deployment,
+	// with the digests of the pushed images
+	digests := map[string]string{}
+	backend := image.SelectBackend(ctx)
+	if backend != nil {
+		backend = &digestBackend{Backend: backend, digests: digests}
+	}
+	handler := newRevisionHandler(component.NewRunHandler(
 		ctx,
 		o.kubeClient,
 		nil,
 		o.configAutomountClient,
 		o.fs,
-		image.SelectBackend(ctx),
+		backend,
 		component.HandlerOptions{
 			Devfile: *devfileObj,
 			Path:    path,
 		},
-	)
+	), *devfileObj, path, digests)
 
 	err = o.buildPushAutoImageComponents(handler, *devfileObj)
 	if err != nil {
@@ -67,7 +75,17 @@ func (o *DeployClient) Deploy(ctx context.Context) error {
 		return err
 	}
 
-	return libdevfile.Deploy(ctx, *devfileObj, handler)
+	err = libdevfile.Deploy(ctx, *devfileObj, handler)
+	if err != nil {
+		return err
+	}
+
+	// The deployment is successful even if it cannot be recorded
+	err = o.recordRevision(ctx, handler.revision)
+	if err != nil {
+		log.Warningf("Unable to record the revision of the deployment: %v", err)
+	}
+	return nil
 }
 
 func (o *DeployClient) buildPushAutoImageComponents(handler libdevfile.Handler, devfileObj parser.DevfileObj) error {
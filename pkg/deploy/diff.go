@@ -0,0 +1,119 @@
+package deploy
+
+import (
+	"github.com/pmezard/go-difflib/difflib"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/service"
+)
+
+// getResourceDiff returns the changes between the resource currently deployed and the resource as it would be after it is applied.
+// The resource currently deployed is nil if the resource would be created, and the applied resource is nil if the resource would be deleted.
+func getResourceDiff(res service.DryRunResult) (api.ResourceDiff, error) {
+	reference := res.Applied
+	if reference == nil {
+		reference = res.Current
+	}
+	result := api.ResourceDiff{
+		Kind: reference.GetKind(),
+		Name: reference.GetName(),
+	}
+
+	current, applied := cleanResource(res.Current), cleanResource(res.Applied)
+	if result.Kind == "Secret" {
+		maskSecretData(current, applied)
+	}
+
+	currentYAML, err := toYAML(current)
+	if err != nil {
+		return api.ResourceDiff{}, err
+	}
+	appliedYAML, err := toYAML(applied)
+	if err != nil {
+		return api.ResourceDiff{}, err
+	}
+
+	switch {
+	case current == nil:
+		result.Status = api.ResourceDiffCreated
+	case applied == nil:
+		result.Status = api.ResourceDiffDeleted
+	case string(currentYAML) == string(appliedYAML):
+		result.Status = api.ResourceDiffUnchanged
+		return result, nil
+	default:
+		result.Status = api.ResourceDiffUpdated
+	}
+
+	result.Diff, err = difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(currentYAML)),
+		B:        difflib.SplitLines(string(appliedYAML)),
+		FromFile: "live/" + result.Kind + "/" + result.Name,
+		ToFile:   "deploy/" + result.Kind + "/" + result.Name,
+		Context:  3,
+	})
+	return result, err
+}
+
+func toYAML(u *unstructured.Unstructured) ([]byte, error) {
+	if u == nil {
+		return nil, nil
+	}
+	return yaml.Marshal(u.Object)
+}
+
+// cleanResource returns a copy of the resource without the fields managed by the cluster,
+// which are not relevant to compare the resource currently deployed with the resource after it is applied
+func cleanResource(u *unstructured.Unstructured) *unstructured.Unstructured {
+	if u == nil {
+		return nil
+	}
+	result := u.DeepCopy()
+	result.SetManagedFields(nil)
+	result.SetResourceVersion("")
+	result.SetGeneration(0)
+	result.SetUID("")
+	result.SetSelfLink("")
+	unstructured.RemoveNestedField(result.Object, "metadata", "creationTimestamp")
+	unstructured.RemoveNestedField(result.Object, "status")
+	return result
+}
+
+// maskSecretData hides the values of the data of the secrets, the same way as kubectl diff does:
+// the values are replaced with "***" if they are not changed, or with "*** (before)" and "*** (after)" if they are
+func maskSecretData(current, applied *unstructured.Unstructured) {
+	var currentData map[string]interface{}
+	if current != nil {
+		currentData, _, _ = unstructured.NestedMap(current.Object, "data")
+	}
+	var appliedData map[string]interface{}
+	if applied != nil {
+		appliedData, _, _ = unstructured.NestedMap(applied.Object, "data")
+	}
+
+	for k, v := range currentData {
+		if appliedValue, found := appliedData[k]; found {
+			if appliedValue == v {
+				currentData[k], appliedData[k] = "***", "***"
+			} else {
+				currentData[k], appliedData[k] = "*** (before)", "*** (after)"
+			}
+			continue
+		}
+		currentData[k] = "***"
+	}
+	for k := range appliedData {
+		if _, found := currentData[k]; !found {
+			appliedData[k] = "***"
+		}
+	}
+
+	if currentData != nil {
+		_ = unstructured.SetNestedMap(current.Object, currentData, "data")
+	}
+	if appliedData != nil {
+		_ = unstructured.SetNestedMap(applied.Object, appliedData, "data")
+	}
+}
@@ -0,0 +1,130 @@
+package deploy
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/service"
+)
+
+func newResource(kind, name string, fields map[string]interface{}) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       kind,
+		"metadata": map[string]interface{}{
+			"name": name,
+		},
+	}}
+	for k, v := range fields {
+		u.Object[k] = v
+	}
+	return u
+}
+
+func withClusterFields(u *unstructured.Unstructured, resourceVersion string) *unstructured.Unstructured {
+	u.SetResourceVersion(resourceVersion)
+	u.SetUID(types.UID("uid-" + u.GetName()))
+	u.SetGeneration(2)
+	_ = unstructured.SetNestedField(u.Object, "2023-01-01T00:00:00Z", "metadata", "creationTimestamp")
+	_ = unstructured.SetNestedField(u.Object, map[string]interface{}{"phase": "Active"}, "status")
+	return u
+}
+
+func Test_getResourceDiff(t *testing.T) {
+	tests := []struct {
+		name          string
+		res           service.DryRunResult
+		wantStatus    api.ResourceDiffStatus
+		wantInDiff    []string
+		wantNotInDiff []string
+	}{
+		{
+			name: "resource not deployed",
+			res: service.DryRunResult{
+				Applied: newResource("ConfigMap", "cm", map[string]interface{}{"data": map[string]interface{}{"key": "value"}}),
+			},
+			wantStatus: api.ResourceDiffCreated,
+			wantInDiff: []string{"+++ deploy/ConfigMap/cm", "+kind: ConfigMap", "+  key: value"},
+		},
+		{
+			name: "resource deployed and unchanged, except fields managed by the cluster",
+			res: service.DryRunResult{
+				Current: withClusterFields(newResource("ConfigMap", "cm", map[string]interface{}{"data": map[string]interface{}{"key": "value"}}), "1"),
+				Applied: withClusterFields(newResource("ConfigMap", "cm", map[string]interface{}{"data": map[string]interface{}{"key": "value"}}), "2"),
+			},
+			wantStatus: api.ResourceDiffUnchanged,
+		},
+		{
+			name: "resource deployed and updated",
+			res: service.DryRunResult{
+				Current: withClusterFields(newResource("ConfigMap", "cm", map[string]interface{}{"data": map[string]interface{}{"key": "value"}}), "1"),
+				Applied: withClusterFields(newResource("ConfigMap", "cm", map[string]interface{}{"data": map[string]interface{}{"key": "other"}}), "2"),
+			},
+			wantStatus:    api.ResourceDiffUpdated,
+			wantInDiff:    []string{"--- live/ConfigMap/cm", "+++ deploy/ConfigMap/cm", "-  key: value", "+  key: other"},
+			wantNotInDiff: []string{"resourceVersion", "uid", "creationTimestamp", "Active"},
+		},
+		{
+			name: "resource deployed and pruned",
+			res: service.DryRunResult{
+				Current: withClusterFields(newResource("ConfigMap", "cm", map[string]interface{}{"data": map[string]interface{}{"key": "value"}}), "1"),
+			},
+			wantStatus:    api.ResourceDiffDeleted,
+			wantInDiff:    []string{"--- live/ConfigMap/cm", "-kind: ConfigMap", "-  key: value"},
+			wantNotInDiff: []string{"+kind", "resourceVersion"},
+		},
+		{
+			name: "secret data is masked",
+			res: service.DryRunResult{
+				Current: newResource("Secret", "secret", map[string]interface{}{"data": map[string]interface{}{
+					"same":    "c2FtZQ==",
+					"changed": "YmVmb3Jl",
+					"removed": "cmVtb3ZlZA==",
+				}}),
+				Applied: newResource("Secret", "secret", map[string]interface{}{"data": map[string]interface{}{
+					"same":    "c2FtZQ==",
+					"changed": "YWZ0ZXI=",
+					"added":   "YWRkZWQ=",
+				}}),
+			},
+			wantStatus:    api.ResourceDiffUpdated,
+			wantInDiff:    []string{"-  changed: '*** (before)'", "+  changed: '*** (after)'", "+  added: '***'", "-  removed: '***'", "   same: '***'"},
+			wantNotInDiff: []string{"c2FtZQ==", "YmVmb3Jl", "YWZ0ZXI=", "YWRkZWQ=", "cmVtb3ZlZA=="},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := getResourceDiff(tt.res)
+			if err != nil {
+				t.Fatalf("getResourceDiff() unexpected error: %v", err)
+			}
+			reference := tt.res.Applied
+			if reference == nil {
+				reference = tt.res.Current
+			}
+			if got.Kind != reference.GetKind() || got.Name != reference.GetName() {
+				t.Errorf("getResourceDiff() resource = %s/%s, want %s/%s", got.Kind, got.Name, reference.GetKind(), reference.GetName())
+			}
+			if got.Status != tt.wantStatus {
+				t.Errorf("getResourceDiff() status = %q, want %q", got.Status, tt.wantStatus)
+			}
+			if tt.wantStatus == api.ResourceDiffUnchanged && got.Diff != "" {
+				t.Errorf("getResourceDiff() diff should be empty for an unchanged resource, got:\n%s", got.Diff)
+			}
+			for _, s := range tt.wantInDiff {
+				if !strings.Contains(got.Diff, s) {
+					t.Errorf("getResourceDiff() diff should contain %q, got:\n%s", s, got.Diff)
+				}
+			}
+			for _, s := range tt.wantNotInDiff {
+				if strings.Contains(got.Diff, s) {
+					t.Errorf("getResourceDiff() diff should not contain %q, got:\n%s", s, got.Diff)
+				}
+			}
+		})
+	}
+}
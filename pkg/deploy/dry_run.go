@@ -0,0 +1,143 @@
+package deploy
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/component"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	"github.com/redhat-developer/odo/pkg/log"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/service"
+)
+
+func (o *DeployClient) DryRun(ctx context.Context, prune bool) (api.DeployDiff, error) {
+	var (
+		devfileObj  = odocontext.GetEffectiveDevfileObj(ctx)
+		devfilePath = odocontext.GetDevfilePath(ctx)
+		path        = filepath.Dir(devfilePath)
+	)
+
+	_, err := libdevfile.ValidateAndGetCommand(*devfileObj, "", v1alpha2.DeployCommandGroupKind)
+	if err != nil {
+		return api.DeployDiff{}, err
+	}
+
+	handler := &dryRunHandler{
+		ctx:        ctx,
+		kubeClient: o.kubeClient,
+		devfile:    *devfileObj,
+		path:       path,
+		diffs:      map[string]int{},
+	}
+
+	err = o.buildPushAutoImageComponents(handler, *devfileObj)
+	if err != nil {
+		return api.DeployDiff{}, err
+	}
+
+	err = o.applyAutoK8sOrOcComponents(handler, *devfileObj)
+	if err != nil {
+		return api.DeployDiff{}, err
+	}
+
+	err = libdevfile.Deploy(ctx, *devfileObj, handler)
+	if err != nil {
+		return api.DeployDiff{}, err
+	}
+
+	if prune {
+		toPrune, err := o.ListResourcesToPrune(ctx)
+		if err != nil {
+			return api.DeployDiff{}, err
+		}
+		for i := range toPrune {
+			diff, err := getResourceDiff(service.DryRunResult{Current: &toPrune[i]})
+			if err != nil {
+				return api.DeployDiff{}, err
+			}
+			handler.result.Resources = append(handler.result.Resources, diff)
+		}
+	}
+
+	if handler.result.Resources == nil {
+		handler.result.Resources = []api.ResourceDiff{}
+	}
+	return handler.result, nil
+}
+
+// dryRunHandler is a handler of the Deploy command which applies the resources in dry-run mode
+// and computes the changes they would make on the cluster, without building nor pushing the images,
+// and without running the commands
+type dryRunHandler struct {
+	ctx        context.Context
+	kubeClient kclient.ClientInterface
+	devfile    parser.DevfileObj
+	path       string
+
+	result api.DeployDiff
+	// diffs is the index of the diff of each resource in result, by kind and name,
+	// as a resource can be applied several times
+	diffs map[string]int
+}
+
+var _ libdevfile.Handler = (*dryRunHandler)(nil)
+
+func (o *dryRunHandler) ApplyImage(img v1alpha2.Component) error {
+	if img.Image == nil {
+		return nil
+	}
+	for _, name := range o.result.Images {
+		if name == img.Image.ImageName {
+			return nil
+		}
+	}
+	o.result.Images = append(o.result.Images, img.Image.ImageName)
+	return nil
+}
+
+func (o *dryRunHandler) ApplyKubernetes(kubernetes v1alpha2.Component, kind v1alpha2.CommandGroupKind) error {
+	var (
+		componentName = odocontext.GetComponentName(o.ctx)
+		appName       = odocontext.GetApplication(o.ctx)
+	)
+	results, err := component.DryRunKubernetes(odolabels.ComponentDeployMode, appName, componentName, o.devfile, kubernetes, o.kubeClient, o.path)
+	if err != nil {
+		return err
+	}
+	for _, res := range results {
+		diff, err := getResourceDiff(res)
+		if err != nil {
+			return err
+		}
+		key := diff.Kind + "/" + diff.Name
+		if i, found := o.diffs[key]; found {
+			o.result.Resources[i] = diff
+			continue
+		}
+		o.diffs[key] = len(o.result.Resources)
+		o.result.Resources = append(o.result.Resources, diff)
+	}
+	return nil
+}
+
+func (o *dryRunHandler) ApplyOpenShift(openshift v1alpha2.Component, kind v1alpha2.CommandGroupKind) error {
+	return o.ApplyKubernetes(openshift, kind)
+}
+
+func (o *dryRunHandler) ExecuteNonTerminatingCommand(ctx context.Context, command v1alpha2.Command) error {
+	klog.V(4).Infof("skipping the execution of command %q in dry-run mode", command.Id)
+	log.Warningf("Commands are not executed in dry-run mode. Skipping: %v.", command.Id)
+	return nil
+}
+
+func (o *dryRunHandler) ExecuteTerminatingCommand(ctx context.Context, command v1alpha2.Command) error {
+	return o.ExecuteNonTerminatingCommand(ctx, command)
+}
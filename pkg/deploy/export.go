@@ -0,0 +1,592 @@
+package deploy
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	devfilefs "github.com/devfile/library/v2/pkg/testingutil/filesystem"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog"
+	"sigs.k8s.io/yaml"
+
+	"github.com/redhat-developer/odo/pkg/component"
+	"github.com/redhat-developer/odo/pkg/devfile"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	"github.com/redhat-developer/odo/pkg/log"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+)
+
+// ExportFormat is the format of the manifests exported by the Deploy mode
+type ExportFormat string
+
+const (
+	// ExportFormatYAML exports each resource in a plain YAML file
+	ExportFormatYAML ExportFormat = "yaml"
+	// ExportFormatKustomize exports the resources along with a kustomization.yaml file referencing them
+	ExportFormatKustomize ExportFormat = "kustomize"
+	// ExportFormatHelm exports the resources as the templates of a Helm chart,
+	// with the images and the Devfile variables in the values of the chart
+	ExportFormatHelm ExportFormat = "helm"
+)
+
+// ExportFormats are the supported export formats
+var ExportFormats = []ExportFormat{ExportFormatYAML, ExportFormatKustomize, ExportFormatHelm}
+
+const (
+	// _helmChartVersion is the version of the exported Helm chart
+	_helmChartVersion = "0.1.0"
+	// _helmTemplatesDir is the directory of the templates of a Helm chart
+	_helmTemplatesDir = "templates"
+)
+
+func (o *DeployClient) Export(ctx context.Context, format ExportFormat, dir string, imageRegistry string) ([]string, error) {
+	var (
+		devfileObj  = odocontext.GetEffectiveDevfileObj(ctx)
+		devfilePath = odocontext.GetDevfilePath(ctx)
+		path        = filepath.Dir(devfilePath)
+	)
+
+	_, err := libdevfile.ValidateAndGetCommand(*devfileObj, "", v1alpha2.DeployCommandGroupKind)
+	if err != nil {
+		return nil, err
+	}
+
+	exported := *devfileObj
+	var placeholders variablePlaceholders
+	if format == ExportFormatHelm {
+		// The variables are kept as references to the values of the chart in the templates
+		exported, placeholders, err = parseWithVariablePlaceholders(*devfileObj, devfilePath, imageRegistry)
+		if err != nil {
+			log.Warningf("Unable to keep the Devfile variables in the templates, their values are rendered instead: %v", err)
+			exported, placeholders = *devfileObj, nil
+		}
+	}
+
+	handler, err := o.collect(ctx, exported, path)
+	if err != nil {
+		return nil, err
+	}
+	// The resources rendered with the values of the variables give the types of the fields templated in the Helm chart
+	rendered := handler
+	if placeholders != nil {
+		rendered, err = o.collect(ctx, *devfileObj, path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var files map[string][]byte
+	switch format {
+	case ExportFormatYAML:
+		files, err = exportYAML(handler.resources)
+	case ExportFormatKustomize:
+		files, err = exportKustomize(handler.resources)
+	case ExportFormatHelm:
+		files, err = exportHelm(odocontext.GetComponentName(ctx), *devfileObj, handler.resources, rendered.resources, handler.images, placeholders)
+	default:
+		return nil, fmt.Errorf("unsupported export format %q", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return o.writeFiles(dir, files)
+}
+
+// collect walks the Deploy command of the Devfile, and returns the handler holding the resources and images collected
+func (o *DeployClient) collect(ctx context.Context, devfileObj parser.DevfileObj, path string) (*exportHandler, error) {
+	handler := newExportHandler(ctx, devfileObj, path)
+
+	err := o.buildPushAutoImageComponents(handler, devfileObj)
+	if err != nil {
+		return nil, err
+	}
+
+	err = o.applyAutoK8sOrOcComponents(handler, devfileObj)
+	if err != nil {
+		return nil, err
+	}
+
+	err = libdevfile.Deploy(ctx, devfileObj, handler)
+	if err != nil {
+		return nil, err
+	}
+	return handler, nil
+}
+
+// exportHandler is a handler of the Deploy command which collects the resources to apply, with the labels and annotations
+// injected by odo, and the images to build, without applying the resources, building the images, nor running the commands
+type exportHandler struct {
+	ctx     context.Context
+	devfile parser.DevfileObj
+	path    string
+
+	resources []unstructured.Unstructured
+	// index is the index of each resource in resources, by kind and name,
+	// as a resource can be applied several times
+	index  map[string]int
+	images []exportedImage
+}
+
+// exportedImage is an image defined by an image component of the Devfile
+type exportedImage struct {
+	component string
+	reference string
+}
+
+var _ libdevfile.Handler = (*exportHandler)(nil)
+
+func newExportHandler(ctx context.Context, devfileObj parser.DevfileObj, path string) *exportHandler {
+	return &exportHandler{
+		ctx:     ctx,
+		devfile: devfileObj,
+		path:    path,
+		index:   map[string]int{},
+	}
+}
+
+func (o *exportHandler) ApplyImage(img v1alpha2.Component) error {
+	if img.Image == nil {
+		return nil
+	}
+	for _, exported := range o.images {
+		if exported.component == img.Name {
+			return nil
+		}
+	}
+	o.images = append(o.images, exportedImage{
+		component: img.Name,
+		reference: img.Image.ImageName,
+	})
+	return nil
+}
+
+func (o *exportHandler) ApplyKubernetes(kubernetes v1alpha2.Component, kind v1alpha2.CommandGroupKind) error {
+	var (
+		componentName = odocontext.GetComponentName(o.ctx)
+		appName       = odocontext.GetApplication(o.ctx)
+	)
+	uList, err := libdevfile.GetK8sComponentAsUnstructuredList(o.devfile, kubernetes.Name, o.path, devfilefs.DefaultFs{})
+	if err != nil {
+		return err
+	}
+	labels, annotations := component.GetKubernetesLabelsAndAnnotations(odolabels.ComponentDeployMode, appName, componentName, o.devfile)
+	for _, u := range uList {
+		u.SetLabels(mergeMaps(u.GetLabels(), labels))
+		u.SetAnnotations(mergeMaps(u.GetAnnotations(), annotations))
+
+		key := u.GetKind() + "/" + u.GetName()
+		if i, found := o.index[key]; found {
+			o.resources[i] = u
+			continue
+		}
+		o.index[key] = len(o.resources)
+		o.resources = append(o.resources, u)
+	}
+	return nil
+}
+
+func (o *exportHandler) ApplyOpenShift(openshift v1alpha2.Component, kind v1alpha2.CommandGroupKind) error {
+	return o.ApplyKubernetes(openshift, kind)
+}
+
+func (o *exportHandler) ExecuteNonTerminatingCommand(ctx context.Context, command v1alpha2.Command) error {
+	klog.V(4).Infof("skipping the execution of command %q during the export", command.Id)
+	log.Warningf("Commands are not exported. Skipping: %v.", command.Id)
+	return nil
+}
+
+func (o *exportHandler) ExecuteTerminatingCommand(ctx context.Context, command v1alpha2.Command) error {
+	return o.ExecuteNonTerminatingCommand(ctx, command)
+}
+
+func mergeMaps(maps ...map[string]string) map[string]string {
+	result := map[string]string{}
+	for _, m := range maps {
+		for k, v := range m {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// variablePlaceholders are the placeholders substituted to the Devfile variables, by variable name
+type variablePlaceholders map[string]string
+
+// parseWithVariablePlaceholders parses the Devfile again, with a unique placeholder as the value of each variable,
+// so that the variables can be located in the resources
+func parseWithVariablePlaceholders(devfileObj parser.DevfileObj, devfilePath string, imageRegistry string) (parser.DevfileObj, variablePlaceholders, error) {
+	variables := devfileObj.Data.GetDevfileWorkspaceSpec().Variables
+	if len(variables) == 0 {
+		return devfileObj, nil, nil
+	}
+	names := make([]string, 0, len(variables))
+	for name := range variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	placeholders := variablePlaceholders{}
+	for i, name := range names {
+		// The placeholder must be valid wherever a variable can be used (e.g. in a resource name)
+		placeholders[name] = fmt.Sprintf("odoexportvar%dend", i)
+	}
+	parsed, err := devfile.ParseAndValidateFromFileWithVariables(devfilePath, placeholders, imageRegistry, true)
+	if err != nil {
+		return parser.DevfileObj{}, nil, err
+	}
+	return parsed, placeholders, nil
+}
+
+// resourceFileName returns the name of the file of an exported resource
+func resourceFileName(u unstructured.Unstructured) string {
+	return fmt.Sprintf("%s-%s.yaml", strings.ToLower(u.GetKind()), u.GetName())
+}
+
+func exportYAML(resources []unstructured.Unstructured) (map[string][]byte, error) {
+	files := map[string][]byte{}
+	for _, u := range resources {
+		content, err := yaml.Marshal(u.Object)
+		if err != nil {
+			return nil, err
+		}
+		files[resourceFileName(u)] = content
+	}
+	return files, nil
+}
+
+// kustomization is the content of a kustomization.yaml file
+type kustomization struct {
+	APIVersion string   `json:"apiVersion"`
+	Kind       string   `json:"kind"`
+	Resources  []string `json:"resources"`
+}
+
+func exportKustomize(resources []unstructured.Unstructured) (map[string][]byte, error) {
+	files, err := exportYAML(resources)
+	if err != nil {
+		return nil, err
+	}
+	k := kustomization{
+		APIVersion: "kustomize.config.k8s.io/v1beta1",
+		Kind:       "Kustomization",
+		Resources:  make([]string, 0, len(resources)),
+	}
+	for _, u := range resources {
+		k.Resources = append(k.Resources, resourceFileName(u))
+	}
+	content, err := yaml.Marshal(k)
+	if err != nil {
+		return nil, err
+	}
+	files["kustomization.yaml"] = content
+	return files, nil
+}
+
+// helmChart is the content of a Chart.yaml file
+type helmChart struct {
+	APIVersion  string `json:"apiVersion"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Type        string `json:"type"`
+	Version     string `json:"version"`
+	AppVersion  string `json:"appVersion,omitempty"`
+}
+
+// exportHelm exports the resources as the templates of a Helm chart. In the templates, the references to the images
+// are replaced with the "images" values, and the placeholders of the variables with the "variables" values.
+// The rendered resources are the resources with the values of the variables, giving the types of the templated fields.
+func exportHelm(componentName string, devfileObj parser.DevfileObj, resources []unstructured.Unstructured, rendered []unstructured.Unstructured, images []exportedImage, placeholders variablePlaceholders) (map[string][]byte, error) {
+	var (
+		// toValues replaces the placeholders of the variables with their values
+		toValues  []string
+		variables = devfileObj.Data.GetDevfileWorkspaceSpec().Variables
+	)
+	for name, placeholder := range placeholders {
+		toValues = append(toValues, placeholder, variables[name])
+	}
+	valuesReplacer := strings.NewReplacer(toValues...)
+
+	templater := newHelmTemplater(valuesReplacer)
+	imagePlaceholders := map[string]string{}
+	for i, img := range images {
+		placeholder := fmt.Sprintf("odoexportimage%dend", i)
+		imagePlaceholders[img.reference] = placeholder
+		templater.add(placeholder, fmt.Sprintf("index .Values.images %q", img.component))
+	}
+	for name, placeholder := range placeholders {
+		templater.add(placeholder, fmt.Sprintf("index .Values.variables %q", name))
+	}
+
+	renderedResources := map[string]map[string]interface{}{}
+	for _, u := range rendered {
+		renderedResources[u.GetKind()+"/"+u.GetName()] = u.Object
+	}
+
+	files := map[string][]byte{}
+	for _, u := range resources {
+		obj := replaceStrings(u.DeepCopy().Object, imagePlaceholders)
+		renderedObj := renderedResources[u.GetKind()+"/"+valuesReplacer.Replace(u.GetName())]
+		content, err := yaml.Marshal(templater.tokenize(obj, renderedObj))
+		if err != nil {
+			return nil, err
+		}
+		name := valuesReplacer.Replace(resourceFileName(u))
+		files[filepath.Join(_helmTemplatesDir, name)] = []byte(templater.render(string(content)))
+	}
+
+	values := map[string]interface{}{}
+	if len(images) > 0 {
+		imageValues := map[string]string{}
+		for _, img := range images {
+			imageValues[img.component] = valuesReplacer.Replace(img.reference)
+		}
+		values["images"] = imageValues
+	}
+	if len(variables) > 0 {
+		values["variables"] = variables
+	}
+	content, err := yaml.Marshal(values)
+	if err != nil {
+		return nil, err
+	}
+	files["values.yaml"] = content
+
+	chart := helmChart{
+		APIVersion:  "v2",
+		Name:        componentName,
+		Description: fmt.Sprintf("Deploy mode of the %s component, exported by odo", componentName),
+		Type:        "application",
+		Version:     _helmChartVersion,
+		AppVersion:  devfileObj.Data.GetMetadata().Version,
+	}
+	content, err = yaml.Marshal(chart)
+	if err != nil {
+		return nil, err
+	}
+	files["Chart.yaml"] = content
+	return files, nil
+}
+
+// helmTemplater turns the strings of the resources containing placeholders into templates referencing the values of the chart.
+// The strings are first replaced with tokens, which yaml.Marshal writes as plain scalars,
+// and the tokens are replaced with the templates once the resources are marshaled.
+type helmTemplater struct {
+	// values replaces the placeholders of the variables with their values
+	values *strings.Replacer
+	// expressions are the expressions of the values of the chart, by placeholder
+	expressions map[string]string
+	// placeholdersRegexp matches the placeholders
+	placeholdersRegexp *regexp.Regexp
+	// templates are the pairs of tokens and templates replacing them
+	templates []string
+}
+
+func newHelmTemplater(values *strings.Replacer) *helmTemplater {
+	return &helmTemplater{
+		values:      values,
+		expressions: map[string]string{},
+	}
+}
+
+// add registers the expression of the value of the chart replacing the placeholder
+func (o *helmTemplater) add(placeholder string, expression string) {
+	o.expressions[placeholder] = expression
+	o.placeholdersRegexp = nil
+}
+
+// tokenize replaces the strings of obj, keys and values, containing placeholders with tokens.
+// rendered is obj rendered with the values of the variables: the templates are quoted, unless the rendered field is not a string
+// (e.g. a number of replicas), as the values of the chart are strings.
+func (o *helmTemplater) tokenize(obj interface{}, rendered interface{}) interface{} {
+	switch v := obj.(type) {
+	case map[string]interface{}:
+		renderedMap, _ := rendered.(map[string]interface{})
+		result := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			result[o.tokenizeString(key, true)] = o.tokenize(value, renderedMap[o.values.Replace(key)])
+		}
+		return result
+	case []interface{}:
+		renderedSlice, _ := rendered.([]interface{})
+		for i, value := range v {
+			var renderedValue interface{}
+			if len(renderedSlice) == len(v) {
+				renderedValue = renderedSlice[i]
+			}
+			v[i] = o.tokenize(value, renderedValue)
+		}
+	case string:
+		quoted := true
+		switch rendered.(type) {
+		case bool, int, int64, float64:
+			quoted = false
+		}
+		return o.tokenizeString(v, quoted)
+	}
+	return obj
+}
+
+func (o *helmTemplater) tokenizeString(s string, quoted bool) string {
+	if len(o.expressions) == 0 {
+		return s
+	}
+	if o.placeholdersRegexp == nil {
+		placeholders := make([]string, 0, len(o.expressions))
+		for placeholder := range o.expressions {
+			placeholders = append(placeholders, regexp.QuoteMeta(placeholder))
+		}
+		o.placeholdersRegexp = regexp.MustCompile(strings.Join(placeholders, "|"))
+	}
+	matches := o.placeholdersRegexp.FindAllStringIndex(s, -1)
+	if len(matches) == 0 {
+		return s
+	}
+
+	var expression string
+	if len(matches) == 1 && matches[0][0] == 0 && matches[0][1] == len(s) {
+		expression = o.expressions[s]
+	} else {
+		// The string is built with printf, the values being only a part of it
+		var (
+			format strings.Builder
+			args   []string
+			last   int
+		)
+		for _, match := range matches {
+			format.WriteString(strings.ReplaceAll(s[last:match[0]], "%", "%%"))
+			format.WriteString("%v")
+			args = append(args, "("+o.expressions[s[match[0]:match[1]]]+")")
+			last = match[1]
+		}
+		format.WriteString(strings.ReplaceAll(s[last:], "%", "%%"))
+		expression = fmt.Sprintf("printf %s %s", strconv.Quote(format.String()), strings.Join(args, " "))
+	}
+	if quoted {
+		expression += " | quote"
+	}
+	token := fmt.Sprintf("odoexporttemplate%dend", len(o.templates)/2)
+	o.templates = append(o.templates, token, "{{ "+expression+" }}")
+	return token
+}
+
+// render escapes the template delimiters present in the content, so that they are written as is by Helm,
+// and replaces the tokens with the templates
+func (o *helmTemplater) render(content string) string {
+	content = strings.ReplaceAll(content, "{{", `{{ "{{" }}`)
+	return strings.NewReplacer(o.templates...).Replace(content)
+}
+
+// replaceStrings replaces the string values of obj equal to a key of replacements
+func replaceStrings(obj interface{}, replacements map[string]string) interface{} {
+	switch v := obj.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			v[key] = replaceStrings(value, replacements)
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = replaceStrings(value, replacements)
+		}
+	case string:
+		if replacement, found := replacements[v]; found {
+			return replacement
+		}
+	}
+	return obj
+}
+
+// writeFiles writes the files into dir, after removing the files of a previous export, and returns the paths of the files written
+func (o *DeployClient) writeFiles(dir string, files map[string][]byte) ([]string, error) {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	err := o.removeExportedFiles(dir, files)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]string, 0, len(names))
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+		err := o.fs.MkdirAll(filepath.Dir(path), 0755)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create the directory of %q: %w", path, err)
+		}
+		err = o.fs.WriteFile(path, files[name], 0644)
+		if err != nil {
+			return nil, fmt.Errorf("unable to write %q: %w", path, err)
+		}
+		result = append(result, path)
+	}
+	return result, nil
+}
+
+// removeExportedFiles removes from dir the files of a previous export which would not be overwritten by files,
+// so that the resources removed from the Devfile since then are not left behind: the resources deployed by odo,
+// and the templates of these resources when a Helm chart is exported. The other files (e.g. the helpers of a chart) are kept.
+func (o *DeployClient) removeExportedFiles(dir string, files map[string][]byte) error {
+	err := o.removeExportedResources(dir, "", files)
+	if err != nil {
+		return err
+	}
+	if !hasFileIn(files, _helmTemplatesDir) {
+		return nil
+	}
+	return o.removeExportedResources(dir, _helmTemplatesDir, files)
+}
+
+// templateActionsRegexp matches the actions of a template
+var templateActionsRegexp = regexp.MustCompile(`\{\{.*?\}\}`)
+
+// removeExportedResources removes the YAML files of the resources deployed by odo from the subdirectory subdir of dir,
+// which would not be overwritten by files
+func (o *DeployClient) removeExportedResources(dir string, subdir string, files map[string][]byte) error {
+	entries, err := o.fs.ReadDir(filepath.Join(dir, subdir))
+	if err != nil {
+		// nothing to remove
+		return nil
+	}
+	for _, entry := range entries {
+		name := filepath.Join(subdir, entry.Name())
+		if _, found := files[name]; found || entry.IsDir() || filepath.Ext(name) != ".yaml" {
+			continue
+		}
+		path := filepath.Join(dir, name)
+		content, err := o.fs.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("unable to read %q: %w", path, err)
+		}
+		// The actions of the templates are replaced, for the templates of a chart to be parsed
+		content = templateActionsRegexp.ReplaceAll(content, []byte("odoexporttemplate"))
+		var u unstructured.Unstructured
+		if yaml.Unmarshal(content, &u.Object) != nil || odolabels.GetMode(u.GetLabels()) != odolabels.ComponentDeployMode {
+			// not a resource exported by odo
+			continue
+		}
+		err = o.fs.Remove(path)
+		if err != nil {
+			return fmt.Errorf("unable to remove the previously exported resource %q: %w", path, err)
+		}
+	}
+	return nil
+}
+
+// hasFileIn returns true if one of the files is in the directory dir
+func hasFileIn(files map[string][]byte, dir string) bool {
+	for name := range files {
+		if filepath.Dir(name) == dir {
+			return true
+		}
+	}
+	return false
+}
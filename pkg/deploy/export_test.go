@@ -0,0 +1,230 @@
+package deploy
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/redhat-developer/odo/pkg/devfile"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+)
+
+const exportDevfile = `schemaVersion: 2.2.0
+metadata:
+  name: my-component
+  version: 1.2.3
+variables:
+  REPLICAS: "2"
+  IMAGE_TAG: v1
+  PORT: "8080"
+  VERSION: "1.0"
+components:
+- name: runtime
+  container:
+    image: registry.access.redhat.com/ubi8/nodejs-16:latest
+- name: my-image
+  image:
+    imageName: quay.io/user/my-image:{{IMAGE_TAG}}
+    dockerfile:
+      uri: Dockerfile
+- name: my-k8s
+  kubernetes:
+    inlined: |
+      apiVersion: apps/v1
+      kind: Deployment
+      metadata:
+        name: my-deploy
+        labels:
+          app.kubernetes.io/version: "{{VERSION}}"
+      spec:
+        replicas: {{REPLICAS}}
+        template:
+          spec:
+            containers:
+            - name: main
+              image: quay.io/user/my-image:{{IMAGE_TAG}}
+              env:
+              - name: PORT
+                value: "{{PORT}}"
+- name: my-svc
+  kubernetes:
+    deployByDefault: false
+    inlined: |
+      apiVersion: v1
+      kind: Service
+      metadata:
+        name: my-svc
+commands:
+- id: build-image
+  apply:
+    component: my-image
+- id: deploy-k8s
+  apply:
+    component: my-k8s
+- id: deploy-svc
+  apply:
+    component: my-svc
+- id: deploy
+  composite:
+    commands:
+    - build-image
+    - deploy-k8s
+    - deploy-svc
+    group:
+      kind: deploy
+      isDefault: true
+`
+
+func TestDeployClient_Export(t *testing.T) {
+	tests := []struct {
+		name   string
+		format ExportFormat
+		// wantFiles are the files which must be exported, with a content they must contain
+		wantFiles map[string][]string
+	}{
+		{
+			name:   "yaml",
+			format: ExportFormatYAML,
+			wantFiles: map[string][]string{
+				"deployment-my-deploy.yaml": {"replicas: 2", "image: quay.io/user/my-image:v1", `value: "8080"`, "odo.dev/mode: Deploy", "odo.dev/project-type: Not available"},
+				"service-my-svc.yaml":       {"app.kubernetes.io/instance: my-component"},
+			},
+		},
+		{
+			name:   "kustomize",
+			format: ExportFormatKustomize,
+			wantFiles: map[string][]string{
+				"deployment-my-deploy.yaml": {"replicas: 2"},
+				"service-my-svc.yaml":       {"name: my-svc"},
+				"kustomization.yaml":        {"kind: Kustomization", "- deployment-my-deploy.yaml", "- service-my-svc.yaml"},
+			},
+		},
+		{
+			name:   "helm",
+			format: ExportFormatHelm,
+			wantFiles: map[string][]string{
+				"Chart.yaml":  {"name: my-component", "appVersion: 1.2.3"},
+				"values.yaml": {"my-image: quay.io/user/my-image:v1", "REPLICAS: \"2\"", "IMAGE_TAG: v1"},
+				"templates/deployment-my-deploy.yaml": {
+					`replicas: {{ index .Values.variables "REPLICAS" }}`,
+					`image: {{ index .Values.images "my-image" | quote }}`,
+					// the env vars and the labels are strings, whatever their values
+					`value: {{ index .Values.variables "PORT" | quote }}`,
+					`app.kubernetes.io/version: {{ index .Values.variables "VERSION" | quote }}`,
+				},
+				"templates/service-my-svc.yaml": {"name: my-svc"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			devfilePath := filepath.Join(tmpDir, "devfile.yaml")
+			if err := os.WriteFile(devfilePath, []byte(exportDevfile), 0644); err != nil {
+				t.Fatal(err)
+			}
+			devfileObj, err := devfile.ParseAndValidateFromFileWithVariables(devfilePath, nil, "", true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ctx := odocontext.WithEffectiveDevfileObj(context.Background(), &devfileObj)
+			ctx = odocontext.WithDevfilePath(ctx, devfilePath)
+			ctx = odocontext.WithApplication(ctx, "app")
+			ctx = odocontext.WithComponentName(ctx, "my-component")
+
+			fs := filesystem.NewFakeFs()
+			// files of a previous export, which must be removed
+			for name, content := range map[string]string{
+				"deployment-old.yaml":      "kind: Deployment\nmetadata:\n  labels:\n    odo.dev/mode: Deploy\n",
+				"templates/old.yaml":       "kind: Deployment\nmetadata:\n  labels:\n    odo.dev/mode: Deploy\nspec:\n  replicas: {{ .Values.replicas }}\n",
+				"templates/_helpers.tpl":   "{{- define \"name\" -}}\n",
+				"templates/NOTES.txt":      "Deployed\n",
+				"not-exported-by-odo.yaml": "kind: Deployment\n",
+			} {
+				if err := fs.MkdirAll(filepath.Dir(filepath.Join("/out", name)), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := fs.WriteFile(filepath.Join("/out", name), []byte(content), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			o := NewDeployClient(nil, nil, fs)
+			got, err := o.Export(ctx, tt.format, "/out", "")
+			if err != nil {
+				t.Fatalf("Export() unexpected error: %v", err)
+			}
+			if len(got) != len(tt.wantFiles) {
+				t.Errorf("Export() returned %d files, want %d: %v", len(got), len(tt.wantFiles), got)
+			}
+			for name, contents := range tt.wantFiles {
+				content, err := fs.ReadFile(filepath.Join("/out", name))
+				if err != nil {
+					t.Errorf("file %q not exported: %v", name, err)
+					continue
+				}
+				for _, want := range contents {
+					if !strings.Contains(string(content), want) {
+						t.Errorf("file %q should contain %q, but is:\n%s", name, want, content)
+					}
+				}
+			}
+			if _, err := fs.Stat("/out/deployment-old.yaml"); err == nil {
+				t.Errorf("previously exported resource not removed")
+			}
+			if _, err := fs.Stat("/out/templates/old.yaml"); (err == nil) == (tt.format == ExportFormatHelm) {
+				t.Errorf("previously exported template should be removed only when exporting a Helm chart")
+			}
+			for _, name := range []string{"not-exported-by-odo.yaml", "templates/_helpers.tpl", "templates/NOTES.txt"} {
+				if _, err := fs.Stat(filepath.Join("/out", name)); err != nil {
+					t.Errorf("file %q not exported by odo should be kept: %v", name, err)
+				}
+			}
+		})
+	}
+}
+
+func Test_helmTemplater(t *testing.T) {
+	templater := newHelmTemplater(strings.NewReplacer("odoexportvar0end", "2", "odoexportvar1end", "my-app"))
+	templater.add("odoexportimage0end", `index .Values.images "my-image"`)
+	templater.add("odoexportvar0end", `index .Values.variables "REPLICAS"`)
+	templater.add("odoexportvar1end", `index .Values.variables "NAME"`)
+
+	obj := map[string]interface{}{
+		"replicas": "odoexportvar0end",
+		"image":    "odoexportimage0end",
+		"name":     "odoexportvar1end",
+		"host":     "odoexportvar1end-100%.example.com",
+		"command":  []interface{}{"echo", "{{ not a template }}"},
+		"labels":   map[string]interface{}{"odoexportvar1end": "true"},
+	}
+	rendered := map[string]interface{}{
+		"replicas": int64(2),
+		"image":    "quay.io/user/my-image:v1",
+		"name":     "my-app",
+		"host":     "my-app-100%.example.com",
+		"command":  []interface{}{"echo", "{{ not a template }}"},
+		"labels":   map[string]interface{}{"my-app": "true"},
+	}
+	content, err := yaml.Marshal(templater.tokenize(obj, rendered))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := templater.render(string(content))
+	for _, want := range []string{
+		`replicas: {{ index .Values.variables "REPLICAS" }}`,
+		`image: {{ index .Values.images "my-image" | quote }}`,
+		`name: {{ index .Values.variables "NAME" | quote }}`,
+		`host: {{ printf "%v-100%%.example.com" (index .Values.variables "NAME") | quote }}`,
+		`- '{{ "{{" }} not a template }}'`,
+		`{{ index .Values.variables "NAME" | quote }}: "true"`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("template should contain %q, but is:\n%s", want, got)
+		}
+	}
+}
@@ -2,6 +2,11 @@ package deploy
 
 import (
 	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/redhat-developer/odo/pkg/api"
 )
 
 type Client interface {
@@ -9,4 +14,28 @@ type Client interface {
 	// The filesystem specified is used to download and store the Dockerfiles needed to build the necessary container images,
 	// in case such Dockerfiles are referenced as remote URLs in the Devfile.
 	Deploy(ctx context.Context) error
+	// DryRun applies the resources of the Deploy mode in dry-run mode on the cluster, without building and pushing the images,
+	// and returns the changes the Deploy mode would make to the resources currently deployed.
+	// If prune is true, the resources which would be pruned are returned as deleted.
+	DryRun(ctx context.Context, prune bool) (api.DeployDiff, error)
+	// Export writes the resources of the Deploy mode, with the labels and annotations injected by odo, into dir in the given format,
+	// without applying the resources, building the images, nor running the commands, and returns the paths of the files written.
+	// imageRegistry is the registry used to resolve the relative image names of the Devfile.
+	Export(ctx context.Context, format ExportFormat, dir string, imageRegistry string) ([]string, error)
+	// ListResourcesToPrune returns the resources deployed by the Deploy mode of the component
+	// which are not defined in the Devfile anymore.
+	ListResourcesToPrune(ctx context.Context) ([]unstructured.Unstructured, error)
+	// Prune deletes the resources, and returns an error reporting the error of each resource that failed to be deleted
+	Prune(resources []unstructured.Unstructured) error
+	// Wait waits for the workloads (Deployments, StatefulSets, DaemonSets and Jobs) deployed by the Deploy mode to be rolled out,
+	// and returns an error if a rollout fails, if a pod cannot start, or if the rollouts are not complete before the timeout.
+	// The failing pods are reported with their last events and log lines.
+	Wait(ctx context.Context, timeout time.Duration) error
+	// ListRevisions returns the deployments recorded for the component, sorted by revision.
+	// Each successful deployment is recorded as a new revision, and only the last revisions are kept.
+	ListRevisions(ctx context.Context) ([]api.DeployRevision, error)
+	// Rollback reapplies the resources of the given revision, or of the revision preceding the last one if revision is zero,
+	// and records the rollback as a new revision. The images are not rebuilt, but pinned to the digests pushed by the revision, when known.
+	// It returns the revision which has been reapplied.
+	Rollback(ctx context.Context, revision int) (api.DeployRevision, error)
 }
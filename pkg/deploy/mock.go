@@ -7,8 +7,11 @@ package deploy
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
+	api "github.com/redhat-developer/odo/pkg/api"
+	unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 // MockClient is a mock of Client interface.
@@ -47,3 +50,106 @@ func (mr *MockClientMockRecorder) Deploy(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deploy", reflect.TypeOf((*MockClient)(nil).Deploy), ctx)
 }
+
+// DryRun mocks base method.
+func (m *MockClient) DryRun(ctx context.Context, prune bool) (api.DeployDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DryRun", ctx, prune)
+	ret0, _ := ret[0].(api.DeployDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DryRun indicates an expected call of DryRun.
+func (mr *MockClientMockRecorder) DryRun(ctx, prune interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DryRun", reflect.TypeOf((*MockClient)(nil).DryRun), ctx, prune)
+}
+
+// Export mocks base method.
+func (m *MockClient) Export(ctx context.Context, format ExportFormat, dir, imageRegistry string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, format, dir, imageRegistry)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockClientMockRecorder) Export(ctx, format, dir, imageRegistry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockClient)(nil).Export), ctx, format, dir, imageRegistry)
+}
+
+// ListResourcesToPrune mocks base method.
+func (m *MockClient) ListResourcesToPrune(ctx context.Context) ([]unstructured.Unstructured, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResourcesToPrune", ctx)
+	ret0, _ := ret[0].([]unstructured.Unstructured)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResourcesToPrune indicates an expected call of ListResourcesToPrune.
+func (mr *MockClientMockRecorder) ListResourcesToPrune(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourcesToPrune", reflect.TypeOf((*MockClient)(nil).ListResourcesToPrune), ctx)
+}
+
+// ListRevisions mocks base method.
+func (m *MockClient) ListRevisions(ctx context.Context) ([]api.DeployRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRevisions", ctx)
+	ret0, _ := ret[0].([]api.DeployRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRevisions indicates an expected call of ListRevisions.
+func (mr *MockClientMockRecorder) ListRevisions(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRevisions", reflect.TypeOf((*MockClient)(nil).ListRevisions), ctx)
+}
+
+// Prune mocks base method.
+func (m *MockClient) Prune(resources []unstructured.Unstructured) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Prune", resources)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Prune indicates an expected call of Prune.
+func (mr *MockClientMockRecorder) Prune(resources interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Prune", reflect.TypeOf((*MockClient)(nil).Prune), resources)
+}
+
+// Rollback mocks base method.
+func (m *MockClient) Rollback(ctx context.Context, revision int) (api.DeployRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rollback", ctx, revision)
+	ret0, _ := ret[0].(api.DeployRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rollback indicates an expected call of Rollback.
+func (mr *MockClientMockRecorder) Rollback(ctx, revision interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockClient)(nil).Rollback), ctx, revision)
+}
+
+// Wait mocks base method.
+func (m *MockClient) Wait(ctx context.Context, timeout time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Wait", ctx, timeout)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Wait indicates an expected call of Wait.
+func (mr *MockClientMockRecorder) Wait(ctx, timeout interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Wait", reflect.TypeOf((*MockClient)(nil).Wait), ctx, timeout)
+}
@@ -0,0 +1,140 @@
+package deploy
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+	devfilefs "github.com/devfile/library/v2/pkg/testingutil/filesystem"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog"
+
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+)
+
+// _deployPruneAttribute is the top-level attribute of the Devfile enabling the pruning of the resources
+// which are not part of the Devfile anymore when running the Deploy mode
+const _deployPruneAttribute = "deploy.odo.prune"
+
+// IsPruneEnabled returns true if the Devfile enables the pruning of the resources with the "deploy.odo.prune" attribute
+func IsPruneEnabled(devfileObj parser.DevfileObj) (bool, error) {
+	// Top-level attributes are not supported by the 2.0.0 schema
+	if devfileObj.Data.GetSchemaVersion() == string(data.APISchemaVersion200) {
+		return false, nil
+	}
+	attributes, err := devfileObj.Data.GetAttributes()
+	if err != nil {
+		return false, err
+	}
+	if !attributes.Exists(_deployPruneAttribute) {
+		return false, nil
+	}
+	var attrErr error
+	prune := attributes.GetBoolean(_deployPruneAttribute, &attrErr)
+	if attrErr != nil {
+		return false, fmt.Errorf("invalid value for the %q attribute: %w", _deployPruneAttribute, attrErr)
+	}
+	return prune, nil
+}
+
+func (o *DeployClient) ListResourcesToPrune(ctx context.Context) ([]unstructured.Unstructured, error) {
+	var (
+		componentName = odocontext.GetComponentName(ctx)
+		appName       = odocontext.GetApplication(ctx)
+	)
+
+	selector := odolabels.GetSelector(componentName, appName, odolabels.ComponentDeployMode, false)
+	remoteResources, err := o.kubeClient.GetAllResourcesFromSelector(selector, o.kubeClient.GetCurrentNamespace())
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch remote resources: %w", err)
+	}
+
+	devfileResources, err := getDevfileResources(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return getResourcesNotInDevfile(remoteResources, devfileResources), nil
+}
+
+// getDevfileResources returns the resources defined by all the Kubernetes and OpenShift components of the Devfile
+func getDevfileResources(ctx context.Context) ([]unstructured.Unstructured, error) {
+	var (
+		devfileObj  = odocontext.GetEffectiveDevfileObj(ctx)
+		devfilePath = odocontext.GetDevfilePath(ctx)
+		path        = filepath.Dir(devfilePath)
+	)
+
+	devfileComponents, err := libdevfile.GetK8sAndOcComponentsToPush(*devfileObj, true)
+	if err != nil {
+		return nil, fmt.Errorf("unable to obtain resources from the Devfile: %w", err)
+	}
+	var devfileResources []unstructured.Unstructured
+	for _, c := range devfileComponents {
+		uList, err := libdevfile.GetK8sComponentAsUnstructuredList(*devfileObj, c.Name, path, devfilefs.DefaultFs{})
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the resource: %w", err)
+		}
+		devfileResources = append(devfileResources, uList...)
+	}
+	return devfileResources, nil
+}
+
+// getResourcesNotInDevfile returns the remote resources created by odo which are not defined in the Devfile anymore.
+// The resources being deleted, the resources not created directly by odo (without the project type annotation)
+// and the resources owned by another resource (whose deletion is handled by the garbage collector) are ignored.
+func getResourcesNotInDevfile(remoteResources, devfileResources []unstructured.Unstructured) []unstructured.Unstructured {
+	var result []unstructured.Unstructured
+	// The same resource can be served by several APIs
+	seen := map[types.UID]bool{}
+	for _, remote := range remoteResources {
+		if remote.GetDeletionTimestamp() != nil ||
+			!odolabels.IsProjectTypeSetInAnnotations(remote.GetAnnotations()) ||
+			len(remote.GetOwnerReferences()) > 0 ||
+			seen[remote.GetUID()] {
+			continue
+		}
+		seen[remote.GetUID()] = true
+
+		found := false
+		for _, devfileResource := range devfileResources {
+			// only check against GroupKind because version might not always match
+			if devfileResource.GroupVersionKind().GroupKind() == remote.GroupVersionKind().GroupKind() &&
+				devfileResource.GetName() == remote.GetName() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			result = append(result, remote)
+		}
+	}
+	return result
+}
+
+func (o *DeployClient) Prune(resources []unstructured.Unstructured) error {
+	var failures []string
+	for _, resource := range resources {
+		gvr, err := o.kubeClient.GetRestMappingFromUnstructured(resource)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%s %q: %v", resource.GetKind(), resource.GetName(), err))
+			continue
+		}
+		err = o.kubeClient.DeleteDynamicResource(resource.GetName(), gvr.Resource, false)
+		if err != nil && !kerrors.IsNotFound(err) {
+			klog.V(3).Infof("failed to delete resource %q (%s.%s.%s): %v", resource.GetName(), gvr.Resource.Group, gvr.Resource.Version, gvr.Resource.Resource, err)
+			failures = append(failures, fmt.Sprintf("%s %q: %v", resource.GetKind(), resource.GetName(), err))
+		}
+	}
+	if len(failures) != 0 {
+		return fmt.Errorf("failed to delete %d resource(s):\n- %s", len(failures), strings.Join(failures, "\n- "))
+	}
+	return nil
+}
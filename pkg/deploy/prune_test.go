@@ -0,0 +1,169 @@
+package deploy
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/attributes"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+	v2 "github.com/devfile/library/v2/pkg/devfile/parser/data/v2"
+	"github.com/golang/mock/gomock"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/redhat-developer/odo/pkg/kclient"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+)
+
+func TestIsPruneEnabled(t *testing.T) {
+	tests := []struct {
+		name          string
+		schemaVersion string
+		attributes    map[string]interface{}
+		want          bool
+		wantErr       bool
+	}{
+		{
+			name: "no attribute",
+			want: false,
+		},
+		{
+			name:          "schema without top-level attributes",
+			schemaVersion: string(data.APISchemaVersion200),
+			want:          false,
+		},
+		{
+			name:       "attribute set to true",
+			attributes: map[string]interface{}{_deployPruneAttribute: true},
+			want:       true,
+		},
+		{
+			name:       "attribute set to false",
+			attributes: map[string]interface{}{_deployPruneAttribute: false},
+			want:       false,
+		},
+		{
+			name:       "invalid attribute",
+			attributes: map[string]interface{}{_deployPruneAttribute: "yes"},
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schemaVersion := tt.schemaVersion
+			if schemaVersion == "" {
+				schemaVersion = string(data.APISchemaVersion220)
+			}
+			devfileData, err := data.NewDevfileData(schemaVersion)
+			if err != nil {
+				t.Fatal(err)
+			}
+			devfileData.(*v2.DevfileV2).Attributes = attributes.Attributes{}
+			for k, v := range tt.attributes {
+				if err = devfileData.AddAttributes(k, v); err != nil {
+					t.Fatal(err)
+				}
+			}
+			got, err := IsPruneEnabled(parser.DevfileObj{Data: devfileData})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IsPruneEnabled() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("IsPruneEnabled() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func newRemoteResource(apiVersion, kind, name string, uid string, projectType bool) unstructured.Unstructured {
+	u := unstructured.Unstructured{}
+	u.SetAPIVersion(apiVersion)
+	u.SetKind(kind)
+	u.SetName(name)
+	u.SetUID(types.UID(uid))
+	if projectType {
+		annotations := map[string]string{}
+		odolabels.SetProjectType(annotations, "nodejs")
+		u.SetAnnotations(annotations)
+	}
+	return u
+}
+
+func Test_getResourcesNotInDevfile(t *testing.T) {
+	deleting := newRemoteResource("v1", "ConfigMap", "deleting", "uid-deleting", true)
+	now := metav1.Now()
+	deleting.SetDeletionTimestamp(&now)
+
+	owned := newRemoteResource("apps/v1", "ReplicaSet", "owned", "uid-owned", true)
+	owned.SetOwnerReferences([]metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "Deployment", Name: "removed", UID: "uid-deploy"}})
+
+	remoteResources := []unstructured.Unstructured{
+		newRemoteResource("apps/v1", "Deployment", "kept", "uid-kept", true),
+		// The version of the resource in the Devfile can be different
+		newRemoteResource("networking.k8s.io/v1", "Ingress", "kept", "uid-ingress", true),
+		newRemoteResource("apps/v1", "Deployment", "removed", "uid-deploy", true),
+		// The same resource served by another API
+		newRemoteResource("extensions/v1beta1", "Deployment", "removed", "uid-deploy", true),
+		// A resource with the same name but of another kind
+		newRemoteResource("v1", "Service", "kept", "uid-service", true),
+		// Not created by odo
+		newRemoteResource("metrics.k8s.io/v1beta1", "PodMetrics", "metrics", "uid-metrics", false),
+		deleting,
+		owned,
+	}
+	devfileResources := []unstructured.Unstructured{
+		newRemoteResource("apps/v1", "Deployment", "kept", "", false),
+		newRemoteResource("networking.k8s.io/v1beta1", "Ingress", "kept", "", false),
+	}
+
+	got := getResourcesNotInDevfile(remoteResources, devfileResources)
+	want := []string{"Deployment/removed", "Service/kept"}
+	if len(got) != len(want) {
+		t.Fatalf("getResourcesNotInDevfile() returned %d resources, want %d: %v", len(got), len(want), got)
+	}
+	for i, u := range got {
+		if u.GetKind()+"/"+u.GetName() != want[i] {
+			t.Errorf("getResourcesNotInDevfile()[%d] = %s/%s, want %s", i, u.GetKind(), u.GetName(), want[i])
+		}
+	}
+}
+
+func TestDeployClient_Prune(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kubeClient := kclient.NewMockClientInterface(ctrl)
+	kubeClient.EXPECT().GetRestMappingFromUnstructured(gomock.Any()).DoAndReturn(func(u unstructured.Unstructured) (*meta.RESTMapping, error) {
+		if u.GetKind() == "Unknown" {
+			return nil, errors.New("no matches for kind")
+		}
+		return &meta.RESTMapping{Resource: schema.GroupVersionResource{Version: "v1", Resource: strings.ToLower(u.GetKind()) + "s"}}, nil
+	}).AnyTimes()
+	kubeClient.EXPECT().DeleteDynamicResource("deleted", gomock.Any(), false).Return(nil)
+	kubeClient.EXPECT().DeleteDynamicResource("gone", gomock.Any(), false).
+		Return(kerrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, "gone"))
+	kubeClient.EXPECT().DeleteDynamicResource("forbidden", gomock.Any(), false).
+		Return(kerrors.NewForbidden(schema.GroupResource{Resource: "secrets"}, "forbidden", errors.New("access denied")))
+
+	o := NewDeployClient(kubeClient, nil, nil)
+	err := o.Prune([]unstructured.Unstructured{
+		newRemoteResource("v1", "ConfigMap", "deleted", "uid-deleted", true),
+		newRemoteResource("v1", "ConfigMap", "gone", "uid-gone", true),
+		newRemoteResource("v1", "Secret", "forbidden", "uid-forbidden", true),
+		newRemoteResource("v1", "Unknown", "unknown", "uid-unknown", true),
+	})
+	if err == nil {
+		t.Fatal("Prune() expected an error")
+	}
+	// a resource already deleted is not a failure, the error of each failed resource is reported
+	for _, want := range []string{"failed to delete 2 resource(s)", `Secret "forbidden"`, "access denied", `Unknown "unknown": no matches for kind`} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Prune() error = %q, want it to contain %q", err.Error(), want)
+		}
+	}
+}
@@ -0,0 +1,370 @@
+package deploy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	devfilefs "github.com/devfile/library/v2/pkg/testingutil/filesystem"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/component"
+	"github.com/redhat-developer/odo/pkg/devfile/image"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	"github.com/redhat-developer/odo/pkg/log"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+)
+
+const (
+	// maxRevisions is the number of revisions kept for a component, the older revisions being deleted
+	maxRevisions = 10
+	// _revisionDataKey is the key of the Secret data containing the revision
+	_revisionDataKey = "revision.json"
+)
+
+// revisionData is the content of a revision, stored in a Secret
+type revisionData struct {
+	// Images are the references of the images built and pushed by the deployment
+	Images []string `json:"images,omitempty"`
+	// ImageDigests are the digests of the pushed images, by reference, used to pin the images when rolling back.
+	// The images whose digest cannot be resolved are not part of it.
+	ImageDigests map[string]string `json:"imageDigests,omitempty"`
+	// Manifests are the resources applied by the deployment, with the variables substituted,
+	// but without the labels and annotations injected by odo
+	Manifests []map[string]interface{} `json:"manifests"`
+	// RollbackOf is the revision reapplied by this revision, or zero if the revision is not a rollback
+	RollbackOf int `json:"rollbackOf,omitempty"`
+}
+
+// storedRevision is a revision read from the cluster
+type storedRevision struct {
+	number     int
+	secretName string
+	created    metav1.Time
+	data       revisionData
+}
+
+func (o storedRevision) toAPI() api.DeployRevision {
+	resources := make([]api.DeployedResource, 0, len(o.data.Manifests))
+	for _, manifest := range o.data.Manifests {
+		u := unstructured.Unstructured{Object: manifest}
+		resources = append(resources, api.DeployedResource{
+			Kind: u.GetKind(),
+			Name: u.GetName(),
+		})
+	}
+	return api.DeployRevision{
+		Revision:     o.number,
+		Date:         o.created.Time,
+		Images:       o.data.Images,
+		ImageDigests: o.data.ImageDigests,
+		Resources:    resources,
+		RollbackOf:   o.data.RollbackOf,
+	}
+}
+
+// digestBackend is an image backend recording the digests of the images pushed by the backend it wraps, by reference
+type digestBackend struct {
+	image.Backend
+	digests map[string]string
+}
+
+func (o *digestBackend) Push(imageName string) (string, error) {
+	digest, err := o.Backend.Push(imageName)
+	if err == nil && digest != "" {
+		o.digests[imageName] = digest
+	}
+	return digest, err
+}
+
+// revisionHandler is a handler of the Deploy command recording the images and the resources
+// successfully applied by the handler it wraps
+type revisionHandler struct {
+	libdevfile.Handler
+	devfile parser.DevfileObj
+	path    string
+	// digests are the digests of the images pushed by the handler, by reference
+	digests map[string]string
+
+	revision revisionData
+	// manifests is the index of each manifest in revision, by kind and name,
+	// as a resource can be applied several times
+	manifests map[string]int
+}
+
+var _ libdevfile.Handler = (*revisionHandler)(nil)
+
+// newRevisionHandler returns a handler recording the images and resources applied by handler,
+// digests being filled with the digests of the images pushed by handler
+func newRevisionHandler(handler libdevfile.Handler, devfileObj parser.DevfileObj, path string, digests map[string]string) *revisionHandler {
+	return &revisionHandler{
+		Handler:   handler,
+		devfile:   devfileObj,
+		path:      path,
+		digests:   digests,
+		manifests: map[string]int{},
+	}
+}
+
+func (o *revisionHandler) ApplyImage(img v1alpha2.Component) error {
+	err := o.Handler.ApplyImage(img)
+	if err != nil || img.Image == nil {
+		return err
+	}
+	name := img.Image.ImageName
+	if digest, found := o.digests[name]; found {
+		if o.revision.ImageDigests == nil {
+			o.revision.ImageDigests = map[string]string{}
+		}
+		o.revision.ImageDigests[name] = digest
+	} else {
+		log.Warningf("Unable to resolve the digest of the image %q, which may not have been pushed: this image will not be reverted when rolling back to this deployment", name)
+	}
+	for _, recorded := range o.revision.Images {
+		if recorded == name {
+			return nil
+		}
+	}
+	o.revision.Images = append(o.revision.Images, name)
+	return nil
+}
+
+func (o *revisionHandler) ApplyKubernetes(kubernetes v1alpha2.Component, kind v1alpha2.CommandGroupKind) error {
+	err := o.Handler.ApplyKubernetes(kubernetes, kind)
+	if err != nil {
+		return err
+	}
+	return o.recordManifests(kubernetes)
+}
+
+func (o *revisionHandler) ApplyOpenShift(openshift v1alpha2.Component, kind v1alpha2.CommandGroupKind) error {
+	err := o.Handler.ApplyOpenShift(openshift, kind)
+	if err != nil {
+		return err
+	}
+	return o.recordManifests(openshift)
+}
+
+func (o *revisionHandler) recordManifests(c v1alpha2.Component) error {
+	uList, err := libdevfile.GetK8sComponentAsUnstructuredList(o.devfile, c.Name, o.path, devfilefs.DefaultFs{})
+	if err != nil {
+		return err
+	}
+	for _, u := range uList {
+		key := u.GetKind() + "/" + u.GetName()
+		if i, found := o.manifests[key]; found {
+			o.revision.Manifests[i] = u.Object
+			continue
+		}
+		o.manifests[key] = len(o.revision.Manifests)
+		o.revision.Manifests = append(o.revision.Manifests, u.Object)
+	}
+	return nil
+}
+
+func (o *DeployClient) ListRevisions(ctx context.Context) ([]api.DeployRevision, error) {
+	revisions, err := o.getRevisions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]api.DeployRevision, 0, len(revisions))
+	for _, revision := range revisions {
+		result = append(result, revision.toAPI())
+	}
+	return result, nil
+}
+
+func (o *DeployClient) Rollback(ctx context.Context, revision int) (api.DeployRevision, error) {
+	var (
+		devfileObj    = odocontext.GetEffectiveDevfileObj(ctx)
+		componentName = odocontext.GetComponentName(ctx)
+		appName       = odocontext.GetApplication(ctx)
+	)
+
+	revisions, err := o.getRevisions(ctx)
+	if err != nil {
+		return api.DeployRevision{}, err
+	}
+	target, err := selectRevision(revisions, revision)
+	if err != nil {
+		return api.DeployRevision{}, err
+	}
+
+	// The images are not rebuilt: the images of the revision are pinned to the digests pushed by the revision
+	for _, name := range target.data.Images {
+		if _, found := target.data.ImageDigests[name]; !found {
+			log.Warningf("The digest of the image %q has not been recorded by revision %d: this image is not reverted", name, target.number)
+		}
+	}
+	uList := make([]unstructured.Unstructured, 0, len(target.data.Manifests))
+	for _, manifest := range target.data.Manifests {
+		u := unstructured.Unstructured{Object: runtime.DeepCopyJSON(manifest)}
+		pinImages(u.Object, target.data.ImageDigests)
+		uList = append(uList, u)
+	}
+	err = component.ApplyKubernetesResources(odolabels.ComponentDeployMode, appName, componentName, *devfileObj, uList, o.kubeClient)
+	if err != nil {
+		return api.DeployRevision{}, err
+	}
+
+	rollback := target.data
+	rollback.RollbackOf = target.number
+	err = o.recordRevision(ctx, rollback)
+	if err != nil {
+		return api.DeployRevision{}, fmt.Errorf("unable to record the rollback: %w", err)
+	}
+	return target.toAPI(), nil
+}
+
+// pinImages replaces, in the image fields of the manifest, the references of the images having a digest
+// with the references of the images by digest
+func pinImages(manifest interface{}, digests map[string]string) {
+	switch v := manifest.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if reference, ok := value.(string); ok && key == "image" {
+				if digest, found := digests[reference]; found {
+					v[key] = pinnedReference(reference, digest)
+				}
+				continue
+			}
+			pinImages(value, digests)
+		}
+	case []interface{}:
+		for _, item := range v {
+			pinImages(item, digests)
+		}
+	}
+}
+
+// pinnedReference returns the reference of the image by digest, without its tag, e.g. quay.io/user/app@sha256:...
+func pinnedReference(reference string, digest string) string {
+	name := reference
+	if i := strings.Index(name, "@"); i >= 0 {
+		name = name[:i]
+	}
+	// A colon after the last slash separates the tag; a colon before is the port of the registry
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name = name[:i]
+	}
+	return name + "@" + digest
+}
+
+// selectRevision returns the revision with the given number, or the revision preceding the last one if number is zero.
+// The revisions must be sorted by number.
+func selectRevision(revisions []storedRevision, number int) (storedRevision, error) {
+	if len(revisions) == 0 {
+		return storedRevision{}, errors.New("no deployment has been recorded for the component")
+	}
+	if number == 0 {
+		if len(revisions) < 2 {
+			return storedRevision{}, fmt.Errorf("no revision to roll back to before revision %d", revisions[0].number)
+		}
+		return revisions[len(revisions)-2], nil
+	}
+	for _, revision := range revisions {
+		if revision.number == number {
+			return revision, nil
+		}
+	}
+	return storedRevision{}, fmt.Errorf("revision %d not found", number)
+}
+
+// getRevisions returns the revisions of the component recorded on the cluster, sorted by number
+func (o *DeployClient) getRevisions(ctx context.Context) ([]storedRevision, error) {
+	var (
+		componentName = odocontext.GetComponentName(ctx)
+		appName       = odocontext.GetApplication(ctx)
+	)
+	secrets, err := o.kubeClient.ListSecrets(odolabels.GetDeployRevisionSelector(componentName, appName))
+	if err != nil {
+		return nil, fmt.Errorf("unable to list the revisions: %w", err)
+	}
+	return parseRevisions(secrets), nil
+}
+
+// parseRevisions returns the revisions stored in the secrets, sorted by number.
+// The secrets which do not contain a valid revision are ignored.
+func parseRevisions(secrets []corev1.Secret) []storedRevision {
+	result := make([]storedRevision, 0, len(secrets))
+	for _, secret := range secrets {
+		number, err := strconv.Atoi(odolabels.GetDeployRevision(secret.GetLabels()))
+		if err != nil {
+			klog.V(4).Infof("ignoring secret %q with an invalid revision: %v", secret.GetName(), err)
+			continue
+		}
+		var data revisionData
+		err = json.Unmarshal(secret.Data[_revisionDataKey], &data)
+		if err != nil {
+			klog.V(4).Infof("ignoring secret %q with invalid revision data: %v", secret.GetName(), err)
+			continue
+		}
+		result = append(result, storedRevision{
+			number:     number,
+			secretName: secret.GetName(),
+			created:    secret.GetCreationTimestamp(),
+			data:       data,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].number < result[j].number
+	})
+	return result
+}
+
+// recordRevision stores the revision in a new Secret, and deletes the oldest revisions to keep maxRevisions revisions
+func (o *DeployClient) recordRevision(ctx context.Context, revision revisionData) error {
+	var (
+		componentName = odocontext.GetComponentName(ctx)
+		appName       = odocontext.GetApplication(ctx)
+	)
+
+	revisions, err := o.getRevisions(ctx)
+	if err != nil {
+		return err
+	}
+	number := 1
+	if len(revisions) > 0 {
+		number = revisions[len(revisions)-1].number + 1
+	}
+
+	content, err := json.Marshal(revision)
+	if err != nil {
+		return err
+	}
+	labels := odolabels.GetLabels(componentName, appName, "", odolabels.ComponentDeployMode, false)
+	odolabels.SetDeployRevision(labels, strconv.Itoa(number))
+	err = o.kubeClient.CreateSecret(
+		metav1.ObjectMeta{
+			Name:   fmt.Sprintf("odo-deploy-%s-v%d", componentName, number),
+			Labels: labels,
+		},
+		map[string]string{_revisionDataKey: string(content)},
+		metav1.OwnerReference{},
+	)
+	if err != nil {
+		return err
+	}
+	klog.V(2).Infof("deployment recorded as revision %d", number)
+
+	// The new revision is not part of revisions
+	for i := 0; i < len(revisions)+1-maxRevisions; i++ {
+		err = o.kubeClient.DeleteSecret(revisions[i].secretName, o.kubeClient.GetCurrentNamespace())
+		if err != nil {
+			klog.V(4).Infof("unable to delete revision %d: %v", revisions[i].number, err)
+		}
+	}
+	return nil
+}
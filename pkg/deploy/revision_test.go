@@ -0,0 +1,191 @@
+package deploy
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/golang/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/redhat-developer/odo/pkg/devfile/image"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+)
+
+func newRevisionSecret(name string, revision string, data string) corev1.Secret {
+	labels := map[string]string{}
+	odolabels.SetDeployRevision(labels, revision)
+	return corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: labels,
+		},
+		Data: map[string][]byte{
+			_revisionDataKey: []byte(data),
+		},
+	}
+}
+
+func Test_parseRevisions(t *testing.T) {
+	secrets := []corev1.Secret{
+		newRevisionSecret("odo-deploy-my-component-v10", "10", `{"manifests":[{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"my-deploy"}}],"rollbackOf":2}`),
+		newRevisionSecret("odo-deploy-my-component-v2", "2", `{"images":["quay.io/user/my-image:v2"],"manifests":[{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"my-deploy"}},{"apiVersion":"v1","kind":"Service","metadata":{"name":"my-svc"}}]}`),
+		newRevisionSecret("invalid-revision", "latest", `{"manifests":[]}`),
+		newRevisionSecret("invalid-data", "3", `not json`),
+	}
+
+	got := parseRevisions(secrets)
+	if len(got) != 2 {
+		t.Fatalf("parseRevisions() returned %d revisions, want 2: %v", len(got), got)
+	}
+	if got[0].number != 2 || got[1].number != 10 {
+		t.Errorf("parseRevisions() returned revisions %d and %d, want 2 and 10", got[0].number, got[1].number)
+	}
+
+	revision := got[0].toAPI()
+	if len(revision.Images) != 1 || revision.Images[0] != "quay.io/user/my-image:v2" {
+		t.Errorf("images of revision 2 = %v, want [quay.io/user/my-image:v2]", revision.Images)
+	}
+	if len(revision.Resources) != 2 || revision.Resources[1].Kind != "Service" || revision.Resources[1].Name != "my-svc" {
+		t.Errorf("resources of revision 2 = %v, want Deployment/my-deploy and Service/my-svc", revision.Resources)
+	}
+	if rollbackOf := got[1].toAPI().RollbackOf; rollbackOf != 2 {
+		t.Errorf("revision 10 is a rollback of %d, want 2", rollbackOf)
+	}
+}
+
+func Test_selectRevision(t *testing.T) {
+	revisions := []storedRevision{{number: 1}, {number: 2}, {number: 4}}
+	tests := []struct {
+		name      string
+		revisions []storedRevision
+		number    int
+		want      int
+		wantErr   bool
+	}{
+		{
+			name:    "no revision",
+			number:  0,
+			wantErr: true,
+		},
+		{
+			name:      "previous revision",
+			revisions: revisions,
+			number:    0,
+			want:      2,
+		},
+		{
+			name:      "no previous revision",
+			revisions: revisions[:1],
+			number:    0,
+			wantErr:   true,
+		},
+		{
+			name:      "specific revision",
+			revisions: revisions,
+			number:    1,
+			want:      1,
+		},
+		{
+			name:      "last revision",
+			revisions: revisions,
+			number:    4,
+			want:      4,
+		},
+		{
+			name:      "deleted revision",
+			revisions: revisions,
+			number:    3,
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := selectRevision(tt.revisions, tt.number)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("selectRevision() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got.number != tt.want {
+				t.Errorf("selectRevision() = %d, want %d", got.number, tt.want)
+			}
+		})
+	}
+}
+
+func Test_pinImages(t *testing.T) {
+	const digest = "sha256:5d0da3dc976460b72c77d94c8a1ad043720b0416bfc16c52c45d4847e53fadb6"
+	manifest := map[string]interface{}{
+		"kind": "Deployment",
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"initContainers": []interface{}{
+						map[string]interface{}{"name": "init", "image": "localhost:5000/user/init"},
+					},
+					"containers": []interface{}{
+						map[string]interface{}{"name": "runtime", "image": "quay.io/user/my-image:v2"},
+						map[string]interface{}{"name": "sidecar", "image": "busybox"},
+					},
+				},
+			},
+		},
+	}
+	pinImages(manifest, map[string]string{
+		"quay.io/user/my-image:v2": digest,
+		"localhost:5000/user/init": digest,
+	})
+
+	spec := manifest["spec"].(map[string]interface{})["template"].(map[string]interface{})["spec"].(map[string]interface{})
+	want := map[string]string{
+		"init":    "localhost:5000/user/init@" + digest,
+		"runtime": "quay.io/user/my-image@" + digest,
+		// no digest recorded for this image
+		"sidecar": "busybox",
+	}
+	for _, field := range []string{"initContainers", "containers"} {
+		for _, c := range spec[field].([]interface{}) {
+			container := c.(map[string]interface{})
+			if got := container["image"]; got != want[container["name"].(string)] {
+				t.Errorf("image of container %v = %v, want %v", container["name"], got, want[container["name"].(string)])
+			}
+		}
+	}
+}
+
+func Test_revisionHandler_ApplyImage(t *testing.T) {
+	const digest = "sha256:5d0da3dc976460b72c77d94c8a1ad043720b0416bfc16c52c45d4847e53fadb6"
+	ctrl := gomock.NewController(t)
+	backend := image.NewMockBackend(ctrl)
+	backend.EXPECT().Push("quay.io/user/my-image:v2").Return(digest, nil)
+	backend.EXPECT().Push("quay.io/user/other-image").Return("", nil)
+
+	digests := map[string]string{}
+	pusher := &digestBackend{Backend: backend, digests: digests}
+	handler := libdevfile.NewMockHandler(ctrl)
+	handler.EXPECT().ApplyImage(gomock.Any()).DoAndReturn(func(img v1alpha2.Component) error {
+		_, err := pusher.Push(img.Image.ImageName)
+		return err
+	}).Times(2)
+
+	o := newRevisionHandler(handler, parser.DevfileObj{}, "", digests)
+	for _, name := range []string{"quay.io/user/my-image:v2", "quay.io/user/other-image"} {
+		err := o.ApplyImage(v1alpha2.Component{
+			Name:           "image",
+			ComponentUnion: v1alpha2.ComponentUnion{Image: &v1alpha2.ImageComponent{Image: v1alpha2.Image{ImageName: name}}},
+		})
+		if err != nil {
+			t.Fatalf("ApplyImage() unexpected error: %v", err)
+		}
+	}
+	if want := []string{"quay.io/user/my-image:v2", "quay.io/user/other-image"}; !reflect.DeepEqual(o.revision.Images, want) {
+		t.Errorf("images = %v, want %v", o.revision.Images, want)
+	}
+	// the digest of the second image is unknown
+	if want := map[string]string{"quay.io/user/my-image:v2": digest}; !reflect.DeepEqual(o.revision.ImageDigests, want) {
+		t.Errorf("digests = %v, want %v", o.revision.ImageDigests, want)
+	}
+}
@@ -0,0 +1,182 @@
+package deploy
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// workloadKinds are the kinds of the resources whose rollout is tracked
+var workloadKinds = map[schema.GroupKind]bool{
+	{Group: "apps", Kind: "Deployment"}:  true,
+	{Group: "apps", Kind: "StatefulSet"}: true,
+	{Group: "apps", Kind: "DaemonSet"}:   true,
+	{Group: "batch", Kind: "Job"}:        true,
+}
+
+// rolloutStatus is the status of the rollout of a workload
+type rolloutStatus struct {
+	// message describes the progress of the rollout
+	message string
+	// done is true when the rollout is complete
+	done bool
+}
+
+// failedRolloutError is returned when the rollout of a workload has failed, and cannot progress anymore
+type failedRolloutError struct {
+	reason string
+}
+
+func (o failedRolloutError) Error() string {
+	return o.reason
+}
+
+// getRolloutStatus returns the status of the rollout of a workload, the same way as kubectl rollout status does,
+// or a failedRolloutError if the rollout has failed
+func getRolloutStatus(u *unstructured.Unstructured) (rolloutStatus, error) {
+	switch u.GroupVersionKind().GroupKind() {
+	case schema.GroupKind{Group: "apps", Kind: "Deployment"}:
+		var deployment appsv1.Deployment
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &deployment); err != nil {
+			return rolloutStatus{}, err
+		}
+		return getDeploymentRolloutStatus(deployment)
+	case schema.GroupKind{Group: "apps", Kind: "StatefulSet"}:
+		var statefulSet appsv1.StatefulSet
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &statefulSet); err != nil {
+			return rolloutStatus{}, err
+		}
+		return getStatefulSetRolloutStatus(statefulSet), nil
+	case schema.GroupKind{Group: "apps", Kind: "DaemonSet"}:
+		var daemonSet appsv1.DaemonSet
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &daemonSet); err != nil {
+			return rolloutStatus{}, err
+		}
+		return getDaemonSetRolloutStatus(daemonSet), nil
+	case schema.GroupKind{Group: "batch", Kind: "Job"}:
+		var job batchv1.Job
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &job); err != nil {
+			return rolloutStatus{}, err
+		}
+		return getJobRolloutStatus(job)
+	}
+	return rolloutStatus{done: true}, nil
+}
+
+func getDeploymentRolloutStatus(deployment appsv1.Deployment) (rolloutStatus, error) {
+	if deployment.Generation > deployment.Status.ObservedGeneration {
+		return rolloutStatus{message: "waiting for the deployment spec update to be observed"}, nil
+	}
+	for _, condition := range deployment.Status.Conditions {
+		if condition.Type == appsv1.DeploymentProgressing && condition.Reason == "ProgressDeadlineExceeded" {
+			return rolloutStatus{}, failedRolloutError{reason: fmt.Sprintf("the rollout has exceeded its progress deadline: %s", condition.Message)}
+		}
+	}
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+	switch {
+	case deployment.Status.UpdatedReplicas < replicas:
+		return rolloutStatus{message: fmt.Sprintf("%d out of %d new replicas have been updated", deployment.Status.UpdatedReplicas, replicas)}, nil
+	case deployment.Status.Replicas > deployment.Status.UpdatedReplicas:
+		return rolloutStatus{message: fmt.Sprintf("%d old replicas are pending termination", deployment.Status.Replicas-deployment.Status.UpdatedReplicas)}, nil
+	case deployment.Status.AvailableReplicas < deployment.Status.UpdatedReplicas:
+		return rolloutStatus{message: fmt.Sprintf("%d of %d updated replicas are available", deployment.Status.AvailableReplicas, deployment.Status.UpdatedReplicas)}, nil
+	}
+	return rolloutStatus{message: "successfully rolled out", done: true}, nil
+}
+
+func getStatefulSetRolloutStatus(statefulSet appsv1.StatefulSet) rolloutStatus {
+	if statefulSet.Spec.UpdateStrategy.Type != appsv1.RollingUpdateStatefulSetStrategyType {
+		// The rollout cannot be tracked with the other strategies
+		return rolloutStatus{message: "rollout not tracked with the " + string(statefulSet.Spec.UpdateStrategy.Type) + " strategy", done: true}
+	}
+	if statefulSet.Status.ObservedGeneration == 0 || statefulSet.Generation > statefulSet.Status.ObservedGeneration {
+		return rolloutStatus{message: "waiting for the statefulset spec update to be observed"}
+	}
+	replicas := int32(1)
+	if statefulSet.Spec.Replicas != nil {
+		replicas = *statefulSet.Spec.Replicas
+	}
+	if statefulSet.Status.ReadyReplicas < replicas {
+		return rolloutStatus{message: fmt.Sprintf("%d of %d pods are ready", statefulSet.Status.ReadyReplicas, replicas)}
+	}
+	if rollingUpdate := statefulSet.Spec.UpdateStrategy.RollingUpdate; rollingUpdate != nil && rollingUpdate.Partition != nil && *rollingUpdate.Partition > 0 {
+		if statefulSet.Status.UpdatedReplicas < replicas-*rollingUpdate.Partition {
+			return rolloutStatus{message: fmt.Sprintf("%d of %d pods have been updated in the partition", statefulSet.Status.UpdatedReplicas, replicas-*rollingUpdate.Partition)}
+		}
+		return rolloutStatus{message: "partitioned roll out complete", done: true}
+	}
+	if statefulSet.Status.UpdateRevision != statefulSet.Status.CurrentRevision {
+		return rolloutStatus{message: fmt.Sprintf("%d of %d pods have been updated", statefulSet.Status.UpdatedReplicas, replicas)}
+	}
+	return rolloutStatus{message: "successfully rolled out", done: true}
+}
+
+func getDaemonSetRolloutStatus(daemonSet appsv1.DaemonSet) rolloutStatus {
+	if daemonSet.Spec.UpdateStrategy.Type != appsv1.RollingUpdateDaemonSetStrategyType {
+		return rolloutStatus{message: "rollout not tracked with the " + string(daemonSet.Spec.UpdateStrategy.Type) + " strategy", done: true}
+	}
+	if daemonSet.Generation > daemonSet.Status.ObservedGeneration {
+		return rolloutStatus{message: "waiting for the daemonset spec update to be observed"}
+	}
+	switch {
+	case daemonSet.Status.UpdatedNumberScheduled < daemonSet.Status.DesiredNumberScheduled:
+		return rolloutStatus{message: fmt.Sprintf("%d out of %d new pods have been updated", daemonSet.Status.UpdatedNumberScheduled, daemonSet.Status.DesiredNumberScheduled)}
+	case daemonSet.Status.NumberAvailable < daemonSet.Status.DesiredNumberScheduled:
+		return rolloutStatus{message: fmt.Sprintf("%d of %d updated pods are available", daemonSet.Status.NumberAvailable, daemonSet.Status.DesiredNumberScheduled)}
+	}
+	return rolloutStatus{message: "successfully rolled out", done: true}
+}
+
+func getJobRolloutStatus(job batchv1.Job) (rolloutStatus, error) {
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			return rolloutStatus{message: "completed", done: true}, nil
+		case batchv1.JobFailed:
+			return rolloutStatus{}, failedRolloutError{reason: fmt.Sprintf("the job has failed: %s", condition.Message)}
+		}
+	}
+	completions := int32(1)
+	if job.Spec.Completions != nil {
+		completions = *job.Spec.Completions
+	}
+	return rolloutStatus{message: fmt.Sprintf("%d of %d completions, %d active pods", job.Status.Succeeded, completions, job.Status.Active)}, nil
+}
+
+// podFailureReasons are the reasons of the waiting state of a container indicating that the container cannot start
+var podFailureReasons = map[string]bool{
+	"CrashLoopBackOff":           true,
+	"ErrImagePull":               true,
+	"ImagePullBackOff":           true,
+	"InvalidImageName":           true,
+	"CreateContainerConfigError": true,
+	"CreateContainerError":       true,
+	"RunContainerError":          true,
+}
+
+// getPodFailure returns the name of the failing container of the pod and a description of the failure,
+// or empty strings if the pod is not failing
+func getPodFailure(pod corev1.Pod) (container string, reason string) {
+	statuses := append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+	for _, status := range statuses {
+		if status.State.Waiting != nil && podFailureReasons[status.State.Waiting.Reason] {
+			reason = status.State.Waiting.Reason
+			if status.State.Waiting.Message != "" {
+				reason += ": " + status.State.Waiting.Message
+			}
+			return status.Name, reason
+		}
+	}
+	return "", ""
+}
@@ -0,0 +1,265 @@
+package deploy
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+)
+
+func toUnstructured(t *testing.T, obj runtime.Object, apiVersion, kind string) *unstructured.Unstructured {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u := &unstructured.Unstructured{Object: content}
+	u.SetAPIVersion(apiVersion)
+	u.SetKind(kind)
+	return u
+}
+
+func Test_getRolloutStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		object     runtime.Object
+		apiVersion string
+		kind       string
+		wantDone   bool
+		wantFailed bool
+	}{
+		{
+			name:       "deployment spec update not observed",
+			apiVersion: "apps/v1",
+			kind:       "Deployment",
+			object: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Status:     appsv1.DeploymentStatus{ObservedGeneration: 1},
+			},
+		},
+		{
+			name:       "deployment with replicas not updated",
+			apiVersion: "apps/v1",
+			kind:       "Deployment",
+			object: &appsv1.Deployment{
+				Spec:   appsv1.DeploymentSpec{Replicas: pointer.Int32(2)},
+				Status: appsv1.DeploymentStatus{Replicas: 2, UpdatedReplicas: 1},
+			},
+		},
+		{
+			name:       "deployment with updated replicas not available",
+			apiVersion: "apps/v1",
+			kind:       "Deployment",
+			object: &appsv1.Deployment{
+				Status: appsv1.DeploymentStatus{Replicas: 1, UpdatedReplicas: 1, AvailableReplicas: 0},
+			},
+		},
+		{
+			name:       "deployment rolled out",
+			apiVersion: "apps/v1",
+			kind:       "Deployment",
+			object: &appsv1.Deployment{
+				Spec:   appsv1.DeploymentSpec{Replicas: pointer.Int32(2)},
+				Status: appsv1.DeploymentStatus{Replicas: 2, UpdatedReplicas: 2, AvailableReplicas: 2},
+			},
+			wantDone: true,
+		},
+		{
+			name:       "deployment exceeding its progress deadline",
+			apiVersion: "apps/v1",
+			kind:       "Deployment",
+			object: &appsv1.Deployment{
+				Status: appsv1.DeploymentStatus{
+					Conditions: []appsv1.DeploymentCondition{
+						{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionFalse, Reason: "ProgressDeadlineExceeded"},
+					},
+				},
+			},
+			wantFailed: true,
+		},
+		{
+			name:       "statefulset with pods not ready",
+			apiVersion: "apps/v1",
+			kind:       "StatefulSet",
+			object: &appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+				Spec: appsv1.StatefulSetSpec{
+					Replicas:       pointer.Int32(3),
+					UpdateStrategy: appsv1.StatefulSetUpdateStrategy{Type: appsv1.RollingUpdateStatefulSetStrategyType},
+				},
+				Status: appsv1.StatefulSetStatus{ObservedGeneration: 1, ReadyReplicas: 2},
+			},
+		},
+		{
+			name:       "statefulset rolled out",
+			apiVersion: "apps/v1",
+			kind:       "StatefulSet",
+			object: &appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+				Spec: appsv1.StatefulSetSpec{
+					Replicas:       pointer.Int32(3),
+					UpdateStrategy: appsv1.StatefulSetUpdateStrategy{Type: appsv1.RollingUpdateStatefulSetStrategyType},
+				},
+				Status: appsv1.StatefulSetStatus{ObservedGeneration: 1, ReadyReplicas: 3, UpdatedReplicas: 3, CurrentRevision: "rev", UpdateRevision: "rev"},
+			},
+			wantDone: true,
+		},
+		{
+			name:       "statefulset with the OnDelete strategy",
+			apiVersion: "apps/v1",
+			kind:       "StatefulSet",
+			object: &appsv1.StatefulSet{
+				Spec: appsv1.StatefulSetSpec{
+					UpdateStrategy: appsv1.StatefulSetUpdateStrategy{Type: appsv1.OnDeleteStatefulSetStrategyType},
+				},
+			},
+			wantDone: true,
+		},
+		{
+			name:       "daemonset with pods not available",
+			apiVersion: "apps/v1",
+			kind:       "DaemonSet",
+			object: &appsv1.DaemonSet{
+				Spec: appsv1.DaemonSetSpec{
+					UpdateStrategy: appsv1.DaemonSetUpdateStrategy{Type: appsv1.RollingUpdateDaemonSetStrategyType},
+				},
+				Status: appsv1.DaemonSetStatus{DesiredNumberScheduled: 2, UpdatedNumberScheduled: 2, NumberAvailable: 1},
+			},
+		},
+		{
+			name:       "daemonset rolled out",
+			apiVersion: "apps/v1",
+			kind:       "DaemonSet",
+			object: &appsv1.DaemonSet{
+				Spec: appsv1.DaemonSetSpec{
+					UpdateStrategy: appsv1.DaemonSetUpdateStrategy{Type: appsv1.RollingUpdateDaemonSetStrategyType},
+				},
+				Status: appsv1.DaemonSetStatus{DesiredNumberScheduled: 2, UpdatedNumberScheduled: 2, NumberAvailable: 2},
+			},
+			wantDone: true,
+		},
+		{
+			name:       "job running",
+			apiVersion: "batch/v1",
+			kind:       "Job",
+			object: &batchv1.Job{
+				Status: batchv1.JobStatus{Active: 1},
+			},
+		},
+		{
+			name:       "job completed",
+			apiVersion: "batch/v1",
+			kind:       "Job",
+			object: &batchv1.Job{
+				Status: batchv1.JobStatus{
+					Conditions: []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}},
+				},
+			},
+			wantDone: true,
+		},
+		{
+			name:       "job failed",
+			apiVersion: "batch/v1",
+			kind:       "Job",
+			object: &batchv1.Job{
+				Status: batchv1.JobStatus{
+					Conditions: []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Reason: "BackoffLimitExceeded"}},
+				},
+			},
+			wantFailed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := getRolloutStatus(toUnstructured(t, tt.object, tt.apiVersion, tt.kind))
+			if tt.wantFailed {
+				if _, ok := err.(failedRolloutError); !ok {
+					t.Errorf("getRolloutStatus() error = %v, want a failedRolloutError", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("getRolloutStatus() unexpected error: %v", err)
+			}
+			if got.done != tt.wantDone {
+				t.Errorf("getRolloutStatus() done = %v, want %v (message: %q)", got.done, tt.wantDone, got.message)
+			}
+			if got.message == "" {
+				t.Errorf("getRolloutStatus() returned an empty message")
+			}
+		})
+	}
+}
+
+func Test_getPodFailure(t *testing.T) {
+	tests := []struct {
+		name          string
+		pod           corev1.Pod
+		wantContainer string
+		wantReason    string
+	}{
+		{
+			name: "running pod",
+			pod: corev1.Pod{
+				Status: corev1.PodStatus{
+					ContainerStatuses: []corev1.ContainerStatus{
+						{Name: "runtime", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+					},
+				},
+			},
+		},
+		{
+			name: "container creating",
+			pod: corev1.Pod{
+				Status: corev1.PodStatus{
+					ContainerStatuses: []corev1.ContainerStatus{
+						{Name: "runtime", State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ContainerCreating"}}},
+					},
+				},
+			},
+		},
+		{
+			name: "container in crash loop",
+			pod: corev1.Pod{
+				Status: corev1.PodStatus{
+					ContainerStatuses: []corev1.ContainerStatus{
+						{Name: "sidecar", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+						{Name: "runtime", State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff", Message: "back-off 10s restarting failed container"}}},
+					},
+				},
+			},
+			wantContainer: "runtime",
+			wantReason:    "CrashLoopBackOff: back-off 10s restarting failed container",
+		},
+		{
+			name: "init container failing to pull its image",
+			pod: corev1.Pod{
+				Status: corev1.PodStatus{
+					InitContainerStatuses: []corev1.ContainerStatus{
+						{Name: "init", State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"}}},
+					},
+					ContainerStatuses: []corev1.ContainerStatus{
+						{Name: "runtime", State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "PodInitializing"}}},
+					},
+				},
+			},
+			wantContainer: "init",
+			wantReason:    "ImagePullBackOff",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotContainer, gotReason := getPodFailure(tt.pod)
+			if gotContainer != tt.wantContainer {
+				t.Errorf("getPodFailure() container = %q, want %q", gotContainer, tt.wantContainer)
+			}
+			if gotReason != tt.wantReason {
+				t.Errorf("getPodFailure() reason = %q, want %q", gotReason, tt.wantReason)
+			}
+		})
+	}
+}
@@ -0,0 +1,376 @@
+package deploy
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog"
+	"k8s.io/utils/pointer"
+
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/platform"
+)
+
+const (
+	// podsCheckInterval is the interval between two checks of the pods of a workload for failures
+	podsCheckInterval = 2 * time.Second
+	// reportedEvents is the number of the last events reported for a failing pod
+	reportedEvents = 5
+	// reportedLogLines is the number of the last log lines reported for a failing container
+	reportedLogLines = 10
+	// deploymentRevisionAnnotation is the annotation of a Deployment and of its ReplicaSets holding their revision
+	deploymentRevisionAnnotation = "deployment.kubernetes.io/revision"
+)
+
+// workload is a resource deployed by the Deploy mode whose rollout is tracked
+type workload struct {
+	resource unstructured.Unstructured
+	gvr      schema.GroupVersionResource
+}
+
+func (o *DeployClient) Wait(ctx context.Context, timeout time.Duration) error {
+	workloads, err := o.getDeployedWorkloads(ctx)
+	if err != nil {
+		return err
+	}
+	if len(workloads) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	log.Section("Waiting for the workloads to be rolled out")
+	for _, w := range workloads {
+		err = o.waitForWorkload(ctx, w, timeout)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getDeployedWorkloads returns the workloads defined in the Devfile and deployed in the Deploy mode
+func (o *DeployClient) getDeployedWorkloads(ctx context.Context) ([]workload, error) {
+	devfileResources, err := getDevfileResources(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result []workload
+	for _, u := range devfileResources {
+		if !workloadKinds[u.GroupVersionKind().GroupKind()] {
+			continue
+		}
+		mapping, err := o.kubeClient.GetRestMappingFromUnstructured(u)
+		if err != nil {
+			return nil, err
+		}
+		deployed, err := o.kubeClient.GetDynamicResource(mapping.Resource, u.GetName())
+		if err != nil {
+			if kerrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		// Ignore the resources of the Devfile which have not been deployed in the Deploy mode
+		if odolabels.GetMode(deployed.GetLabels()) != odolabels.ComponentDeployMode {
+			continue
+		}
+		result = append(result, workload{
+			resource: *deployed,
+			gvr:      mapping.Resource,
+		})
+	}
+	return result, nil
+}
+
+// waitForWorkload waits for the rollout of the workload to be complete, and reports the failing pods if the rollout fails,
+// if a pod of the workload cannot start, or if the context is done
+func (o *DeployClient) waitForWorkload(ctx context.Context, w workload, timeout time.Duration) error {
+	var (
+		kind = w.resource.GetKind()
+		name = w.resource.GetName()
+	)
+	title := fmt.Sprintf("Waiting for %s %q", kind, name)
+	spinner := log.Spinner(title)
+	defer spinner.End(false)
+
+	// Only the last state of the resource is relevant
+	updates := make(chan *unstructured.Unstructured, 1)
+	onUpdate := func(obj interface{}) {
+		u, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			return
+		}
+		select {
+		case <-updates:
+		default:
+		}
+		updates <- u
+	}
+	informer := o.kubeClient.DynamicResourceInformer(w.gvr, name)
+	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    onUpdate,
+		UpdateFunc: func(_, newObj interface{}) { onUpdate(newObj) },
+	})
+	if err != nil {
+		return err
+	}
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go informer.Run(stopCh)
+
+	ticker := time.NewTicker(podsCheckInterval)
+	defer ticker.Stop()
+
+	current := &w.resource
+	status := rolloutStatus{message: "waiting for the rollout to start"}
+	for {
+		select {
+		case <-ctx.Done():
+			if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return ctx.Err()
+			}
+			spinner.End(false)
+			o.reportPods(current, false)
+			return fmt.Errorf("timeout (%s) while waiting for %s %q to be rolled out: %s", timeout, kind, name, status.message)
+
+		case current = <-updates:
+			status, err = getRolloutStatus(current)
+			if err != nil {
+				var failedErr failedRolloutError
+				if errors.As(err, &failedErr) {
+					spinner.End(false)
+					o.reportPods(current, false)
+					return fmt.Errorf("%s %q failed to roll out: %w", kind, name, err)
+				}
+				return err
+			}
+			if status.done {
+				spinner.EndWithStatus(fmt.Sprintf("%s %q: %s", kind, name, status.message), true)
+				return nil
+			}
+			spinner.UpdateStatus(fmt.Sprintf("%s: %s", title, status.message))
+
+		case <-ticker.C:
+			pods, err := o.getWorkloadPods(current)
+			if err != nil {
+				klog.V(4).Infof("unable to get the pods of %s %q: %v", kind, name, err)
+				continue
+			}
+			for _, pod := range pods {
+				if _, reason := getPodFailure(pod); reason != "" {
+					spinner.End(false)
+					o.reportPods(current, true)
+					return fmt.Errorf("%s %q failed to roll out: pod %q is failing: %s", kind, name, pod.GetName(), reason)
+				}
+			}
+		}
+	}
+}
+
+// getWorkloadPods returns the pods of the current revision of the workload.
+// The pods of the previous revisions, being replaced, are ignored.
+func (o *DeployClient) getWorkloadPods(u *unstructured.Unstructured) ([]corev1.Pod, error) {
+	selectorMap, found, err := unstructured.NestedMap(u.Object, "spec", "selector")
+	if err != nil || !found {
+		return nil, err
+	}
+	var labelSelector metav1.LabelSelector
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(selectorMap, &labelSelector)
+	if err != nil {
+		return nil, err
+	}
+	selector, err := metav1.LabelSelectorAsSelector(&labelSelector)
+	if err != nil {
+		return nil, err
+	}
+	revisionLabel, revision, err := o.getCurrentRevision(u, selector.String())
+	if err != nil {
+		return nil, err
+	}
+	if revisionLabel != "" {
+		if revision == "" {
+			// The pods of the current revision are not created yet
+			return nil, nil
+		}
+		requirement, err := labels.NewRequirement(revisionLabel, selection.Equals, []string{revision})
+		if err != nil {
+			return nil, err
+		}
+		selector = selector.Add(*requirement)
+	}
+	list, err := o.kubeClient.GetPodsMatchingSelector(selector.String())
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// getCurrentRevision returns the label identifying the revision of the pods of the workload, and the value of this label
+// for the pods of the current revision, or an empty value if the current revision is not known yet.
+// An empty label is returned for the workloads whose pods all belong to the current revision (Jobs).
+func (o *DeployClient) getCurrentRevision(u *unstructured.Unstructured, selector string) (label string, revision string, err error) {
+	// The revision is known only once the controller has observed the last changes of the workload
+	observedGeneration, _, _ := unstructured.NestedInt64(u.Object, "status", "observedGeneration")
+	observed := observedGeneration >= u.GetGeneration()
+	switch u.GroupVersionKind().GroupKind() {
+	case schema.GroupKind{Group: "apps", Kind: "Deployment"}:
+		// The pods of the current revision are the ones of the newest ReplicaSet
+		replicaSets, err := o.listOwnedResources(u, appsv1.SchemeGroupVersion.WithResource("replicasets"), selector)
+		if err != nil {
+			return "", "", err
+		}
+		newest := getNewestResource(replicaSets, func(rs unstructured.Unstructured) int64 {
+			i, _ := strconv.ParseInt(rs.GetAnnotations()[deploymentRevisionAnnotation], 10, 64)
+			return i
+		})
+		if !observed || newest == nil || newest.GetAnnotations()[deploymentRevisionAnnotation] != u.GetAnnotations()[deploymentRevisionAnnotation] {
+			return appsv1.DefaultDeploymentUniqueLabelKey, "", nil
+		}
+		return appsv1.DefaultDeploymentUniqueLabelKey, newest.GetLabels()[appsv1.DefaultDeploymentUniqueLabelKey], nil
+
+	case schema.GroupKind{Group: "apps", Kind: "StatefulSet"}:
+		if !observed {
+			return appsv1.ControllerRevisionHashLabelKey, "", nil
+		}
+		updateRevision, _, err := unstructured.NestedString(u.Object, "status", "updateRevision")
+		return appsv1.ControllerRevisionHashLabelKey, updateRevision, err
+
+	case schema.GroupKind{Group: "apps", Kind: "DaemonSet"}:
+		// The pods of the current revision are the ones of the newest ControllerRevision
+		revisions, err := o.listOwnedResources(u, appsv1.SchemeGroupVersion.WithResource("controllerrevisions"), selector)
+		if err != nil {
+			return "", "", err
+		}
+		newest := getNewestResource(revisions, func(cr unstructured.Unstructured) int64 {
+			i, _, _ := unstructured.NestedInt64(cr.Object, "revision")
+			return i
+		})
+		if !observed || newest == nil {
+			return appsv1.ControllerRevisionHashLabelKey, "", nil
+		}
+		return appsv1.ControllerRevisionHashLabelKey, newest.GetLabels()[appsv1.ControllerRevisionHashLabelKey], nil
+	}
+	return "", "", nil
+}
+
+// listOwnedResources returns the resources matching the selector and controlled by the owner
+func (o *DeployClient) listOwnedResources(owner *unstructured.Unstructured, gvr schema.GroupVersionResource, selector string) ([]unstructured.Unstructured, error) {
+	list, err := o.kubeClient.ListDynamicResources("", gvr, selector)
+	if err != nil || list == nil {
+		return nil, err
+	}
+	var result []unstructured.Unstructured
+	for i := range list.Items {
+		if metav1.IsControlledBy(&list.Items[i], owner) {
+			result = append(result, list.Items[i])
+		}
+	}
+	return result, nil
+}
+
+// getNewestResource returns the resource with the highest revision, or nil if resources is empty
+func getNewestResource(resources []unstructured.Unstructured, revision func(unstructured.Unstructured) int64) *unstructured.Unstructured {
+	var newest *unstructured.Unstructured
+	for i := range resources {
+		if newest == nil || revision(resources[i]) > revision(*newest) {
+			newest = &resources[i]
+		}
+	}
+	return newest
+}
+
+// reportPods displays the failing pods of the workload (or the pods not ready, if onlyFailing is false),
+// with their last events and the last log lines of the failing container
+func (o *DeployClient) reportPods(u *unstructured.Unstructured, onlyFailing bool) {
+	pods, err := o.getWorkloadPods(u)
+	if err != nil {
+		klog.V(4).Infof("unable to get the pods of %s %q: %v", u.GetKind(), u.GetName(), err)
+		return
+	}
+	for _, pod := range pods {
+		container, reason := getPodFailure(pod)
+		if reason == "" {
+			if onlyFailing || isPodReady(pod) || pod.Status.Phase == corev1.PodSucceeded {
+				continue
+			}
+			reason = fmt.Sprintf("pod is not ready (phase: %s)", pod.Status.Phase)
+		}
+
+		log.Warningf("Pod %q: %s", pod.GetName(), reason)
+		o.reportPodEvents(pod.GetName())
+		if container != "" {
+			o.reportContainerLogs(pod.GetName(), container)
+		}
+	}
+}
+
+func isPodReady(pod corev1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func (o *DeployClient) reportPodEvents(podName string) {
+	events, err := o.kubeClient.ListPodEvents(podName)
+	if err != nil {
+		klog.V(4).Infof("unable to get the events of pod %q: %v", podName, err)
+		return
+	}
+	if len(events) == 0 {
+		return
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].LastTimestamp.Before(&events[j].LastTimestamp)
+	})
+	if len(events) > reportedEvents {
+		events = events[len(events)-reportedEvents:]
+	}
+	log.Info("Last events:")
+	for _, event := range events {
+		log.Printf("%s %s: %s", event.Type, event.Reason, event.Message)
+	}
+}
+
+func (o *DeployClient) reportContainerLogs(podName, container string) {
+	logs, err := o.kubeClient.GetPodLogs(podName, container, platform.PodLogsOptions{
+		TailLines: pointer.Int64(reportedLogLines),
+	})
+	if err != nil {
+		klog.V(4).Infof("unable to get the logs of container %q of pod %q: %v", container, podName, err)
+		return
+	}
+	defer logs.Close()
+	var lines []string
+	scanner := bufio.NewScanner(logs)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if len(lines) == 0 {
+		return
+	}
+	log.Infof("Last log lines of container %q:", container)
+	for _, line := range lines {
+		log.Printf("%s", line)
+	}
+}
@@ -0,0 +1,129 @@
+package deploy
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/pointer"
+
+	"github.com/redhat-developer/odo/pkg/kclient"
+)
+
+func Test_getWorkloadPods(t *testing.T) {
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}
+	owner := func(kind string) []metav1.OwnerReference {
+		return []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: kind, Name: "web", UID: "uid-web", Controller: pointer.Bool(true)}}
+	}
+	replicaSet := func(revision, hash string, owners []metav1.OwnerReference) unstructured.Unstructured {
+		return *toUnstructured(t, &appsv1.ReplicaSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "web-" + hash,
+				Annotations:     map[string]string{deploymentRevisionAnnotation: revision},
+				Labels:          map[string]string{"app": "web", appsv1.DefaultDeploymentUniqueLabelKey: hash},
+				OwnerReferences: owners,
+			},
+		}, "apps/v1", "ReplicaSet")
+	}
+	controllerRevision := func(revision int64, hash string) unstructured.Unstructured {
+		return *toUnstructured(t, &appsv1.ControllerRevision{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "web-" + hash,
+				Labels:          map[string]string{"app": "web", appsv1.ControllerRevisionHashLabelKey: hash},
+				OwnerReferences: owner("DaemonSet"),
+			},
+			Revision: revision,
+		}, "apps/v1", "ControllerRevision")
+	}
+	deployment := func(revision string, generation, observedGeneration int64) *unstructured.Unstructured {
+		return toUnstructured(t, &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", UID: "uid-web", Generation: generation, Annotations: map[string]string{deploymentRevisionAnnotation: revision}},
+			Spec:       appsv1.DeploymentSpec{Selector: selector},
+			Status:     appsv1.DeploymentStatus{ObservedGeneration: observedGeneration},
+		}, "apps/v1", "Deployment")
+	}
+	replicaSets := []unstructured.Unstructured{
+		replicaSet("1", "aaa", owner("Deployment")),
+		replicaSet("2", "bbb", owner("Deployment")),
+		// not controlled by the Deployment
+		replicaSet("3", "ccc", nil),
+	}
+
+	tests := []struct {
+		name string
+		// resources are the ReplicaSets or ControllerRevisions listed
+		resources []unstructured.Unstructured
+		workload  *unstructured.Unstructured
+		// wantSelector is the selector of the pods, or empty if no pods are expected to be listed
+		wantSelector string
+	}{
+		{
+			name:         "deployment, pods of the newest ReplicaSet",
+			resources:    replicaSets,
+			workload:     deployment("2", 2, 2),
+			wantSelector: "app=web,pod-template-hash=bbb",
+		},
+		{
+			name:      "deployment, changes not observed yet",
+			resources: replicaSets,
+			workload:  deployment("2", 3, 2),
+		},
+		{
+			name:      "deployment, newest ReplicaSet not created yet",
+			resources: replicaSets,
+			workload:  deployment("3", 3, 3),
+		},
+		{
+			name: "statefulset, pods of the update revision",
+			workload: toUnstructured(t, &appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "web", UID: "uid-web", Generation: 2},
+				Spec:       appsv1.StatefulSetSpec{Selector: selector},
+				Status:     appsv1.StatefulSetStatus{ObservedGeneration: 2, UpdateRevision: "web-7b9f"},
+			}, "apps/v1", "StatefulSet"),
+			wantSelector: "app=web,controller-revision-hash=web-7b9f",
+		},
+		{
+			name:      "daemonset, pods of the newest ControllerRevision",
+			resources: []unstructured.Unstructured{controllerRevision(2, "bbb"), controllerRevision(1, "aaa")},
+			workload: toUnstructured(t, &appsv1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "web", UID: "uid-web", Generation: 2},
+				Spec:       appsv1.DaemonSetSpec{Selector: selector},
+				Status:     appsv1.DaemonSetStatus{ObservedGeneration: 2},
+			}, "apps/v1", "DaemonSet"),
+			wantSelector: "app=web,controller-revision-hash=bbb",
+		},
+		{
+			name: "job, all the pods",
+			workload: toUnstructured(t, &batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{Name: "web", UID: "uid-web"},
+				Spec:       batchv1.JobSpec{Selector: selector},
+			}, "batch/v1", "Job"),
+			wantSelector: "app=web",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			kubeClient := kclient.NewMockClientInterface(ctrl)
+			kubeClient.EXPECT().ListDynamicResources("", gomock.Any(), "app=web").
+				Return(&unstructured.UnstructuredList{Items: tt.resources}, nil).AnyTimes()
+			pod := corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-pod"}}
+			if tt.wantSelector != "" {
+				kubeClient.EXPECT().GetPodsMatchingSelector(tt.wantSelector).Return(&corev1.PodList{Items: []corev1.Pod{pod}}, nil)
+			}
+
+			o := NewDeployClient(kubeClient, nil, nil)
+			got, err := o.getWorkloadPods(tt.workload)
+			if err != nil {
+				t.Fatalf("getWorkloadPods() unexpected error: %v", err)
+			}
+			if wantPods := tt.wantSelector != ""; (len(got) != 0) != wantPods {
+				t.Errorf("getWorkloadPods() returned %d pods, want pods: %v", len(got), wantPods)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package common
 
 import (
+	"fmt"
 	"path/filepath"
 	"strings"
 
@@ -21,3 +22,19 @@ func GetSyncFilesFromAttributes(command v1alpha2.Command) map[string]string {
 	}
 	return syncMap
 }
+
+const _devPullPathsAttribute = "dev.odo.pull.paths"
+
+// GetPullPathsFromAttributes gets the paths of the files and folders to pull from the container, relative to
+// the project directory in the container. It uses the "dev.odo.pull.paths" attribute, if any, in the specified component.
+func GetPullPathsFromAttributes(component v1alpha2.Component) ([]string, error) {
+	if !component.Attributes.Exists(_devPullPathsAttribute) {
+		return nil, nil
+	}
+	var paths []string
+	err := component.Attributes.GetInto(_devPullPathsAttribute, &paths)
+	if err != nil {
+		return nil, fmt.Errorf("attribute %q of component %q must be a list of paths: %w", _devPullPathsAttribute, component.Name, err)
+	}
+	return paths, nil
+}
@@ -89,3 +89,50 @@ func TestGetSyncFilesFromAttributes(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPullPathsFromAttributes(t *testing.T) {
+	tests := []struct {
+		name      string
+		component v1alpha2.Component
+		want      []string
+		wantErr   bool
+	}{
+		{
+			name:      "no attributes",
+			component: v1alpha2.Component{Name: "runtime"},
+			want:      nil,
+		},
+		{
+			name: "list of paths",
+			component: v1alpha2.Component{
+				Name: "runtime",
+				Attributes: attributes.Attributes{}.FromMap(map[string]interface{}{
+					"dev.odo.pull.paths": []string{"package-lock.json", "generated"},
+				}, nil),
+			},
+			want: []string{"package-lock.json", "generated"},
+		},
+		{
+			name: "not a list",
+			component: v1alpha2.Component{
+				Name: "runtime",
+				Attributes: attributes.Attributes{}.FromStringMap(map[string]string{
+					"dev.odo.pull.paths": "package-lock.json",
+				}),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetPullPathsFromAttributes(tt.component)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetPullPathsFromAttributes() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("GetPullPathsFromAttributes() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
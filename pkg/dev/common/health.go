@@ -0,0 +1,244 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/configAutomount"
+	"github.com/redhat-developer/odo/pkg/dev"
+	"github.com/redhat-developer/odo/pkg/exec"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/platform"
+	"github.com/redhat-developer/odo/pkg/remotecmd"
+	"github.com/redhat-developer/odo/pkg/state"
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+)
+
+const _devHealthProbeAttribute = "dev.odo.health.probe"
+
+const (
+	// HealthProbeTCP checks that a connection can be opened on the forwarded port
+	HealthProbeTCP = "tcp"
+	// HealthProbeHTTP checks that a GET request on the forwarded port returns a 2xx or 3xx status
+	HealthProbeHTTP = "http"
+)
+
+const (
+	// probeAttempts is the number of times a failing probe is tried before considering the command unhealthy
+	probeAttempts = 3
+	// probeInterval is the time between two attempts of a failing probe
+	probeInterval = 1 * time.Second
+	// probeTimeout is the timeout of each attempt of a probe
+	probeTimeout = 2 * time.Second
+)
+
+// HealthProbe defines how to check that the application started by the run (or debug) command is responding
+type HealthProbe struct {
+	// Type is either HealthProbeTCP or HealthProbeHTTP
+	Type string
+	// ContainerPort is the port in the container, the probe is done on the local port forwarded to it
+	ContainerPort int
+	// Path is the path of the HTTP request, for an HTTP probe
+	Path string
+}
+
+// GetHealthProbeFromAttributes gets the probe defined by the "dev.odo.health.probe" attribute of the command, if any.
+// The value of the attribute is either "tcp:<containerPort>" or "http:<containerPort>[/<path>]".
+func GetHealthProbeFromAttributes(command devfilev1.Command) (*HealthProbe, error) {
+	value := command.Attributes.GetString(_devHealthProbeAttribute, nil)
+	if value == "" {
+		return nil, nil
+	}
+
+	invalidErr := fmt.Errorf("invalid value %q for attribute %q of command %q, must be %q or %q",
+		value, _devHealthProbeAttribute, command.Id, HealthProbeTCP+":<port>", HealthProbeHTTP+":<port>/<path>")
+
+	probeType, target, found := strings.Cut(value, ":")
+	if !found {
+		return nil, invalidErr
+	}
+	probe := HealthProbe{
+		Type: probeType,
+	}
+	portStr := target
+	switch probeType {
+	case HealthProbeTCP:
+	case HealthProbeHTTP:
+		probe.Path = "/"
+		if i := strings.Index(target, "/"); i != -1 {
+			portStr = target[:i]
+			probe.Path = target[i:]
+		}
+	default:
+		return nil, invalidErr
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil || port <= 0 {
+		return nil, invalidErr
+	}
+	probe.ContainerPort = port
+	return &probe, nil
+}
+
+// CheckRunCommandHealth checks that the process of the run (or debug) command is running in the component's container
+// and, if a probe is defined for this command, that the application responds on the forwarded port.
+// If the command is not healthy, a reason is returned.
+// Only exec commands are checked, other commands are considered healthy.
+func CheckRunCommandHealth(
+	ctx context.Context,
+	options dev.StartOptions,
+	platformClient platform.Client,
+	execClient exec.Client,
+	stateClient state.Client,
+) (bool, string, error) {
+	var (
+		componentName = odocontext.GetComponentName(ctx)
+		devfileObj    = odocontext.GetEffectiveDevfileObj(ctx)
+	)
+
+	cmdName, cmdKind := getRunOrDebugCommandName(options)
+	cmd, hasCmd, err := libdevfile.GetCommand(*devfileObj, cmdName, cmdKind)
+	if err != nil {
+		return false, "", err
+	}
+	if !hasCmd || cmd.Exec == nil {
+		return true, "", nil
+	}
+
+	pod, err := platformClient.GetPodUsingComponentName(componentName)
+	if err != nil {
+		return false, "", fmt.Errorf("unable to get pod for component %s: %w", componentName, err)
+	}
+
+	remoteProcess, err := remotecmd.NewKubeExecProcessHandler(execClient).GetProcessInfoForCommand(
+		ctx, remotecmd.CommandDefinition{Id: cmd.Id}, pod.GetName(), cmd.Exec.Component)
+	if err != nil {
+		return false, "", err
+	}
+	if remoteProcess.Status != remotecmd.Running {
+		return false, fmt.Sprintf("the process of command %q is %s", cmd.Id, remoteProcess.Status), nil
+	}
+
+	probe, err := GetHealthProbeFromAttributes(cmd)
+	if err != nil || probe == nil {
+		return true, "", err
+	}
+
+	fwPorts, err := stateClient.GetForwardedPorts(ctx)
+	if err != nil {
+		return false, "", err
+	}
+	for _, fwPort := range fwPorts {
+		if fwPort.IsReverse || fwPort.ContainerName != cmd.Exec.Component || fwPort.ContainerPort != probe.ContainerPort {
+			continue
+		}
+		address := fwPort.LocalAddress
+		if address == "" {
+			address = "127.0.0.1"
+		}
+		if err = RunHealthProbe(ctx, *probe, net.JoinHostPort(address, strconv.Itoa(fwPort.LocalPort))); err != nil {
+			return false, fmt.Sprintf("the %s probe on port %d failed: %v", probe.Type, probe.ContainerPort, err), nil
+		}
+		return true, "", nil
+	}
+	klog.V(4).Infof("port %d of container %q is not forwarded, skipping the %s probe", probe.ContainerPort, cmd.Exec.Component, probe.Type)
+	return true, "", nil
+}
+
+// RunHealthProbe runs the probe against the local address, retrying it when it fails
+func RunHealthProbe(ctx context.Context, probe HealthProbe, address string) error {
+	var err error
+	for i := 0; i < probeAttempts; i++ {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(probeInterval):
+			}
+		}
+		switch probe.Type {
+		case HealthProbeTCP:
+			err = probeTCP(ctx, address)
+		case HealthProbeHTTP:
+			err = probeHTTP(ctx, address, probe.Path)
+		default:
+			return fmt.Errorf("unknown probe type %q", probe.Type)
+		}
+		if err == nil {
+			return nil
+		}
+		klog.V(4).Infof("attempt %d of %s probe on %s failed: %v", i+1, probe.Type, address, err)
+	}
+	return err
+}
+
+func probeTCP(ctx context.Context, address string) error {
+	dialer := net.Dialer{Timeout: probeTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+func probeHTTP(ctx context.Context, address string, path string) error {
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://"+address+path, nil)
+	if err != nil {
+		return err
+	}
+	client := http.Client{
+		// A redirection is a valid response, there is no need to follow it
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+		return fmt.Errorf("unexpected status %q", resp.Status)
+	}
+	return nil
+}
+
+// RestartRunCommand executes again the run (or debug) command in the component's container
+func RestartRunCommand(
+	ctx context.Context,
+	options dev.StartOptions,
+	platformClient platform.Client,
+	execClient exec.Client,
+	configAutomountClient configAutomount.Client,
+	filesystem filesystem.Filesystem,
+) error {
+	devfileObj := odocontext.GetEffectiveDevfileObj(ctx)
+	cmdName, cmdKind := getRunOrDebugCommandName(options)
+	cmd, hasCmd, err := libdevfile.GetCommand(*devfileObj, cmdName, cmdKind)
+	if err != nil {
+		return err
+	}
+	if !hasCmd {
+		return nil
+	}
+	return Run(ctx, cmd.Id, platformClient, execClient, configAutomountClient, filesystem)
+}
+
+// getRunOrDebugCommandName returns the name and kind of the command to execute to start the application
+func getRunOrDebugCommandName(options dev.StartOptions) (string, devfilev1.CommandGroupKind) {
+	if options.Debug {
+		return options.DebugCommand, devfilev1.DebugCommandGroupKind
+	}
+	return options.RunCommand, devfilev1.RunCommandGroupKind
+}
@@ -0,0 +1,146 @@
+package common
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/attributes"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGetHealthProbeFromAttributes(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    *HealthProbe
+		wantErr bool
+	}{
+		{
+			name: "no attribute",
+			want: nil,
+		},
+		{
+			name:  "tcp probe",
+			value: "tcp:8080",
+			want:  &HealthProbe{Type: HealthProbeTCP, ContainerPort: 8080},
+		},
+		{
+			name:  "http probe without path",
+			value: "http:3000",
+			want:  &HealthProbe{Type: HealthProbeHTTP, ContainerPort: 3000, Path: "/"},
+		},
+		{
+			name:  "http probe with path",
+			value: "http:3000/api/health",
+			want:  &HealthProbe{Type: HealthProbeHTTP, ContainerPort: 3000, Path: "/api/health"},
+		},
+		{
+			name:    "tcp probe with path",
+			value:   "tcp:8080/health",
+			wantErr: true,
+		},
+		{
+			name:    "unknown probe type",
+			value:   "grpc:8080",
+			wantErr: true,
+		},
+		{
+			name:    "missing port",
+			value:   "http",
+			wantErr: true,
+		},
+		{
+			name:    "invalid port",
+			value:   "http:-1/health",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			command := v1alpha2.Command{Id: "run"}
+			if tt.value != "" {
+				command.Attributes = attributes.Attributes{}.FromStringMap(map[string]string{
+					_devHealthProbeAttribute: tt.value,
+				})
+			}
+			got, err := GetHealthProbeFromAttributes(command)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetHealthProbeFromAttributes() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("GetHealthProbeFromAttributes() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRunHealthProbe(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/health":
+			w.WriteHeader(http.StatusOK)
+		case "/login":
+			http.Redirect(w, r, "/health", http.StatusFound)
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+	serverAddress := strings.TrimPrefix(server.URL, "http://")
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	closedAddress := listener.Addr().String()
+	listener.Close()
+
+	tests := []struct {
+		name    string
+		probe   HealthProbe
+		address string
+		wantErr bool
+	}{
+		{
+			name:    "tcp probe on listening port",
+			probe:   HealthProbe{Type: HealthProbeTCP},
+			address: serverAddress,
+		},
+		{
+			name:    "tcp probe on closed port",
+			probe:   HealthProbe{Type: HealthProbeTCP},
+			address: closedAddress,
+			wantErr: true,
+		},
+		{
+			name:    "http probe with success status",
+			probe:   HealthProbe{Type: HealthProbeHTTP, Path: "/health"},
+			address: serverAddress,
+		},
+		{
+			name:    "http probe with redirect status",
+			probe:   HealthProbe{Type: HealthProbeHTTP, Path: "/login"},
+			address: serverAddress,
+		},
+		{
+			name:    "http probe with error status",
+			probe:   HealthProbe{Type: HealthProbeHTTP, Path: "/"},
+			address: serverAddress,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := RunHealthProbe(context.Background(), tt.probe, tt.address)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RunHealthProbe() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package common
+
+import (
+	"fmt"
+	"regexp"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/dev"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+)
+
+const (
+	_devLogsReadyAttribute = "dev.odo.logs.ready"
+	_devLogsErrorAttribute = "dev.odo.logs.error"
+)
+
+// LogPatterns are the regular expressions matched against the lines of the logs of the application
+// started by the run (or debug) command
+type LogPatterns struct {
+	// Container is the container in which the command is executed, whose logs are matched.
+	// The logs of all the containers are matched if empty.
+	Container string
+	// Ready, if not nil, matches a line indicating that the application is ready
+	Ready *regexp.Regexp
+	// Error, if not nil, matches a line reporting an error of the application
+	Error *regexp.Regexp
+}
+
+// IsReady returns true if the line of logs indicates that the application is ready
+func (o LogPatterns) IsReady(line api.ContainerLogLine) bool {
+	return o.Ready != nil && o.matchesContainer(line) && o.Ready.MatchString(line.Message)
+}
+
+// IsError returns true if the line of logs reports an error of the application
+func (o LogPatterns) IsError(line api.ContainerLogLine) bool {
+	return o.Error != nil && o.matchesContainer(line) && o.Error.MatchString(line.Message)
+}
+
+func (o LogPatterns) matchesContainer(line api.ContainerLogLine) bool {
+	return o.Container == "" || o.Container == line.Container
+}
+
+// GetLogPatternsFromAttributes gets the patterns defined by the "dev.odo.logs.ready" and "dev.odo.logs.error" attributes
+// of the command, or nil if none is defined.
+// The value of each attribute is a regular expression, matched against each line of the logs.
+func GetLogPatternsFromAttributes(command devfilev1.Command) (*LogPatterns, error) {
+	var (
+		patterns LogPatterns
+		found    bool
+	)
+	for _, attr := range []struct {
+		name   string
+		target **regexp.Regexp
+	}{
+		{name: _devLogsReadyAttribute, target: &patterns.Ready},
+		{name: _devLogsErrorAttribute, target: &patterns.Error},
+	} {
+		value := command.Attributes.GetString(attr.name, nil)
+		if value == "" {
+			continue
+		}
+		re, err := regexp.Compile(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q for attribute %q of command %q: %w", value, attr.name, command.Id, err)
+		}
+		*attr.target = re
+		found = true
+	}
+	if !found {
+		return nil, nil
+	}
+	if command.Exec != nil {
+		patterns.Container = command.Exec.Component
+	}
+	return &patterns, nil
+}
+
+// GetRunCommandLogPatterns gets the log patterns of the run (or debug) command, or nil if the command does not declare any
+func GetRunCommandLogPatterns(devfileObj parser.DevfileObj, options dev.StartOptions) (*LogPatterns, error) {
+	cmdName, cmdKind := getRunOrDebugCommandName(options)
+	cmd, hasCmd, err := libdevfile.GetCommand(devfileObj, cmdName, cmdKind)
+	if err != nil || !hasCmd {
+		return nil, err
+	}
+	return GetLogPatternsFromAttributes(cmd)
+}
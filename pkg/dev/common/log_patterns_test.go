@@ -0,0 +1,148 @@
+package common
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/attributes"
+
+	"github.com/redhat-developer/odo/pkg/api"
+)
+
+func TestGetLogPatternsFromAttributes(t *testing.T) {
+	tests := []struct {
+		name          string
+		attributes    map[string]string
+		exec          bool
+		wantNil       bool
+		wantErr       bool
+		wantContainer string
+		wantReady     string
+		wantError     string
+	}{
+		{
+			name:    "no attribute",
+			wantNil: true,
+		},
+		{
+			name: "ready pattern of an exec command",
+			attributes: map[string]string{
+				_devLogsReadyAttribute: "Started Application in",
+			},
+			exec:          true,
+			wantContainer: "runtime",
+			wantReady:     "Started Application in",
+		},
+		{
+			name: "ready and error patterns of a composite command",
+			attributes: map[string]string{
+				_devLogsReadyAttribute: "listening on port [0-9]+",
+				_devLogsErrorAttribute: "ERROR|Exception",
+			},
+			wantReady: "listening on port [0-9]+",
+			wantError: "ERROR|Exception",
+		},
+		{
+			name: "invalid pattern",
+			attributes: map[string]string{
+				_devLogsErrorAttribute: "ERROR(",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			command := v1alpha2.Command{Id: "run"}
+			if tt.exec {
+				command.Exec = &v1alpha2.ExecCommand{Component: "runtime"}
+			}
+			if tt.attributes != nil {
+				command.Attributes = attributes.Attributes{}.FromStringMap(tt.attributes)
+			}
+			got, err := GetLogPatternsFromAttributes(command)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetLogPatternsFromAttributes() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if (got == nil) != tt.wantNil {
+				t.Fatalf("GetLogPatternsFromAttributes() = %v, wantNil %v", got, tt.wantNil)
+			}
+			if got == nil {
+				return
+			}
+			if got.Container != tt.wantContainer {
+				t.Errorf("container = %q, want %q", got.Container, tt.wantContainer)
+			}
+			if s := regexpString(got.Ready); s != tt.wantReady {
+				t.Errorf("ready pattern = %q, want %q", s, tt.wantReady)
+			}
+			if s := regexpString(got.Error); s != tt.wantError {
+				t.Errorf("error pattern = %q, want %q", s, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestLogPatterns_Match(t *testing.T) {
+	command := v1alpha2.Command{
+		Id: "run",
+		CommandUnion: v1alpha2.CommandUnion{
+			Exec: &v1alpha2.ExecCommand{Component: "runtime"},
+		},
+		Attributes: attributes.Attributes{}.FromStringMap(map[string]string{
+			_devLogsReadyAttribute: "Started Application in",
+			_devLogsErrorAttribute: "^ERROR",
+		}),
+	}
+	patterns, err := GetLogPatternsFromAttributes(command)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		line      api.ContainerLogLine
+		wantReady bool
+		wantError bool
+	}{
+		{
+			name:      "ready line",
+			line:      api.ContainerLogLine{Container: "runtime", Message: "Started Application in 2.3 seconds"},
+			wantReady: true,
+		},
+		{
+			name:      "error line",
+			line:      api.ContainerLogLine{Container: "runtime", Message: "ERROR unable to connect to the database"},
+			wantError: true,
+		},
+		{
+			name: "other line",
+			line: api.ContainerLogLine{Container: "runtime", Message: "Starting Application"},
+		},
+		{
+			name: "ready line of another container",
+			line: api.ContainerLogLine{Container: "sidecar", Message: "Started Application in 2.3 seconds"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := patterns.IsReady(tt.line); got != tt.wantReady {
+				t.Errorf("IsReady() = %v, want %v", got, tt.wantReady)
+			}
+			if got := patterns.IsError(tt.line); got != tt.wantError {
+				t.Errorf("IsError() = %v, want %v", got, tt.wantError)
+			}
+		})
+	}
+}
+
+func regexpString(re *regexp.Regexp) string {
+	if re == nil {
+		return ""
+	}
+	return re.String()
+}
@@ -0,0 +1,105 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	parsercommon "github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/dev"
+	"github.com/redhat-developer/odo/pkg/exec"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/platform"
+	"github.com/redhat-developer/odo/pkg/port"
+	"github.com/redhat-developer/odo/pkg/state"
+)
+
+// DetectListeningPorts returns the ports listening in the pod of the component which are neither declared as endpoints
+// in the Devfile, nor already forwarded, sorted by port.
+// As all the containers of the pod share the same network, the ports are scanned from, and attributed to,
+// the container of the run (or debug) command, or the first container of the Devfile.
+// helperListensOnLocalPorts must be true when the local ports of the forwarded ports are listening in the pod,
+// as done by the port forwarding helper container on Podman, so these ports are not reported.
+func DetectListeningPorts(
+	ctx context.Context,
+	options dev.StartOptions,
+	platformClient platform.Client,
+	execClient exec.Client,
+	stateClient state.Client,
+	helperListensOnLocalPorts bool,
+) ([]api.ForwardedPort, error) {
+	var (
+		componentName = odocontext.GetComponentName(ctx)
+		devfileObj    = odocontext.GetEffectiveDevfileObj(ctx)
+	)
+
+	containers, err := devfileObj.Data.GetComponents(parsercommon.DevfileOptions{
+		ComponentOptions: parsercommon.ComponentOptions{ComponentType: devfilev1.ContainerComponentType},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(containers) == 0 {
+		return nil, nil
+	}
+	containerName := containers[0].Name
+	cmdName, cmdKind := getRunOrDebugCommandName(options)
+	cmd, hasCmd, err := libdevfile.GetCommand(*devfileObj, cmdName, cmdKind)
+	if err != nil {
+		return nil, err
+	}
+	if hasCmd && cmd.Exec != nil {
+		containerName = cmd.Exec.Component
+	}
+
+	excluded := make(map[int]struct{})
+	ceMapping, err := libdevfile.GetDevfileContainerEndpointMapping(*devfileObj, true)
+	if err != nil {
+		return nil, err
+	}
+	for _, endpoints := range ceMapping {
+		for _, endpoint := range endpoints {
+			excluded[endpoint.TargetPort] = struct{}{}
+		}
+	}
+	fwPorts, err := stateClient.GetForwardedPorts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, fwPort := range fwPorts {
+		excluded[fwPort.ContainerPort] = struct{}{}
+		if helperListensOnLocalPorts && !fwPort.IsReverse {
+			excluded[fwPort.LocalPort] = struct{}{}
+		}
+	}
+
+	pod, err := platformClient.GetPodUsingComponentName(componentName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get pod for component %s: %w", componentName, err)
+	}
+	connections, err := port.GetListeningConnections(ctx, execClient, pod.GetName(), containerName)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []api.ForwardedPort
+	for _, conn := range connections {
+		if _, found := excluded[conn.LocalPort]; found {
+			continue
+		}
+		// a port listening on both IPv4 and IPv6 is reported once
+		excluded[conn.LocalPort] = struct{}{}
+		result = append(result, api.ForwardedPort{
+			ContainerName: containerName,
+			ContainerPort: conn.LocalPort,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ContainerPort < result[j].ContainerPort
+	})
+	return result, nil
+}
@@ -0,0 +1,127 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/dev"
+	"github.com/redhat-developer/odo/pkg/exec"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/platform"
+	"github.com/redhat-developer/odo/pkg/state"
+	"github.com/redhat-developer/odo/pkg/testingutil"
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+	"github.com/redhat-developer/odo/pkg/testingutil/system"
+)
+
+func TestDetectListeningPorts(t *testing.T) {
+	devfileData, err := data.NewDevfileData(string(data.APISchemaVersion200))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = devfileData.AddComponents([]v1alpha2.Component{
+		testingutil.GetFakeContainerComponent("tools"),
+		testingutil.GetFakeContainerComponent("runtime", 3000),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	isDefault := true
+	err = devfileData.AddCommands([]v1alpha2.Command{{
+		Id: "run",
+		CommandUnion: v1alpha2.CommandUnion{
+			Exec: &v1alpha2.ExecCommand{
+				LabeledCommand: v1alpha2.LabeledCommand{
+					BaseCommand: v1alpha2.BaseCommand{
+						Group: &v1alpha2.CommandGroup{Kind: v1alpha2.RunCommandGroupKind, IsDefault: &isDefault},
+					},
+				},
+				CommandLine: "npm start",
+				Component:   "runtime",
+			},
+		},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	ctx = odocontext.WithPID(ctx, 1)
+	ctx = odocontext.WithComponentName(ctx, "mycomponent")
+	ctx = odocontext.WithEffectiveDevfileObj(ctx, &parser.DevfileObj{Data: devfileData})
+
+	stateClient := state.NewStateClient(filesystem.NewFakeFs(), system.Fake{})
+	err = stateClient.SetForwardedPorts(ctx, []api.ForwardedPort{
+		{ContainerName: "runtime", ContainerPort: 3000, LocalAddress: "127.0.0.1", LocalPort: 20001},
+		{ContainerName: "runtime", ContainerPort: 4000, LocalAddress: "127.0.0.1", LocalPort: 20002, IsDynamic: true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name                      string
+		helperListensOnLocalPorts bool
+		want                      []api.ForwardedPort
+	}{
+		{
+			name: "local ports not listening in the pod",
+			want: []api.ForwardedPort{
+				{ContainerName: "runtime", ContainerPort: 8081},
+				{ContainerName: "runtime", ContainerPort: 9229},
+				{ContainerName: "runtime", ContainerPort: 20001},
+			},
+		},
+		{
+			name:                      "local ports listening in the pod",
+			helperListensOnLocalPorts: true,
+			want: []api.ForwardedPort{
+				{ContainerName: "runtime", ContainerPort: 8081},
+				{ContainerName: "runtime", ContainerPort: 9229},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			platformClient := platform.NewMockClient(ctrl)
+			platformClient.EXPECT().GetPodUsingComponentName("mycomponent").
+				Return(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "mypod"}}, nil)
+			execClient := exec.NewMockClient(ctrl)
+			execClient.EXPECT().ExecuteCommand(gomock.Any(), gomock.Any(), "mypod", "runtime", false, nil, nil).
+				Return([]string{
+					"  sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode",
+					// declared as endpoint
+					"   0: 00000000:0BB8 00000000:0000 0A 00000000:00000000 00:00000000 00000000  1001        0 1",
+					// listening on loopback
+					"   1: 0100007F:240D 00000000:0000 0A 00000000:00000000 00:00000000 00000000  1001        0 2",
+					// not listening
+					"   2: 0100007F:1538 0100007F:9C4C 01 00000000:00000000 00:00000000 00000000  1001        0 3",
+					// local port forwarded to an endpoint, listening in the pod on Podman
+					"   3: 00000000:4E21 00000000:0000 0A 00000000:00000000 00:00000000 00000000  1001        0 4",
+					// already forwarded
+					"   4: 00000000:0FA0 00000000:0000 0A 00000000:00000000 00:00000000 00000000  1001        0 5",
+					"   5: 00000000:1F91 00000000:0000 0A 00000000:00000000 00:00000000 00000000  1001        0 6",
+					"  sl  local_address                         remote_address                        st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode",
+					// listening on both IPv4 and IPv6
+					"   0: 00000000000000000000000000000000:1F91 00000000000000000000000000000000:0000 0A 00000000:00000000 00:00000000 00000000  1001        0 7",
+				}, nil, nil)
+
+			got, err := DetectListeningPorts(ctx, dev.StartOptions{}, platformClient, execClient, stateClient, tt.helperListensOnLocalPorts)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("DetectListeningPorts() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
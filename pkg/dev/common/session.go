@@ -0,0 +1,77 @@
+package common
+
+import (
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+
+	"github.com/redhat-developer/odo/pkg/dev"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	"github.com/redhat-developer/odo/pkg/state"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// CompleteSession completes the session (containing the status of the component) with the information
+// about the Devfile, the commands executed, the pod running the component and the files synced from path
+func CompleteSession(
+	session state.Session,
+	devfileObj parser.DevfileObj,
+	options dev.StartOptions,
+	podName string,
+	path string,
+) (state.Session, error) {
+	var err error
+	session.PodName = podName
+
+	session.DevfileHash, err = libdevfile.GetDevfileHash(devfileObj)
+	if err != nil {
+		return state.Session{}, err
+	}
+
+	session.SyncIndexDigest, err = util.GetIndexFileDigest(path)
+	if err != nil {
+		return state.Session{}, err
+	}
+
+	if options.SkipCommands {
+		return session, nil
+	}
+
+	buildCmd, hasBuildCmd, err := libdevfile.GetCommand(devfileObj, options.BuildCommand, devfilev1.BuildCommandGroupKind)
+	if err != nil {
+		return state.Session{}, err
+	}
+	if hasBuildCmd {
+		session.BuildCommand = buildCmd.Id
+	}
+
+	cmdKind, cmdName := devfilev1.RunCommandGroupKind, options.RunCommand
+	if options.Debug {
+		cmdKind, cmdName = devfilev1.DebugCommandGroupKind, options.DebugCommand
+	}
+	runCmd, hasRunCmd, err := libdevfile.GetCommand(devfileObj, cmdName, cmdKind)
+	if err != nil {
+		return state.Session{}, err
+	}
+	if hasRunCmd && session.RunExecuted {
+		session.RunCommand = runCmd.Id
+	}
+	return session, nil
+}
+
+// IsSessionRestorable returns true if the session has been started with the same Devfile,
+// and if the index of the files in path has not been modified since the last sync done by the session
+func IsSessionRestorable(session state.Session, devfileObj parser.DevfileObj, path string) (bool, error) {
+	devfileHash, err := libdevfile.GetDevfileHash(devfileObj)
+	if err != nil {
+		return false, err
+	}
+	if session.DevfileHash == "" || devfileHash != session.DevfileHash {
+		return false, nil
+	}
+
+	indexDigest, err := util.GetIndexFileDigest(path)
+	if err != nil {
+		return false, err
+	}
+	return indexDigest == session.SyncIndexDigest, nil
+}
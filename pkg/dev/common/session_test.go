@@ -0,0 +1,236 @@
+package common
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/redhat-developer/odo/pkg/dev"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	"github.com/redhat-developer/odo/pkg/state"
+	"github.com/redhat-developer/odo/pkg/testingutil"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+func getSessionDevfileObj(t *testing.T, runCommandLine string) parser.DevfileObj {
+	devfileData, err := data.NewDevfileData(string(data.APISchemaVersion200))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = devfileData.AddComponents([]v1alpha2.Component{
+		testingutil.GetFakeContainerComponent("runtime", 3000),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	isDefault := true
+	execCommand := func(id string, kind v1alpha2.CommandGroupKind, commandLine string) v1alpha2.Command {
+		return v1alpha2.Command{
+			Id: id,
+			CommandUnion: v1alpha2.CommandUnion{
+				Exec: &v1alpha2.ExecCommand{
+					LabeledCommand: v1alpha2.LabeledCommand{
+						BaseCommand: v1alpha2.BaseCommand{
+							Group: &v1alpha2.CommandGroup{Kind: kind, IsDefault: &isDefault},
+						},
+					},
+					CommandLine: commandLine,
+					Component:   "runtime",
+				},
+			},
+		}
+	}
+	err = devfileData.AddCommands([]v1alpha2.Command{
+		execCommand("build", v1alpha2.BuildCommandGroupKind, "npm install"),
+		execCommand("run", v1alpha2.RunCommandGroupKind, runCommandLine),
+		execCommand("debug", v1alpha2.DebugCommandGroupKind, "npm run debug"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parser.DevfileObj{Data: devfileData}
+}
+
+func writeIndexFile(t *testing.T, dir string, content string) {
+	err := os.MkdirAll(filepath.Join(dir, util.DotOdoDirectory), 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+	indexFile, err := util.ResolveIndexFilePath(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.WriteFile(indexFile, []byte(content), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCompleteSession(t *testing.T) {
+	devfileObj := getSessionDevfileObj(t, "npm start")
+	devfileHash, err := libdevfile.GetDevfileHash(devfileObj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	writeIndexFile(t, dir, `{"files":{}}`)
+	indexDigest, err := util.GetIndexFileDigest(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		session state.Session
+		options dev.StartOptions
+		want    state.Session
+	}{
+		{
+			name:    "run command executed",
+			session: state.Session{PostStartEventsDone: true, RunExecuted: true},
+			want: state.Session{
+				DevfileHash:         devfileHash,
+				BuildCommand:        "build",
+				RunCommand:          "run",
+				PostStartEventsDone: true,
+				RunExecuted:         true,
+				PodName:             "apod",
+				SyncIndexDigest:     indexDigest,
+			},
+		},
+		{
+			name:    "debug command executed",
+			session: state.Session{PostStartEventsDone: true, RunExecuted: true},
+			options: dev.StartOptions{Debug: true},
+			want: state.Session{
+				DevfileHash:         devfileHash,
+				BuildCommand:        "build",
+				RunCommand:          "debug",
+				PostStartEventsDone: true,
+				RunExecuted:         true,
+				PodName:             "apod",
+				SyncIndexDigest:     indexDigest,
+			},
+		},
+		{
+			name:    "run command not executed yet",
+			session: state.Session{PostStartEventsDone: true},
+			want: state.Session{
+				DevfileHash:         devfileHash,
+				BuildCommand:        "build",
+				PostStartEventsDone: true,
+				PodName:             "apod",
+				SyncIndexDigest:     indexDigest,
+			},
+		},
+		{
+			name:    "commands skipped",
+			session: state.Session{PostStartEventsDone: true, RunExecuted: true},
+			options: dev.StartOptions{SkipCommands: true},
+			want: state.Session{
+				DevfileHash:         devfileHash,
+				PostStartEventsDone: true,
+				RunExecuted:         true,
+				PodName:             "apod",
+				SyncIndexDigest:     indexDigest,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CompleteSession(tt.session, devfileObj, tt.options, "apod", dir)
+			if err != nil {
+				t.Errorf("CompleteSession() error = %v", err)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("CompleteSession() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsSessionRestorable(t *testing.T) {
+	devfileObj := getSessionDevfileObj(t, "npm start")
+
+	tests := []struct {
+		name string
+		// modify is called after the session has been completed, to simulate changes done
+		// while no odo dev session was running
+		modify func(t *testing.T, dir string) parser.DevfileObj
+		want   bool
+	}{
+		{
+			name: "nothing changed",
+			modify: func(t *testing.T, dir string) parser.DevfileObj {
+				return devfileObj
+			},
+			want: true,
+		},
+		{
+			name: "Devfile changed",
+			modify: func(t *testing.T, dir string) parser.DevfileObj {
+				return getSessionDevfileObj(t, "npm run start")
+			},
+			want: false,
+		},
+		{
+			name: "index of the synced files changed",
+			modify: func(t *testing.T, dir string) parser.DevfileObj {
+				writeIndexFile(t, dir, `{"files":{"main.js":{}}}`)
+				return devfileObj
+			},
+			want: false,
+		},
+		{
+			name: "index of the synced files removed",
+			modify: func(t *testing.T, dir string) parser.DevfileObj {
+				indexFile, err := util.ResolveIndexFilePath(dir)
+				if err != nil {
+					t.Fatal(err)
+				}
+				err = os.Remove(indexFile)
+				if err != nil {
+					t.Fatal(err)
+				}
+				return devfileObj
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeIndexFile(t, dir, `{"files":{}}`)
+			session, err := CompleteSession(state.Session{PostStartEventsDone: true}, devfileObj, dev.StartOptions{}, "apod", dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := IsSessionRestorable(session, tt.modify(t, dir), dir)
+			if err != nil {
+				t.Errorf("IsSessionRestorable() error = %v", err)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("IsSessionRestorable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("session without Devfile hash", func(t *testing.T) {
+		got, err := IsSessionRestorable(state.Session{PodName: "apod"}, devfileObj, t.TempDir())
+		if err != nil {
+			t.Errorf("IsSessionRestorable() error = %v", err)
+			return
+		}
+		if got {
+			t.Errorf("IsSessionRestorable() = %v, want false", got)
+		}
+	})
+}
@@ -0,0 +1,144 @@
+package common
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	gitignore "github.com/sabhiram/go-gitignore"
+	"k8s.io/klog"
+)
+
+const _devSyncActionAttributePrefix = "dev.odo.sync.action:"
+
+const (
+	// SyncActionSync only syncs the files, without executing any command
+	SyncActionSync = "sync"
+	// SyncActionRestart syncs the files and restarts the run (or debug) command
+	SyncActionRestart = "restart"
+	// SyncActionRebuild syncs the files, executes the build command and restarts the run (or debug) command
+	SyncActionRebuild = "rebuild"
+	// syncActionExecPrefix is the prefix of the action executing the named command after syncing the files
+	syncActionExecPrefix = "exec:"
+)
+
+// SyncRule defines the action to execute when files matching a pattern are synced
+type SyncRule struct {
+	// Pattern is a glob pattern, with the syntax of .gitignore files, relative to the project directory
+	Pattern string
+	// Action is one of SyncActionSync, SyncActionRestart or SyncActionRebuild, or empty if Command is set
+	Action string
+	// Command is the name of the command to execute, for an "exec:<command>" action
+	Command string
+}
+
+// SyncActions are the actions to execute after changed files have been synced
+type SyncActions struct {
+	// Build is true if the build command needs to be executed
+	Build bool
+	// Run is true if the run (or debug) command needs to be restarted
+	Run bool
+	// Commands are the names of the commands to execute
+	Commands []string
+}
+
+// DefaultSyncActions are the actions executed when files not matching any rule are synced
+var DefaultSyncActions = SyncActions{
+	Build: true,
+	Run:   true,
+}
+
+// GetSyncRulesFromAttributes gets the actions to execute when files are synced, from the devfile.
+// It uses the "dev.odo.sync.action:" attribute prefix, if any, in the specified command. The key of the attribute
+// is a glob pattern, and its value is one of "sync", "restart", "rebuild" or "exec:<command>".
+// The rules are sorted by pattern.
+func GetSyncRulesFromAttributes(command v1alpha2.Command) ([]SyncRule, error) {
+	var rules []SyncRule
+	for key, value := range command.Attributes.Strings(nil) {
+		if !strings.HasPrefix(key, _devSyncActionAttributePrefix) {
+			continue
+		}
+		pattern := strings.TrimPrefix(key, _devSyncActionAttributePrefix)
+		if pattern == "" {
+			return nil, fmt.Errorf("attribute %q of command %q must define a pattern after the prefix", key, command.Id)
+		}
+		rule := SyncRule{
+			Pattern: pattern,
+		}
+		switch {
+		case value == SyncActionSync || value == SyncActionRestart || value == SyncActionRebuild:
+			rule.Action = value
+		case strings.HasPrefix(value, syncActionExecPrefix) && len(value) > len(syncActionExecPrefix):
+			rule.Command = strings.TrimPrefix(value, syncActionExecPrefix)
+		default:
+			return nil, fmt.Errorf("invalid value %q for attribute %q of command %q, must be one of %q, %q, %q or %q",
+				value, key, command.Id, SyncActionSync, SyncActionRestart, SyncActionRebuild, syncActionExecPrefix+"<command>")
+		}
+		rules = append(rules, rule)
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].Pattern < rules[j].Pattern
+	})
+	return rules, nil
+}
+
+// GetSyncActions returns the actions to execute after the changedFiles and deletedFiles in path have been synced.
+// A file matching several rules executes the actions of all these rules.
+// If no file has changed, or if a file matches no rule, the default actions are executed.
+func GetSyncActions(rules []SyncRule, path string, changedFiles, deletedFiles []string) SyncActions {
+	files := append(append([]string{}, changedFiles...), deletedFiles...)
+	if len(rules) == 0 || len(files) == 0 {
+		return DefaultSyncActions
+	}
+
+	matchers := make([]*gitignore.GitIgnore, len(rules))
+	for i, rule := range rules {
+		matchers[i] = gitignore.CompileIgnoreLines(rule.Pattern)
+	}
+
+	var result SyncActions
+	for _, file := range files {
+		rel := file
+		if filepath.IsAbs(file) {
+			var err error
+			rel, err = filepath.Rel(path, file)
+			if err != nil {
+				klog.V(4).Infof("unable to get relative path of %q on %q: %v", file, path, err)
+				return DefaultSyncActions
+			}
+		}
+		matched := false
+		for i, rule := range rules {
+			if !matchers[i].MatchesPath(rel) {
+				continue
+			}
+			matched = true
+			switch rule.Action {
+			case SyncActionRebuild:
+				result.Build = true
+				result.Run = true
+			case SyncActionRestart:
+				result.Run = true
+			case "":
+				result.addCommand(rule.Command)
+			}
+		}
+		if !matched {
+			result.Build = true
+			result.Run = true
+		}
+	}
+	klog.V(4).Infof("actions after sync: %+v", result)
+	return result
+}
+
+func (o *SyncActions) addCommand(name string) {
+	for _, command := range o.Commands {
+		if command == name {
+			return
+		}
+	}
+	o.Commands = append(o.Commands, name)
+}
@@ -0,0 +1,149 @@
+package common
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/attributes"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGetSyncRulesFromAttributes(t *testing.T) {
+	tests := []struct {
+		name    string
+		command v1alpha2.Command
+		want    []SyncRule
+		wantErr bool
+	}{
+		{
+			name:    "no attributes",
+			command: v1alpha2.Command{},
+			want:    nil,
+		},
+		{
+			name: "rules for each action",
+			command: v1alpha2.Command{
+				Attributes: attributes.Attributes{}.FromStringMap(map[string]string{
+					"some-custom-attribute-key":                    "some-value",
+					_devSyncActionAttributePrefix + "static/**":    "sync",
+					_devSyncActionAttributePrefix + "*.proto":      "exec:generate",
+					_devSyncActionAttributePrefix + "package.json": "rebuild",
+					_devSyncActionAttributePrefix + "config/*":     "restart",
+				}),
+			},
+			want: []SyncRule{
+				{Pattern: "*.proto", Command: "generate"},
+				{Pattern: "config/*", Action: SyncActionRestart},
+				{Pattern: "package.json", Action: SyncActionRebuild},
+				{Pattern: "static/**", Action: SyncActionSync},
+			},
+		},
+		{
+			name: "invalid action",
+			command: v1alpha2.Command{
+				Attributes: attributes.Attributes{}.FromStringMap(map[string]string{
+					_devSyncActionAttributePrefix + "static/**": "reload",
+				}),
+			},
+			wantErr: true,
+		},
+		{
+			name: "exec action without command",
+			command: v1alpha2.Command{
+				Attributes: attributes.Attributes{}.FromStringMap(map[string]string{
+					_devSyncActionAttributePrefix + "*.proto": "exec:",
+				}),
+			},
+			wantErr: true,
+		},
+		{
+			name: "no pattern",
+			command: v1alpha2.Command{
+				Attributes: attributes.Attributes{}.FromStringMap(map[string]string{
+					_devSyncActionAttributePrefix: "sync",
+				}),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetSyncRulesFromAttributes(tt.command)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetSyncRulesFromAttributes() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("GetSyncRulesFromAttributes() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetSyncActions(t *testing.T) {
+	path := filepath.FromSlash("/project")
+	rules := []SyncRule{
+		{Pattern: "*.proto", Command: "generate"},
+		{Pattern: "config/*", Action: SyncActionRestart},
+		{Pattern: "package.json", Action: SyncActionRebuild},
+		{Pattern: "static/**", Action: SyncActionSync},
+	}
+	tests := []struct {
+		name         string
+		rules        []SyncRule
+		changedFiles []string
+		deletedFiles []string
+		want         SyncActions
+	}{
+		{
+			name:         "no rules",
+			changedFiles: []string{filepath.Join(path, "static", "index.html")},
+			want:         DefaultSyncActions,
+		},
+		{
+			name:  "no changed files",
+			rules: rules,
+			want:  DefaultSyncActions,
+		},
+		{
+			name:         "sync only",
+			rules:        rules,
+			changedFiles: []string{filepath.Join(path, "static", "index.html")},
+			deletedFiles: []string{filepath.Join(path, "static", "css", "main.css")},
+			want:         SyncActions{},
+		},
+		{
+			name:         "restart",
+			rules:        rules,
+			changedFiles: []string{filepath.Join(path, "static", "index.html"), filepath.Join(path, "config", "app.yaml")},
+			want:         SyncActions{Run: true},
+		},
+		{
+			name:         "rebuild",
+			rules:        rules,
+			changedFiles: []string{filepath.Join(path, "package.json"), filepath.Join(path, "config", "app.yaml")},
+			want:         SyncActions{Build: true, Run: true},
+		},
+		{
+			name:         "exec commands once",
+			rules:        rules,
+			changedFiles: []string{filepath.Join(path, "api", "a.proto"), filepath.Join(path, "api", "b.proto")},
+			want:         SyncActions{Commands: []string{"generate"}},
+		},
+		{
+			name:         "file matching no rule",
+			rules:        rules,
+			changedFiles: []string{filepath.Join(path, "api", "a.proto"), filepath.Join(path, "main.go")},
+			want:         SyncActions{Build: true, Run: true, Commands: []string{"generate"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := GetSyncActions(tt.rules, path, tt.changedFiles, tt.deletedFiles)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("GetSyncActions() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
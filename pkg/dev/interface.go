@@ -3,10 +3,21 @@ package dev
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/redhat-developer/odo/pkg/api"
 )
 
+const (
+	// DetectPortsNotify reports the ports listening in the component which are not declared as endpoints in the Devfile
+	DetectPortsNotify = "notify"
+	// DetectPortsForward forwards the ports listening in the component which are not declared as endpoints in the Devfile
+	DetectPortsForward = "forward"
+)
+
+// DetectPortsValues are the acceptable values for StartOptions.DetectPorts
+var DetectPortsValues = []string{DetectPortsNotify, DetectPortsForward}
+
 type StartOptions struct {
 	// IgnorePaths are files/directories to ignore when pushing files to the container.
 	IgnorePaths []string
@@ -27,8 +38,25 @@ type StartOptions struct {
 	CustomForwardedPorts []api.ForwardedPort
 	// CustomAddress defines a custom local address for port forwarding; default value is 127.0.0.1
 	CustomAddress string
+	// CustomReverseForwardedPorts define ports, inside the component, tunneling back to local addresses,
+	// in addition to the ones defined in the Devfile
+	CustomReverseForwardedPorts []api.ForwardedPort
 	// if WatchFiles is set, files changes will trigger a new sync to the container
 	WatchFiles bool
+	// WatchBackend is the mechanism used to detect changes in the files, either fsnotify or polling
+	WatchBackend string
+	// WatchPollingInterval is the interval between two scans of the files, when polling
+	WatchPollingInterval time.Duration
+	// SyncDelay is the period without file changes after which the changes are synced to the container.
+	// If zero, the changes are synced as soon as they are received.
+	SyncDelay time.Duration
+	// if AutoRestart is set, the run (or debug) command is regularly checked, and restarted when it has stopped
+	AutoRestart bool
+	// MaxRestarts is the maximum number of consecutive restarts of the run (or debug) command, when AutoRestart is set
+	MaxRestarts int
+	// DetectPorts, if set, indicates to regularly scan the ports listening in the component, and to either report (DetectPortsNotify)
+	// or forward (DetectPortsForward) the ones which are not declared as endpoints in the Devfile
+	DetectPorts string
 	// IgnoreLocalhost indicates whether to proceed with port-forwarding regardless of any container ports being bound to the container loopback interface.
 	// Applicable to Podman only.
 	IgnoreLocalhost bool
@@ -39,11 +67,35 @@ type StartOptions struct {
 	Variables map[string]string
 	// PushWatcher is a channel that will emit an event when Pushing files to the component is requested
 	PushWatcher <-chan struct{}
+	// EventsNotifier, if not nil, is notified of the events happening during the Dev session
+	EventsNotifier EventsNotifier
+	// ApplicationLogs, if not nil, receives the lines of the logs of the containers of the component,
+	// matched against the log patterns of the run (or debug) command to follow the status of the application
+	ApplicationLogs <-chan api.ContainerLogLine
+	// CommandsRecorder, if not nil, records the output of the Devfile commands executed in the containers
+	CommandsRecorder CommandsRecorder
 
 	Out    io.Writer
 	ErrOut io.Writer
 }
 
+// EventsNotifier is notified of the events happening during a Dev session
+type EventsNotifier interface {
+	// NotifyStatusChanged is called when the state of the component changes
+	NotifyStatusChanged(state string)
+	// NotifyFilesSynced is called when files have been synced into the component
+	NotifyFilesSynced(changedFiles, deletedFiles []string)
+	// NotifyRunCommandHealthChanged is called when the run (or debug) command stops, or runs again
+	NotifyRunCommandHealthChanged(healthy bool, reason string)
+}
+
+// CommandsRecorder records the output of the Devfile commands executed during a Dev session
+type CommandsRecorder interface {
+	// RecordCommandOutput records the lines output by the command commandID in the container containerName,
+	// and err if the command failed
+	RecordCommandOutput(commandID string, containerName string, lines []string, err error)
+}
+
 type Client interface {
 	// Start the resources defined in context's Devfile on the platform. It then pushes the files in path to the container.
 	// It then watches for any changes to the files under path.
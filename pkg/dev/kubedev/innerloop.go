@@ -17,6 +17,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/log"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
 	"github.com/redhat-developer/odo/pkg/port"
+	"github.com/redhat-developer/odo/pkg/portForward"
 	"github.com/redhat-developer/odo/pkg/sync"
 	"github.com/redhat-developer/odo/pkg/watch"
 
@@ -57,6 +58,7 @@ func (o *DevClient) innerloop(ctx context.Context, parameters common.PushParamet
 			component.HandlerOptions{
 				PodName:           pod.Name,
 				ContainersRunning: component.GetContainersNames(pod),
+				OutputRecorder:    parameters.StartOptions.CommandsRecorder,
 				Msg:               "Executing post-start command in container",
 			},
 		)
@@ -88,7 +90,13 @@ func (o *DevClient) innerloop(ctx context.Context, parameters common.PushParamet
 		var running bool
 		var isComposite bool
 		var runHandler libdevfile.Handler
+		var syncRules []common.SyncRule
 		if hasRunOrDebugCmd {
+			syncRules, err = common.GetSyncRulesFromAttributes(cmd)
+			if err != nil {
+				return err
+			}
+
 			var commandType devfilev1.CommandType
 			commandType, err = parsercommon.GetCommandType(cmd)
 			if err != nil {
@@ -105,6 +113,7 @@ func (o *DevClient) innerloop(ctx context.Context, parameters common.PushParamet
 				component.HandlerOptions{
 					PodName:           pod.GetName(),
 					ContainersRunning: component.GetContainersNames(pod),
+					OutputRecorder:    parameters.StartOptions.CommandsRecorder,
 					Devfile:           parameters.Devfile,
 					Path:              path,
 				},
@@ -131,7 +140,22 @@ func (o *DevClient) innerloop(ctx context.Context, parameters common.PushParamet
 		klog.V(4).Infof("running=%v, execRequired=%v",
 			running, execRequired)
 
-		if isComposite || !running || execRequired {
+		// The actions depending on the changed files apply only once the application has been started
+		syncActions := common.DefaultSyncActions
+		if running || isComposite {
+			syncActions = common.GetSyncActions(syncRules, path, parameters.WatchFiles, parameters.WatchDeletedFiles)
+		}
+
+		if execRequired {
+			for _, commandName := range syncActions.Commands {
+				if err = o.Run(ctx, commandName); err != nil {
+					componentStatus.SetState(watch.StateReady)
+					return err
+				}
+			}
+		}
+
+		if (isComposite || !running || execRequired) && syncActions.Run {
 			// Invoke the build command once (before calling libdevfile.ExecuteCommandByNameAndKind), as, if cmd is a composite command,
 			// the handler we pass will be called for each command in that composite command.
 			doExecuteBuildCommand := func() error {
@@ -146,14 +170,17 @@ func (o *DevClient) innerloop(ctx context.Context, parameters common.PushParamet
 						PodName:           pod.Name,
 						ComponentExists:   running,
 						ContainersRunning: component.GetContainersNames(pod),
+						OutputRecorder:    parameters.StartOptions.CommandsRecorder,
 						Msg:               "Building your application in container",
 					},
 				)
 				return libdevfile.Build(ctx, parameters.Devfile, parameters.StartOptions.BuildCommand, execHandler)
 			}
-			if err = doExecuteBuildCommand(); err != nil {
-				componentStatus.SetState(watch.StateReady)
-				return err
+			if syncActions.Build {
+				if err = doExecuteBuildCommand(); err != nil {
+					componentStatus.SetState(watch.StateReady)
+					return err
+				}
 			}
 
 			if hasRunOrDebugCmd {
@@ -162,6 +189,7 @@ func (o *DevClient) innerloop(ctx context.Context, parameters common.PushParamet
 					return err
 				}
 				componentStatus.RunExecuted = true
+				componentStatus.RunCommandStarts++
 			} else {
 				msg := fmt.Sprintf("Missing default %v command", cmdKind)
 				if cmdName != "" {
@@ -194,6 +222,20 @@ func (o *DevClient) innerloop(ctx context.Context, parameters common.PushParamet
 	}
 	componentStatus.EndpointsForwarded = o.portForwardClient.GetForwardedPorts()
 
+	reversePorts, err := portForward.GetReverseForwardedPorts(parameters.Devfile, parameters.StartOptions.CustomReverseForwardedPorts)
+	if err != nil {
+		return err
+	}
+	err = o.portForwardClient.StartReversePortForwarding(ctx, componentName, reversePorts, parameters.StartOptions.ErrOut)
+	if err != nil {
+		return common.NewErrPortForward(err)
+	}
+
+	err = o.saveSession(ctx, parameters, componentStatus, pod.GetName())
+	if err != nil {
+		return err
+	}
+
 	componentStatus.SetState(watch.StateReady)
 	return nil
 }
@@ -3,6 +3,7 @@ package kubedev
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 
 	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 
@@ -15,8 +16,11 @@ import (
 	"github.com/redhat-developer/odo/pkg/devfile/location"
 	"github.com/redhat-developer/odo/pkg/exec"
 	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/log"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
 	"github.com/redhat-developer/odo/pkg/portForward"
 	"github.com/redhat-developer/odo/pkg/preference"
+	"github.com/redhat-developer/odo/pkg/state"
 	"github.com/redhat-developer/odo/pkg/sync"
 	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
 	"github.com/redhat-developer/odo/pkg/watch"
@@ -35,6 +39,7 @@ type DevClient struct {
 	execClient            exec.Client
 	deleteClient          _delete.Client
 	configAutomountClient configAutomount.Client
+	stateClient           state.Client
 
 	// deploymentExists is true when the deployment is already created when calling createComponents
 	deploymentExists bool
@@ -57,6 +62,7 @@ func NewDevClient(
 	execClient exec.Client,
 	deleteClient _delete.Client,
 	configAutomountClient configAutomount.Client,
+	stateClient state.Client,
 ) *DevClient {
 	return &DevClient{
 		kubernetesClient:      kubernetesClient,
@@ -69,6 +75,7 @@ func NewDevClient(
 		execClient:            execClient,
 		deleteClient:          deleteClient,
 		configAutomountClient: configAutomountClient,
+		stateClient:           stateClient,
 	}
 }
 
@@ -84,12 +91,19 @@ func (o *DevClient) Start(
 		}
 	)
 
+	o.restoreSession(ctx, &componentStatus)
+
 	klog.V(4).Infoln("Creating inner-loop resources for the component")
 
 	watchParameters := watch.WatchParameters{
-		StartOptions:        options,
-		DevfileWatchHandler: o.regenerateAdapterAndPush,
-		WatchCluster:        true,
+		StartOptions:               options,
+		DevfileWatchHandler:        o.regenerateAdapterAndPush,
+		HealthCheckHandler:         o.checkRunCommandHealth,
+		RunCommandRestartHandler:   o.restartRunCommand,
+		PortsDetectionHandler:      o.detectListeningPorts,
+		DetectedPortForwardHandler: o.forwardDetectedPort,
+		ApplicationStatusHandler:   o.stateClient.SetApplicationStatus,
+		WatchCluster:               true,
 	}
 
 	return o.watchClient.WatchAndPush(ctx, watchParameters, componentStatus)
@@ -111,3 +125,61 @@ func (o *DevClient) regenerateAdapterAndPush(ctx context.Context, pushParams com
 	}
 	return nil
 }
+
+// restoreSession restores the status of the component from a previous session interrupted without cleanup,
+// if the component is still running with the same Devfile.
+// This avoids executing the postStart events again when reattaching to the component.
+func (o *DevClient) restoreSession(ctx context.Context, componentStatus *watch.ComponentStatus) {
+	var (
+		componentName = odocontext.GetComponentName(ctx)
+		devfileObj    = odocontext.GetEffectiveDevfileObj(ctx)
+		path          = filepath.Dir(odocontext.GetDevfilePath(ctx))
+	)
+
+	previous, err := o.stateClient.GetPreviousSession(ctx)
+	if err != nil {
+		klog.V(4).Infof("unable to get previous session: %v", err)
+		return
+	}
+	if previous == nil {
+		return
+	}
+
+	restorable, err := common.IsSessionRestorable(*previous.Session, *devfileObj, path)
+	if err != nil {
+		klog.V(4).Infof("unable to check if previous session can be restored: %v", err)
+		return
+	}
+	if !restorable {
+		klog.V(4).Infof("previous session of process %d cannot be restored", previous.PID)
+		return
+	}
+
+	pod, err := o.kubernetesClient.GetPodUsingComponentName(componentName)
+	if err != nil || pod.GetName() != previous.Session.PodName {
+		klog.V(4).Infof("pod %q of previous session is not running anymore", previous.Session.PodName)
+		return
+	}
+
+	componentStatus.RestoreSession(*previous.Session)
+	log.Infof("Reattaching to the component running in pod %q", pod.GetName())
+
+	// The session is now owned by the current process, the state of the previous one is not needed anymore
+	err = o.stateClient.DeletePreviousSession(ctx, previous.PID)
+	if err != nil {
+		klog.V(4).Infof("unable to remove the state of previous session of process %d: %v", previous.PID, err)
+	}
+}
+
+// saveSession persists the status of the component running in podName into the state of the session
+func (o *DevClient) saveSession(ctx context.Context, parameters common.PushParameters, componentStatus *watch.ComponentStatus, podName string) error {
+	var (
+		devfileObj = odocontext.GetEffectiveDevfileObj(ctx)
+		path       = filepath.Dir(odocontext.GetDevfilePath(ctx))
+	)
+	session, err := common.CompleteSession(componentStatus.ToSession(), *devfileObj, parameters.StartOptions, podName, path)
+	if err != nil {
+		return err
+	}
+	return o.stateClient.SetSession(ctx, session)
+}
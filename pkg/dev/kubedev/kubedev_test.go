@@ -0,0 +1,102 @@
+package kubedev
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	devfileParser "github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+	"github.com/golang/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/state"
+	odoTestingUtil "github.com/redhat-developer/odo/pkg/testingutil"
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+	"github.com/redhat-developer/odo/pkg/testingutil/system"
+	"github.com/redhat-developer/odo/pkg/watch"
+)
+
+func TestDevClient_restoreSession(t *testing.T) {
+	const previousStateFile = ".odo/devstate.2.json"
+
+	devfileData, err := data.NewDevfileData(string(data.APISchemaVersion200))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = devfileData.AddComponents([]devfilev1.Component{odoTestingUtil.GetFakeContainerComponent("runtime", 3000)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	devfileObj := devfileParser.DevfileObj{Data: devfileData}
+	devfileHash, err := libdevfile.GetDevfileHash(devfileObj)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name            string
+		previousSession state.Session
+		runningPod      string
+		wantRestored    bool
+	}{
+		{
+			name:            "session restored when the pod is still running with the same Devfile",
+			previousSession: state.Session{DevfileHash: devfileHash, PodName: "apod", PostStartEventsDone: true, RunExecuted: true},
+			runningPod:      "apod",
+			wantRestored:    true,
+		},
+		{
+			name:            "session not restored when the Devfile has changed",
+			previousSession: state.Session{DevfileHash: "another-hash", PodName: "apod", PostStartEventsDone: true, RunExecuted: true},
+			runningPod:      "apod",
+			wantRestored:    false,
+		},
+		{
+			name:            "session not restored when the pod has been replaced",
+			previousSession: state.Session{DevfileHash: devfileHash, PodName: "apod", PostStartEventsDone: true, RunExecuted: true},
+			runningPod:      "another-pod",
+			wantRestored:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			fs := filesystem.NewFakeFs()
+			stateClient := state.NewStateClient(fs, system.Fake{})
+
+			ctx := context.Background()
+			ctx = odocontext.WithComponentName(ctx, "mycomponent")
+			ctx = odocontext.WithDevfilePath(ctx, filepath.Join(t.TempDir(), "devfile.yaml"))
+			ctx = odocontext.WithEffectiveDevfileObj(ctx, &devfileObj)
+
+			// State left by the previous session of the terminated process 2
+			err = stateClient.SetSession(odocontext.WithPID(ctx, 2), tt.previousSession)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			kubeClient := kclient.NewMockClientInterface(ctrl)
+			kubeClient.EXPECT().GetPodUsingComponentName("mycomponent").Return(&corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: tt.runningPod},
+			}, nil).AnyTimes()
+
+			o := NewDevClient(kubeClient, nil, nil, nil, nil, nil, fs, nil, nil, nil, stateClient)
+			componentStatus := watch.ComponentStatus{}
+			o.restoreSession(odocontext.WithPID(ctx, 1), &componentStatus)
+
+			if componentStatus.PostStartEventsDone != tt.wantRestored || componentStatus.RunExecuted != tt.wantRestored {
+				t.Errorf("component status restored = %v, want %v", componentStatus.PostStartEventsDone, tt.wantRestored)
+			}
+			_, err = fs.Stat(previousStateFile)
+			if exists := err == nil; exists == tt.wantRestored {
+				t.Errorf("state file of the previous session exists = %v, want %v", exists, !tt.wantRestored)
+			}
+		})
+	}
+}
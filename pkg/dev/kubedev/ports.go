@@ -0,0 +1,21 @@
+package kubedev
+
+import (
+	"context"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/dev"
+	"github.com/redhat-developer/odo/pkg/dev/common"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+)
+
+// detectListeningPorts returns the ports listening in the component's pod which are neither declared as endpoints nor forwarded
+func (o *DevClient) detectListeningPorts(ctx context.Context, options dev.StartOptions) ([]api.ForwardedPort, error) {
+	return common.DetectListeningPorts(ctx, options, o.kubernetesClient, o.execClient, o.stateClient, false)
+}
+
+// forwardDetectedPort forwards a port detected in the component's pod, on the custom address if defined
+func (o *DevClient) forwardDetectedPort(ctx context.Context, options dev.StartOptions, port api.ForwardedPort) (api.ForwardedPort, error) {
+	port.LocalAddress = options.CustomAddress
+	return o.portForwardClient.AddPortForward(ctx, odocontext.GetComponentName(ctx), port, options.ErrOut)
+}
@@ -3,6 +3,7 @@ package kubedev
 import (
 	"context"
 
+	"github.com/redhat-developer/odo/pkg/dev"
 	"github.com/redhat-developer/odo/pkg/dev/common"
 	"k8s.io/klog"
 )
@@ -21,3 +22,14 @@ func (o *DevClient) Run(
 		o.filesystem,
 	)
 }
+
+// checkRunCommandHealth checks that the run (or debug) command is still running in the component's pod
+func (o *DevClient) checkRunCommandHealth(ctx context.Context, options dev.StartOptions) (bool, string, error) {
+	return common.CheckRunCommandHealth(ctx, options, o.kubernetesClient, o.execClient, o.stateClient)
+}
+
+// restartRunCommand executes again the run (or debug) command in the component's pod
+func (o *DevClient) restartRunCommand(ctx context.Context, options dev.StartOptions) error {
+	klog.V(4).Infof("restarting run command on cluster")
+	return common.RestartRunCommand(ctx, options, o.kubernetesClient, o.execClient, o.configAutomountClient, o.filesystem)
+}
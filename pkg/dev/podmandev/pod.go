@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/rand" // #nosec
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
@@ -18,6 +19,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/labels"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
 	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	fcontext "github.com/redhat-developer/odo/pkg/odo/commonflags/context"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
 	"github.com/redhat-developer/odo/pkg/storage"
 	"github.com/redhat-developer/odo/pkg/util"
@@ -39,6 +41,7 @@ func (o *DevClient) createPodFromComponent(
 	runCommand string,
 	debugCommand string,
 	withHelperContainer bool,
+	withReverseHelperContainer bool,
 	randomPorts bool,
 	customForwardedPorts []api.ForwardedPort,
 	usedPorts []int,
@@ -77,6 +80,11 @@ func (o *DevClient) createPodFromComponent(
 	if err != nil {
 		return nil, nil, err
 	}
+	// The same pod definition is used to run the component on Docker
+	platform := fcontext.GetPlatform(ctx, commonflags.PlatformPodman)
+	for i := range fwPorts {
+		fwPorts[i].Platform = platform
+	}
 
 	utils.AddOdoProjectVolume(containers)
 	utils.AddOdoMandatoryVolume(containers)
@@ -126,6 +134,10 @@ func (o *DevClient) createPodFromComponent(
 	}
 
 	containers = addHostPorts(withHelperContainer, containers, fwPorts, customAddress)
+	if withReverseHelperContainer && !withHelperContainer {
+		// Reverse port-forwarding is done from the helper container, sharing the network of the pod
+		containers = append(containers, newPortForwardingHelperContainer())
+	}
 
 	pod := corev1.Pod{
 		Spec: corev1.PodSpec{
@@ -166,12 +178,7 @@ func addHostPorts(withHelperContainer bool, containers []corev1.Container, fwPor
 			containers[i].Ports = nil
 		}
 		// Add helper container for port-forwarding
-		pfHelperContainer := corev1.Container{
-			Name:    portForwardingHelperContainerName,
-			Image:   portForwardingHelperImage,
-			Command: []string{"tail"},
-			Args:    []string{"-f", "/dev/null"},
-		}
+		pfHelperContainer := newPortForwardingHelperContainer()
 		for _, fwPort := range fwPorts {
 			pfHelperContainer.Ports = append(pfHelperContainer.Ports, corev1.ContainerPort{
 				// It is intentional here to use the same port as ContainerPort and HostPort, for simplicity.
@@ -181,6 +188,7 @@ func addHostPorts(withHelperContainer bool, containers []corev1.Container, fwPor
 				ContainerPort: int32(fwPort.LocalPort),
 				HostPort:      int32(fwPort.LocalPort),
 				HostIP:        customAddress,
+				Protocol:      getContainerPortProtocol(fwPort.Protocol),
 			})
 		}
 		containers = append(containers, pfHelperContainer)
@@ -191,7 +199,8 @@ func addHostPorts(withHelperContainer bool, containers []corev1.Container, fwPor
 			var containerPorts []corev1.ContainerPort
 			for _, p := range containers[i].Ports {
 				for _, fwPort := range fwPorts {
-					if containers[i].Name == fwPort.ContainerName && int(p.ContainerPort) == fwPort.ContainerPort {
+					if containers[i].Name == fwPort.ContainerName && int(p.ContainerPort) == fwPort.ContainerPort &&
+						p.Protocol == getContainerPortProtocol(fwPort.Protocol) {
 						p.HostPort = int32(fwPort.LocalPort)
 						p.HostIP = customAddress
 						containerPorts = append(containerPorts, p)
@@ -205,6 +214,24 @@ func addHostPorts(withHelperContainer bool, containers []corev1.Container, fwPor
 	return containers
 }
 
+// getContainerPortProtocol returns the protocol of the port mapping of an endpoint, given the protocol of the endpoint
+func getContainerPortProtocol(protocol string) corev1.Protocol {
+	if strings.EqualFold(protocol, string(corev1.ProtocolUDP)) {
+		return corev1.ProtocolUDP
+	}
+	return corev1.ProtocolTCP
+}
+
+// newPortForwardingHelperContainer returns the side container running the socat processes for port-forwarding
+func newPortForwardingHelperContainer() corev1.Container {
+	return corev1.Container{
+		Name:    portForwardingHelperContainerName,
+		Image:   portForwardingHelperImage,
+		Command: []string{"tail"},
+		Args:    []string{"-f", "/dev/null"},
+	}
+}
+
 func getVolumeName(volume string, componentName string, appName string) string {
 	return volume + "-" + componentName + "-" + appName
 }
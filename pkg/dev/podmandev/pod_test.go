@@ -141,6 +141,7 @@ func Test_createPodFromComponent(t *testing.T) {
 		runCommand           string
 		debugCommand         string
 		forwardLocalhost     bool
+		reverseHelper        bool
 		customForwardedPorts []api.ForwardedPort
 		customAddress        string
 	}
@@ -190,6 +191,25 @@ func Test_createPodFromComponent(t *testing.T) {
 				return pod
 			},
 		},
+		{
+			name: "basic component without command / reverse port forwarding",
+			args: args{
+				devfileObj: func() parser.DevfileObj {
+					data, _ := data.NewDevfileData(string(data.APISchemaVersion200))
+					_ = data.AddCommands([]v1alpha2.Command{command})
+					_ = data.AddComponents([]v1alpha2.Component{baseComponent})
+					return parser.DevfileObj{
+						Data: data,
+					}
+				},
+				componentName: devfileName,
+				appName:       appName,
+				reverseHelper: true,
+			},
+			wantPod: func(basePod *corev1.Pod) *corev1.Pod {
+				return basePod.DeepCopy()
+			},
+		},
 		{
 			name: "basic component with command / forwardLocalhost=false",
 			args: args{
@@ -386,6 +406,7 @@ func Test_createPodFromComponent(t *testing.T) {
 					Name:          "http",
 					ContainerPort: 20001,
 					HostPort:      20001,
+					Protocol:      corev1.ProtocolTCP,
 					HostIP:        "127.0.0.1",
 				})
 				return pod
@@ -478,6 +499,7 @@ func Test_createPodFromComponent(t *testing.T) {
 					Name:          "http",
 					ContainerPort: 20001,
 					HostPort:      20001,
+					Protocol:      corev1.ProtocolTCP,
 					HostIP:        "127.0.0.1",
 				})
 				return pod
@@ -588,12 +610,14 @@ func Test_createPodFromComponent(t *testing.T) {
 					Name:          "http",
 					ContainerPort: 20001,
 					HostPort:      20001,
+					Protocol:      corev1.ProtocolTCP,
 					HostIP:        "127.0.0.1",
 				})
 				pod.Spec.Containers[1].Ports = append(pod.Spec.Containers[1].Ports, corev1.ContainerPort{
 					Name:          "debug",
 					ContainerPort: 20002,
 					HostPort:      20002,
+					Protocol:      corev1.ProtocolTCP,
 					HostIP:        "127.0.0.1",
 				})
 				return pod
@@ -814,18 +838,21 @@ func Test_createPodFromComponent(t *testing.T) {
 					Name:          "http",
 					ContainerPort: 20003,
 					HostPort:      20003,
+					Protocol:      corev1.ProtocolTCP,
 					HostIP:        "127.0.0.1",
 				})
 				pod.Spec.Containers[1].Ports = append(pod.Spec.Containers[1].Ports, corev1.ContainerPort{
 					Name:          "debug",
 					ContainerPort: 20004,
 					HostPort:      20004,
+					Protocol:      corev1.ProtocolTCP,
 					HostIP:        "127.0.0.1",
 				})
 				pod.Spec.Containers[1].Ports = append(pod.Spec.Containers[1].Ports, corev1.ContainerPort{
 					Name:          "debug-1",
 					ContainerPort: 20005,
 					HostPort:      20005,
+					Protocol:      corev1.ProtocolTCP,
 					HostIP:        "127.0.0.1",
 				})
 				return pod
@@ -970,12 +997,14 @@ func Test_createPodFromComponent(t *testing.T) {
 					Name:          "http",
 					ContainerPort: 20002,
 					HostPort:      20002,
+					Protocol:      corev1.ProtocolTCP,
 					HostIP:        "127.0.0.1",
 				})
 				pod.Spec.Containers[1].Ports = append(pod.Spec.Containers[1].Ports, corev1.ContainerPort{
 					Name:          "http-1",
 					ContainerPort: 20003,
 					HostPort:      20003,
+					Protocol:      corev1.ProtocolTCP,
 					HostIP:        "127.0.0.1",
 				})
 				return pod
@@ -1458,6 +1487,7 @@ func Test_createPodFromComponent(t *testing.T) {
 				tt.args.runCommand,
 				tt.args.debugCommand,
 				tt.args.forwardLocalhost,
+				tt.args.reverseHelper,
 				false,
 				tt.args.customForwardedPorts,
 				[]int{20001, 20002, 20003, 20004, 20005},
@@ -1469,7 +1499,7 @@ func Test_createPodFromComponent(t *testing.T) {
 				return
 			}
 
-			basePod := buildBasePod(tt.args.forwardLocalhost)
+			basePod := buildBasePod(tt.args.forwardLocalhost || tt.args.reverseHelper)
 			if diff := cmp.Diff(tt.wantPod(basePod), got, cmpopts.EquateEmpty()); diff != "" {
 				t.Errorf("createPodFromComponent() pod mismatch (-want +got):\n%s", diff)
 			}
@@ -1480,3 +1510,68 @@ func Test_createPodFromComponent(t *testing.T) {
 		})
 	}
 }
+
+func Test_addHostPorts(t *testing.T) {
+	buildContainers := func() []corev1.Container {
+		return []corev1.Container{
+			{
+				Name: "runtime",
+				Ports: []corev1.ContainerPort{
+					{Name: "dns-tcp", ContainerPort: 53, Protocol: corev1.ProtocolTCP},
+					{Name: "dns", ContainerPort: 53, Protocol: corev1.ProtocolUDP},
+					{Name: "http", ContainerPort: 8080, Protocol: corev1.ProtocolTCP},
+				},
+			},
+		}
+	}
+	fwPorts := []api.ForwardedPort{
+		{ContainerName: "runtime", PortName: "dns-tcp", LocalPort: 20001, ContainerPort: 53, Protocol: "tcp"},
+		{ContainerName: "runtime", PortName: "dns", LocalPort: 20002, ContainerPort: 53, Protocol: "udp"},
+	}
+
+	tests := []struct {
+		name                string
+		withHelperContainer bool
+		want                []corev1.Container
+	}{
+		{
+			name: "host ports on the containers",
+			want: []corev1.Container{
+				{
+					Name: "runtime",
+					Ports: []corev1.ContainerPort{
+						{Name: "dns-tcp", ContainerPort: 53, HostPort: 20001, HostIP: "127.0.0.1", Protocol: corev1.ProtocolTCP},
+						{Name: "dns", ContainerPort: 53, HostPort: 20002, HostIP: "127.0.0.1", Protocol: corev1.ProtocolUDP},
+					},
+				},
+			},
+		},
+		{
+			name:                "host ports on the helper container",
+			withHelperContainer: true,
+			want: []corev1.Container{
+				{
+					Name: "runtime",
+				},
+				{
+					Name:    portForwardingHelperContainerName,
+					Image:   portForwardingHelperImage,
+					Command: []string{"tail"},
+					Args:    []string{"-f", "/dev/null"},
+					Ports: []corev1.ContainerPort{
+						{Name: "dns-tcp", ContainerPort: 20001, HostPort: 20001, HostIP: "127.0.0.1", Protocol: corev1.ProtocolTCP},
+						{Name: "dns", ContainerPort: 20002, HostPort: 20002, HostIP: "127.0.0.1", Protocol: corev1.ProtocolUDP},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := addHostPorts(tt.withHelperContainer, buildContainers(), fwPorts, "")
+			if diff := cmp.Diff(tt.want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("addHostPorts() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package podmandev
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"strings"
 
 	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
@@ -40,6 +41,11 @@ type DevClient struct {
 
 	deployedPod *corev1.Pod
 	usedPorts   []int
+
+	// reattachPodName is the name of the pod still running from a previous session, which can be reused
+	reattachPodName string
+	// reattachSync is true when the next sync can be done incrementally, as the files have been synced by a previous session
+	reattachSync bool
 }
 
 var _ dev.Client = (*DevClient)(nil)
@@ -78,12 +84,19 @@ func (o *DevClient) Start(
 		}
 	)
 
+	o.restoreSession(ctx, &options, &componentStatus)
+
 	klog.V(4).Infoln("Creating inner-loop resources for the component")
 
 	watchParameters := watch.WatchParameters{
-		StartOptions:        options,
-		DevfileWatchHandler: o.watchHandler,
-		WatchCluster:        false,
+		StartOptions:               options,
+		DevfileWatchHandler:        o.watchHandler,
+		HealthCheckHandler:         o.checkRunCommandHealth,
+		RunCommandRestartHandler:   o.restartRunCommand,
+		PortsDetectionHandler:      o.detectListeningPorts,
+		DetectedPortForwardHandler: o.forwardDetectedPort,
+		ApplicationStatusHandler:   o.stateClient.SetApplicationStatus,
+		WatchCluster:               false,
 	}
 
 	return o.watchClient.WatchAndPush(ctx, watchParameters, componentStatus)
@@ -142,13 +155,14 @@ func (o *DevClient) syncFiles(ctx context.Context, options dev.StartOptions, pod
 		DevfileScanIndexForWatch: true,
 
 		CompInfo:  compInfo,
-		ForcePush: true,
+		ForcePush: !o.reattachSync,
 		Files:     syncFilesMap,
 	}
 	execRequired, err := o.syncClient.SyncFiles(ctx, syncParams)
 	if err != nil {
 		return false, err
 	}
+	o.reattachSync = false
 	s.End(true)
 	return execRequired, nil
 }
@@ -182,3 +196,70 @@ func (o *DevClient) watchHandler(ctx context.Context, pushParams common.PushPara
 
 	return o.reconcile(ctx, pushParams, componentStatus)
 }
+
+// restoreSession restores the status of the component from a previous session interrupted without cleanup,
+// if the pod of the component is still running with the same Devfile.
+// This avoids recreating the pod, executing the postStart events and syncing all the files again when reattaching to the component.
+func (o *DevClient) restoreSession(ctx context.Context, options *dev.StartOptions, componentStatus *watch.ComponentStatus) {
+	var (
+		devfileObj = odocontext.GetEffectiveDevfileObj(ctx)
+		path       = filepath.Dir(odocontext.GetDevfilePath(ctx))
+	)
+
+	previous, err := o.stateClient.GetPreviousSession(ctx)
+	if err != nil {
+		klog.V(4).Infof("unable to get previous session: %v", err)
+		return
+	}
+	if previous == nil {
+		return
+	}
+
+	restorable, err := common.IsSessionRestorable(*previous.Session, *devfileObj, path)
+	if err != nil {
+		klog.V(4).Infof("unable to check if previous session can be restored: %v", err)
+		return
+	}
+	if !restorable {
+		klog.V(4).Infof("previous session of process %d cannot be restored", previous.PID)
+		return
+	}
+
+	pods, err := o.podmanClient.PodLs()
+	if err != nil || !pods[previous.Session.PodName] {
+		klog.V(4).Infof("pod %q of previous session is not running anymore", previous.Session.PodName)
+		return
+	}
+
+	if len(options.CustomForwardedPorts) == 0 {
+		// Reuse the ports of the running pod, so the pod spec does not change
+		for _, fwPort := range previous.ForwardedPorts {
+			if !fwPort.IsReverse {
+				options.CustomForwardedPorts = append(options.CustomForwardedPorts, fwPort)
+			}
+		}
+	}
+	o.reattachPodName = previous.Session.PodName
+	o.reattachSync = true
+	componentStatus.RestoreSession(*previous.Session)
+	log.Infof("Reattaching to the component running in pod %q", previous.Session.PodName)
+
+	// The session is now owned by the current process, the state of the previous one is not needed anymore
+	err = o.stateClient.DeletePreviousSession(ctx, previous.PID)
+	if err != nil {
+		klog.V(4).Infof("unable to remove the state of previous session of process %d: %v", previous.PID, err)
+	}
+}
+
+// saveSession persists the status of the component running in podName into the state of the session
+func (o *DevClient) saveSession(ctx context.Context, options dev.StartOptions, componentStatus *watch.ComponentStatus, podName string) error {
+	var (
+		devfileObj = odocontext.GetEffectiveDevfileObj(ctx)
+		path       = filepath.Dir(odocontext.GetDevfilePath(ctx))
+	)
+	session, err := common.CompleteSession(componentStatus.ToSession(), *devfileObj, options, podName, path)
+	if err != nil {
+		return err
+	}
+	return o.stateClient.SetSession(ctx, session)
+}
@@ -0,0 +1,22 @@
+package podmandev
+
+import (
+	"context"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/dev"
+	"github.com/redhat-developer/odo/pkg/dev/common"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+)
+
+// detectListeningPorts returns the ports listening in the component's pod which are neither declared as endpoints nor forwarded
+func (o *DevClient) detectListeningPorts(ctx context.Context, options dev.StartOptions) ([]api.ForwardedPort, error) {
+	// The helper container listens on the local ports, in the network of the pod
+	return common.DetectListeningPorts(ctx, options, o.podmanClient, o.execClient, o.stateClient, true)
+}
+
+// forwardDetectedPort forwards a port detected in the component's pod, on the custom address if defined
+func (o *DevClient) forwardDetectedPort(ctx context.Context, options dev.StartOptions, port api.ForwardedPort) (api.ForwardedPort, error) {
+	port.LocalAddress = options.CustomAddress
+	return o.portForwardClient.AddPortForward(ctx, odocontext.GetComponentName(ctx), port, options.ErrOut)
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/log"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
 	"github.com/redhat-developer/odo/pkg/port"
+	"github.com/redhat-developer/odo/pkg/portForward"
 	"github.com/redhat-developer/odo/pkg/watch"
 
 	corev1 "k8s.io/api/core/v1"
@@ -49,7 +50,12 @@ func (o *DevClient) reconcile(
 		return err
 	}
 
-	pod, fwPorts, err := o.deployPod(ctx, options, devfileObj)
+	reversePorts, err := portForward.GetReverseForwardedPorts(devfileObj, options.CustomReverseForwardedPorts)
+	if err != nil {
+		return err
+	}
+
+	pod, fwPorts, err := o.deployPod(ctx, options, devfileObj, len(reversePorts) != 0)
 	if err != nil {
 		return err
 	}
@@ -74,6 +80,7 @@ func (o *DevClient) reconcile(
 			component.HandlerOptions{
 				PodName:           pod.Name,
 				ContainersRunning: component.GetContainersNames(pod),
+				OutputRecorder:    parameters.StartOptions.CommandsRecorder,
 				Msg:               "Executing post-start command in container",
 			},
 		)
@@ -88,6 +95,35 @@ func (o *DevClient) reconcile(
 	var hasRunOrDebugCmd bool
 	if innerLoopWithCommands {
 		if execRequired {
+			cmdKind := devfilev1.RunCommandGroupKind
+			cmdName := options.RunCommand
+			if options.Debug {
+				cmdKind = devfilev1.DebugCommandGroupKind
+				cmdName = options.DebugCommand
+			}
+			var cmd devfilev1.Command
+			cmd, hasRunOrDebugCmd, err = libdevfile.GetCommand(parameters.Devfile, cmdName, cmdKind)
+			if err != nil {
+				return err
+			}
+
+			// The actions depending on the changed files apply only once the application has been started
+			syncActions := common.DefaultSyncActions
+			if hasRunOrDebugCmd && componentStatus.RunExecuted {
+				var syncRules []common.SyncRule
+				syncRules, err = common.GetSyncRulesFromAttributes(cmd)
+				if err != nil {
+					return err
+				}
+				syncActions = common.GetSyncActions(syncRules, path, parameters.WatchFiles, parameters.WatchDeletedFiles)
+			}
+
+			for _, commandName := range syncActions.Commands {
+				if err = o.Run(ctx, commandName); err != nil {
+					return err
+				}
+			}
+
 			doExecuteBuildCommand := func() error {
 				execHandler := component.NewRunHandler(
 					ctx,
@@ -100,29 +136,21 @@ func (o *DevClient) reconcile(
 						PodName:           pod.Name,
 						ComponentExists:   componentStatus.RunExecuted,
 						ContainersRunning: component.GetContainersNames(pod),
+						OutputRecorder:    parameters.StartOptions.CommandsRecorder,
 						Msg:               "Building your application in container",
 					},
 				)
 				return libdevfile.Build(ctx, devfileObj, options.BuildCommand, execHandler)
 			}
 
-			err = doExecuteBuildCommand()
-			if err != nil {
-				return err
-			}
-
-			cmdKind := devfilev1.RunCommandGroupKind
-			cmdName := options.RunCommand
-			if options.Debug {
-				cmdKind = devfilev1.DebugCommandGroupKind
-				cmdName = options.DebugCommand
-			}
-			_, hasRunOrDebugCmd, err = libdevfile.GetCommand(parameters.Devfile, cmdName, cmdKind)
-			if err != nil {
-				return err
+			if syncActions.Build {
+				err = doExecuteBuildCommand()
+				if err != nil {
+					return err
+				}
 			}
 
-			if hasRunOrDebugCmd {
+			if hasRunOrDebugCmd && syncActions.Run {
 				cmdHandler := component.NewRunHandler(
 					ctx,
 					o.podmanClient,
@@ -137,6 +165,7 @@ func (o *DevClient) reconcile(
 						PodName:           pod.Name,
 						ComponentExists:   componentStatus.RunExecuted,
 						ContainersRunning: component.GetContainersNames(pod),
+						OutputRecorder:    parameters.StartOptions.CommandsRecorder,
 					},
 				)
 				err = libdevfile.ExecuteCommandByNameAndKind(ctx, devfileObj, cmdName, cmdKind, cmdHandler, false)
@@ -144,7 +173,8 @@ func (o *DevClient) reconcile(
 					return err
 				}
 				componentStatus.RunExecuted = true
-			} else {
+				componentStatus.RunCommandStarts++
+			} else if !hasRunOrDebugCmd {
 				msg := fmt.Sprintf("Missing default %v command", cmdKind)
 				if cmdName != "" {
 					msg = fmt.Sprintf("Missing %v command with name %q", cmdKind, cmdName)
@@ -182,11 +212,26 @@ func (o *DevClient) reconcile(
 		}
 	} // else port-forwarding is done via the main container ports in the pod spec
 
+	// Reverse port-forwarding is done by executing dedicated socat commands in the helper container
+	err = o.portForwardClient.StartReversePortForwarding(ctx, componentName, reversePorts, options.ErrOut)
+	if err != nil {
+		return common.NewErrPortForward(err)
+	}
+
 	for _, fwPort := range fwPorts {
 		s := fmt.Sprintf("Forwarding from %s:%d -> %d", fwPort.LocalAddress, fwPort.LocalPort, fwPort.ContainerPort)
 		fmt.Fprintf(options.Out, " -  %s", log.SboldColor(color.FgGreen, s))
 	}
-	err = o.stateClient.SetForwardedPorts(ctx, fwPorts)
+	for _, fwPort := range reversePorts {
+		s := fmt.Sprintf("Reverse forwarding from container port %d -> %s:%d", fwPort.ContainerPort, fwPort.LocalAddress, fwPort.LocalPort)
+		fmt.Fprintf(options.Out, " -  %s", log.SboldColor(color.FgGreen, s))
+	}
+	err = o.stateClient.SetForwardedPorts(ctx, append(fwPorts, reversePorts...))
+	if err != nil {
+		return err
+	}
+
+	err = o.saveSession(ctx, options, componentStatus, pod.GetName())
 	if err != nil {
 		return err
 	}
@@ -227,8 +272,9 @@ func (o *DevClient) buildPushAutoImageComponents(ctx context.Context, devfileObj
 	return nil
 }
 
-// deployPod deploys the component as a Pod in podman
-func (o *DevClient) deployPod(ctx context.Context, options dev.StartOptions, devfileObj parser.DevfileObj) (*corev1.Pod, []api.ForwardedPort, error) {
+// deployPod deploys the component as a Pod in podman.
+// If withReverseHelperContainer is true, the helper container is added to the pod for reverse port forwarding.
+func (o *DevClient) deployPod(ctx context.Context, options dev.StartOptions, devfileObj parser.DevfileObj, withReverseHelperContainer bool) (*corev1.Pod, []api.ForwardedPort, error) {
 
 	spinner := log.Spinner("Deploying pod")
 	defer spinner.End(false)
@@ -240,6 +286,7 @@ func (o *DevClient) deployPod(ctx context.Context, options dev.StartOptions, dev
 		options.RunCommand,
 		options.DebugCommand,
 		options.ForwardLocalhost,
+		withReverseHelperContainer,
 		options.RandomPorts,
 		options.CustomForwardedPorts,
 		o.usedPorts,
@@ -257,6 +304,13 @@ func (o *DevClient) deployPod(ctx context.Context, options dev.StartOptions, dev
 		return o.deployedPod, fwPorts, nil
 	}
 
+	if o.deployedPod == nil && o.reattachPodName == pod.GetName() {
+		klog.V(4).Infof("reusing pod %q from previous session", pod.GetName())
+		o.reattachPodName = ""
+		spinner.End(true)
+		return pod, fwPorts, nil
+	}
+
 	// Delete previous pod, if running
 	if o.deployedPod != nil {
 		err = o.podmanClient.CleanupPodResources(o.deployedPod, false)
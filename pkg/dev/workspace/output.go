@@ -0,0 +1,73 @@
+package workspace
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"sync"
+)
+
+// prefixWriter writes to out each complete line written to it, prefixed with prefix.
+// The writers sharing the same mutex do not interleave their lines.
+type prefixWriter struct {
+	out    io.Writer
+	mu     *sync.Mutex
+	prefix []byte
+	buffer []byte
+}
+
+var _ io.Writer = (*prefixWriter)(nil)
+
+func newPrefixWriter(out io.Writer, mu *sync.Mutex, prefix string) *prefixWriter {
+	return &prefixWriter{
+		out:    out,
+		mu:     mu,
+		prefix: []byte(prefix),
+	}
+}
+
+func (o *prefixWriter) Write(p []byte) (int, error) {
+	o.buffer = append(o.buffer, p...)
+	for {
+		i := bytes.IndexByte(o.buffer, '\n')
+		if i == -1 {
+			return len(p), nil
+		}
+		if err := o.writeLine(o.buffer[:i+1]); err != nil {
+			return 0, err
+		}
+		o.buffer = o.buffer[i+1:]
+	}
+}
+
+// Flush writes the last line, if it does not end with a newline
+func (o *prefixWriter) Flush() error {
+	if len(o.buffer) == 0 {
+		return nil
+	}
+	line := append(o.buffer, '\n')
+	o.buffer = nil
+	return o.writeLine(line)
+}
+
+func (o *prefixWriter) writeLine(line []byte) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	_, err := o.out.Write(append(append([]byte{}, o.prefix...), line...))
+	return err
+}
+
+// getPrefixes returns the prefixes of the output of the components, aligned on the longest name
+func getPrefixes(components []Component) []string {
+	width := 0
+	for _, component := range components {
+		if len(component.Name) > width {
+			width = len(component.Name)
+		}
+	}
+	prefixes := make([]string, len(components))
+	for i, component := range components {
+		prefixes[i] = "[" + component.Name + "]" + strings.Repeat(" ", width-len(component.Name)+1)
+	}
+	return prefixes
+}
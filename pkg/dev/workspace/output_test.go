@@ -0,0 +1,45 @@
+package workspace
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_prefixWriter(t *testing.T) {
+	out := &bytes.Buffer{}
+	var mu sync.Mutex
+	prefixes := getPrefixes([]Component{{Name: "frontend"}, {Name: "api"}})
+	frontend := newPrefixWriter(out, &mu, prefixes[0])
+	api := newPrefixWriter(out, &mu, prefixes[1])
+
+	for _, write := range []struct {
+		writer *prefixWriter
+		text   string
+	}{
+		{frontend, "Starting"},
+		{api, "Starting\nSyncing files\n"},
+		{frontend, " the application\n"},
+		{api, "Running"},
+	} {
+		if _, err := write.writer.Write([]byte(write.text)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := frontend.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if err := api.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "[api]      Starting\n" +
+		"[api]      Syncing files\n" +
+		"[frontend] Starting the application\n" +
+		"[api]      Running\n"
+	if diff := cmp.Diff(want, out.String()); diff != "" {
+		t.Errorf("output mismatch (-want +got):\n%s", diff)
+	}
+}
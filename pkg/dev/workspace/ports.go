@@ -0,0 +1,106 @@
+package workspace
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+const (
+	// startPort is the first local port assigned to the endpoints, as for a single Dev session
+	startPort = 20001
+	endPort   = startPort + 10000
+	// apiServerAddress is the address on which the API Server of a Dev session listens
+	apiServerAddress = "127.0.0.1"
+)
+
+// portAllocator assigns free local ports, never assigning the same port twice
+type portAllocator struct {
+	next     int
+	assigned map[int]struct{}
+	// wrapped is true once the ports have been searched up to endPort, starting again at startPort
+	wrapped bool
+}
+
+// newPortAllocator returns an allocator assigning the ports from startPort, or from a random port if randomPorts is true
+func newPortAllocator(randomPorts bool) *portAllocator {
+	next := startPort
+	if randomPorts {
+		next += rand.New(rand.NewSource(time.Now().UnixNano())).Intn(endPort - startPort + 1) // #nosec
+	}
+	return &portAllocator{
+		next:     next,
+		assigned: make(map[int]struct{}),
+		wrapped:  next == startPort,
+	}
+}
+
+func (o *portAllocator) allocate(address string) (int, error) {
+	for {
+		port, err := 0, fmt.Errorf("no free port in range [%d-%d]", startPort, endPort)
+		if o.next <= endPort {
+			port, err = util.NextFreePort(o.next, endPort, nil, address)
+		}
+		if err != nil {
+			if o.wrapped {
+				return 0, err
+			}
+			// search the ports before the first port assigned
+			o.next, o.wrapped = startPort, true
+			continue
+		}
+		o.next = port + 1
+		if _, found := o.assigned[port]; found {
+			continue
+		}
+		o.assigned[port] = struct{}{}
+		return port, nil
+	}
+}
+
+// AllocatePorts assigns a local port to each container endpoint of each component, and a port to the API Server
+// of each component if withAPIServer is true, so that the ports do not collide between the Dev sessions.
+// The ports are assigned from a random port if randomPorts is true.
+// It returns, for each component, the arguments to pass to `odo dev`.
+func AllocatePorts(endpoints []map[string][]v1alpha2.Endpoint, address string, withAPIServer bool, randomPorts bool) ([][]string, error) {
+	if address == "" {
+		address = "127.0.0.1"
+	}
+	allocator := newPortAllocator(randomPorts)
+	result := make([][]string, len(endpoints))
+	for i, ceMapping := range endpoints {
+		// iterate over containers in an orderly manner, to get the same result every time
+		containers := make([]string, 0, len(ceMapping))
+		for container := range ceMapping {
+			containers = append(containers, container)
+		}
+		sort.Strings(containers)
+
+		for _, container := range containers {
+			for _, endpoint := range ceMapping[container] {
+				port, err := allocator.allocate(address)
+				if err != nil {
+					return nil, err
+				}
+				result[i] = append(result[i], "--port-forward", fmt.Sprintf("%d:%s:%d", port, container, endpoint.TargetPort))
+			}
+		}
+	}
+
+	if withAPIServer {
+		for i := range endpoints {
+			port, err := allocator.allocate(apiServerAddress)
+			if err != nil {
+				return nil, err
+			}
+			result[i] = append(result[i], "--api-server-port", strconv.Itoa(port))
+		}
+	}
+	return result, nil
+}
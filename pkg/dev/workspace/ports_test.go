@@ -0,0 +1,114 @@
+package workspace
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+)
+
+func TestAllocatePorts(t *testing.T) {
+	// keep the first port busy, to check that it is not assigned
+	listener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", startPort))
+	if err == nil {
+		defer listener.Close()
+	}
+
+	endpoints := []map[string][]v1alpha2.Endpoint{
+		{
+			"runtime": {{Name: "http", TargetPort: 3000}, {Name: "debug", TargetPort: 5858}},
+		},
+		{
+			"tools":   {{Name: "admin", TargetPort: 9000}},
+			"runtime": {{Name: "http", TargetPort: 3000}},
+		},
+		{},
+	}
+	got, err := AllocatePorts(endpoints, "", true, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(endpoints) {
+		t.Fatalf("expected args for %d components, got %d", len(endpoints), len(got))
+	}
+
+	wantTargets := [][]string{
+		{"runtime:3000", "runtime:5858"},
+		{"runtime:3000", "tools:9000"},
+		nil,
+	}
+	localPorts := map[string]struct{}{}
+	for i, args := range got {
+		var targets []string
+		var apiServerPorts int
+		for j := 0; j < len(args); j += 2 {
+			var localPort string
+			switch args[j] {
+			case "--port-forward":
+				parts := strings.SplitN(args[j+1], ":", 2)
+				localPort = parts[0]
+				targets = append(targets, parts[1])
+			case "--api-server-port":
+				localPort = args[j+1]
+				apiServerPorts++
+			default:
+				t.Fatalf("unexpected arg %q", args[j])
+			}
+			port, err := strconv.Atoi(localPort)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if port == startPort && listener != nil {
+				t.Errorf("busy port %d should not be assigned", port)
+			}
+			if _, found := localPorts[localPort]; found {
+				t.Errorf("port %s assigned several times", localPort)
+			}
+			localPorts[localPort] = struct{}{}
+		}
+		if strings.Join(targets, ",") != strings.Join(wantTargets[i], ",") {
+			t.Errorf("component %d: expected forwarded ports %v, got %v", i, wantTargets[i], targets)
+		}
+		if apiServerPorts != 1 {
+			t.Errorf("component %d: expected one API Server port, got %d", i, apiServerPorts)
+		}
+	}
+}
+
+func TestAllocatePorts_random(t *testing.T) {
+	endpoints := []map[string][]v1alpha2.Endpoint{
+		{"runtime": {{Name: "http", TargetPort: 3000}}},
+		{"runtime": {{Name: "http", TargetPort: 3000}}},
+	}
+	got, err := AllocatePorts(endpoints, "", true, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	localPorts := map[string]struct{}{}
+	for i, args := range got {
+		if len(args) != 4 || args[0] != "--port-forward" || args[2] != "--api-server-port" {
+			t.Fatalf("component %d: expected a forwarded port and an API Server port, got %v", i, args)
+		}
+		for _, localPort := range []string{strings.SplitN(args[1], ":", 2)[0], args[3]} {
+			if _, found := localPorts[localPort]; found {
+				t.Errorf("port %s assigned several times", localPort)
+			}
+			localPorts[localPort] = struct{}{}
+		}
+	}
+}
+
+func Test_portAllocator_wrap(t *testing.T) {
+	allocator := newPortAllocator(true)
+	allocator.next = endPort + 1
+	port, err := allocator.allocate("127.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if port < startPort || port > endPort {
+		t.Errorf("expected a port in range [%d-%d] after wrapping, got %d", startPort, endPort, port)
+	}
+}
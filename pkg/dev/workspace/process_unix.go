@@ -0,0 +1,22 @@
+//go:build aix || darwin || dragonfly || freebsd || linux || netbsd || openbsd || solaris || zos
+// +build aix darwin dragonfly freebsd linux netbsd openbsd solaris zos
+
+package workspace
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+// setProcessAttributes starts the process in its own process group, so it does not receive the signals
+// sent by the terminal to odo, which are forwarded once by interruptProcess
+func setProcessAttributes(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Setpgid: true,
+	}
+}
+
+func interruptProcess(process *os.Process) error {
+	return process.Signal(os.Interrupt)
+}
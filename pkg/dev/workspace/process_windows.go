@@ -0,0 +1,22 @@
+package workspace
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+
+	"golang.org/x/sys/windows"
+)
+
+// setProcessAttributes starts the process in its own process group, so it does not receive the Ctrl+C events
+// sent by the console to odo, which are forwarded once by interruptProcess
+func setProcessAttributes(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		CreationFlags: syscall.CREATE_NEW_PROCESS_GROUP,
+	}
+}
+
+// interruptProcess sends a Ctrl+Break event to the process group, received as an interrupt signal by odo
+func interruptProcess(process *os.Process) error {
+	return windows.GenerateConsoleCtrlEvent(windows.CTRL_BREAK_EVENT, uint32(process.Pid))
+}
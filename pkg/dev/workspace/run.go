@@ -0,0 +1,116 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sync"
+
+	"k8s.io/klog"
+)
+
+// Session is the Dev session of a component of the workspace
+type Session struct {
+	Component Component
+	// Args are the arguments of the odo command executed in the directory of the component
+	Args []string
+}
+
+type sessionResult struct {
+	index int
+	err   error
+}
+
+// Run starts concurrently an odo process for each session, in the directory of its component, and writes
+// their output to out, prefixed with the name of the component.
+// When ctx is cancelled, or when a session exits, all the sessions are interrupted, and Run returns after they have exited.
+func Run(ctx context.Context, odoPath string, sessions []Session, out io.Writer) error {
+	var (
+		mu      sync.Mutex
+		cmds    = make([]*exec.Cmd, 0, len(sessions))
+		writers = make([]*prefixWriter, 0, len(sessions))
+		results = make(chan sessionResult, len(sessions))
+	)
+
+	components := make([]Component, len(sessions))
+	for i, session := range sessions {
+		components[i] = session.Component
+	}
+	prefixes := getPrefixes(components)
+
+	var startErr error
+	for i, session := range sessions {
+		writer := newPrefixWriter(out, &mu, prefixes[i])
+		cmd := exec.Command(odoPath, session.Args...)
+		cmd.Dir = session.Component.Path
+		cmd.Stdout = writer
+		cmd.Stderr = writer
+		setProcessAttributes(cmd)
+		klog.V(2).Infof("starting Dev session of component %q in %q: %s %v", session.Component.Name, cmd.Dir, odoPath, session.Args)
+		if startErr = cmd.Start(); startErr != nil {
+			startErr = fmt.Errorf("unable to start the Dev session of component %q: %w", session.Component.Name, startErr)
+			break
+		}
+		cmds = append(cmds, cmd)
+		writers = append(writers, writer)
+		go func(index int, cmd *exec.Cmd) {
+			results <- sessionResult{index: index, err: cmd.Wait()}
+		}(i, cmd)
+	}
+
+	var exitErr error
+	if startErr == nil {
+		select {
+		case <-ctx.Done():
+			klog.V(2).Info("interrupting the Dev sessions of the workspace")
+		case result := <-results:
+			exitErr = fmt.Errorf("the Dev session of component %q exited", sessions[result.index].Component.Name)
+			if result.err != nil {
+				exitErr = fmt.Errorf("%w: %v", exitErr, result.err)
+			}
+			fmt.Fprintf(out, "%s\nStopping the Dev sessions of the other components\n", exitErr)
+			// the session has already exited
+			cmds[result.index] = nil
+		}
+	}
+
+	remaining := 0
+	for _, cmd := range cmds {
+		if cmd == nil {
+			continue
+		}
+		remaining++
+		if err := interruptProcess(cmd.Process); err != nil {
+			klog.V(4).Infof("unable to interrupt process %d: %v", cmd.Process.Pid, err)
+		}
+	}
+	for ; remaining > 0; remaining-- {
+		result := <-results
+		if result.err != nil {
+			klog.V(2).Infof("Dev session of component %q exited: %v", sessions[result.index].Component.Name, result.err)
+		}
+	}
+
+	for _, writer := range writers {
+		if err := writer.Flush(); err != nil {
+			klog.V(4).Infof("unable to write output: %v", err)
+		}
+	}
+
+	if startErr != nil {
+		return startErr
+	}
+	return exitErr
+}
+
+// OdoPath returns the path of the odo binary to execute for each session
+func OdoPath() string {
+	path, err := os.Executable()
+	if err != nil {
+		klog.V(4).Infof("unable to get the path of the odo binary: %v", err)
+		return os.Args[0]
+	}
+	return path
+}
@@ -0,0 +1,53 @@
+package workspace
+
+import (
+	"bytes"
+	"context"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRun(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test sessions are shell scripts")
+	}
+	dir := t.TempDir()
+	longRunning := Session{
+		Component: Component{Name: "frontend", Path: dir},
+		Args:      []string{"-c", "trap 'echo interrupted; exit 0' INT; echo started; while true; do sleep 0.1; done"},
+	}
+	failing := Session{
+		Component: Component{Name: "api", Path: dir},
+		Args:      []string{"-c", "sleep 0.5; echo failed; exit 1"},
+	}
+
+	t.Run("a session exits", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		err := Run(context.Background(), "/bin/sh", []Session{longRunning, failing}, out)
+		if err == nil || !strings.Contains(err.Error(), `"api"`) {
+			t.Errorf("expected an error for component api, got %v", err)
+		}
+		for _, want := range []string{"[frontend] started\n", "[api]      failed\n", "[frontend] interrupted\n"} {
+			if !strings.Contains(out.String(), want) {
+				t.Errorf("output %q should contain %q", out.String(), want)
+			}
+		}
+	})
+
+	t.Run("context cancelled", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+		defer cancel()
+		err := Run(ctx, "/bin/sh", []Session{longRunning, {Component: Component{Name: "worker", Path: dir}, Args: longRunning.Args}}, out)
+		if err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+		for _, want := range []string{"[frontend] interrupted\n", "[worker]   interrupted\n"} {
+			if !strings.Contains(out.String(), want) {
+				t.Errorf("output %q should contain %q", out.String(), want)
+			}
+		}
+	})
+}
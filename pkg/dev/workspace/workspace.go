@@ -0,0 +1,85 @@
+// Package workspace runs the Dev sessions of several components, listed in a workspace file,
+// from a single `odo dev` invocation
+package workspace
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/redhat-developer/odo/pkg/devfile/location"
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+)
+
+// Workspace is the content of a workspace file
+type Workspace struct {
+	// Components are the components to develop together
+	Components []Component `yaml:"components"`
+}
+
+// Component is a component of the workspace
+type Component struct {
+	// Name prefixes the output of the Dev session of the component. It defaults to the name of the directory
+	Name string `yaml:"name,omitempty"`
+	// Path is the directory containing the Devfile of the component, relative to the directory of the workspace file
+	Path string `yaml:"path"`
+	// Args are additional arguments passed to `odo dev` for this component
+	Args []string `yaml:"args,omitempty"`
+}
+
+// ParseFile reads and validates the workspace file at path.
+// The paths of the components are returned as absolute paths.
+func ParseFile(fsys filesystem.Filesystem, path string) (Workspace, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return Workspace{}, err
+	}
+	content, err := fsys.ReadFile(absPath)
+	if err != nil {
+		return Workspace{}, fmt.Errorf("unable to read workspace file: %w", err)
+	}
+
+	var workspace Workspace
+	err = yaml.UnmarshalStrict(content, &workspace)
+	if err != nil {
+		return Workspace{}, fmt.Errorf("unable to parse workspace file %q: %w", path, err)
+	}
+	if len(workspace.Components) == 0 {
+		return Workspace{}, fmt.Errorf("workspace file %q does not define any component", path)
+	}
+
+	names := make(map[string]struct{}, len(workspace.Components))
+	dirs := make(map[string]struct{}, len(workspace.Components))
+	for i := range workspace.Components {
+		component := &workspace.Components[i]
+		if component.Path == "" {
+			return Workspace{}, fmt.Errorf("component #%d of workspace file %q does not define a path", i+1, path)
+		}
+		if !filepath.IsAbs(component.Path) {
+			component.Path = filepath.Join(filepath.Dir(absPath), component.Path)
+		}
+		component.Path = filepath.Clean(component.Path)
+		if component.Name == "" {
+			component.Name = filepath.Base(component.Path)
+		}
+
+		if _, found := names[component.Name]; found {
+			return Workspace{}, fmt.Errorf("several components of workspace file %q are named %q", path, component.Name)
+		}
+		names[component.Name] = struct{}{}
+		if _, found := dirs[component.Path]; found {
+			return Workspace{}, fmt.Errorf("several components of workspace file %q are in directory %q", path, component.Path)
+		}
+		dirs[component.Path] = struct{}{}
+
+		hasDevfile, err := location.DirectoryContainsDevfile(fsys, component.Path)
+		if err != nil {
+			return Workspace{}, err
+		}
+		if !hasDevfile {
+			return Workspace{}, fmt.Errorf("no Devfile found in directory %q of component %q", component.Path, component.Name)
+		}
+	}
+	return workspace, nil
+}
@@ -0,0 +1,94 @@
+package workspace
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+)
+
+func TestParseFile(t *testing.T) {
+	root := filepath.FromSlash("/workspace")
+	workspaceFile := filepath.Join(root, "odo-workspace.yaml")
+	tests := []struct {
+		name    string
+		content string
+		want    Workspace
+		wantErr bool
+	}{
+		{
+			name: "components with default and custom names",
+			content: `components:
+- path: frontend
+- name: api
+  path: ./backends/api
+  args: ["--run-command", "dev"]
+`,
+			want: Workspace{
+				Components: []Component{
+					{Name: "frontend", Path: filepath.Join(root, "frontend")},
+					{Name: "api", Path: filepath.Join(root, "backends", "api"), Args: []string{"--run-command", "dev"}},
+				},
+			},
+		},
+		{
+			name:    "no component",
+			content: "components: []\n",
+			wantErr: true,
+		},
+		{
+			name:    "component without path",
+			content: "components:\n- name: frontend\n",
+			wantErr: true,
+		},
+		{
+			name:    "directory without devfile",
+			content: "components:\n- path: worker\n",
+			wantErr: true,
+		},
+		{
+			name:    "duplicate names",
+			content: "components:\n- path: frontend\n- name: frontend\n  path: backends/api\n",
+			wantErr: true,
+		},
+		{
+			name:    "duplicate directories",
+			content: "components:\n- path: frontend\n- name: other\n  path: ./frontend/\n",
+			wantErr: true,
+		},
+		{
+			name:    "unknown field",
+			content: "components:\n- directory: frontend\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fsys := filesystem.NewFakeFs()
+			for _, dir := range []string{"frontend", filepath.Join("backends", "api"), "worker"} {
+				if err := fsys.MkdirAll(filepath.Join(root, dir), 0755); err != nil {
+					t.Fatal(err)
+				}
+			}
+			for _, devfile := range []string{filepath.Join("frontend", "devfile.yaml"), filepath.Join("backends", "api", ".devfile.yaml")} {
+				if err := fsys.WriteFile(filepath.Join(root, devfile), []byte("schemaVersion: 2.2.0\n"), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := fsys.WriteFile(workspaceFile, []byte(tt.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := ParseFile(fsys, workspaceFile)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseFile() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ParseFile() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
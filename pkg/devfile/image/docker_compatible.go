@@ -1,10 +1,13 @@
 package image
 
 import (
+	"bytes"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
 	"strings"
 
 	devfile "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
@@ -149,16 +152,32 @@ func getShellCommand(cmdName string, globalExtraArgs []string, buildExtraArgs []
 }
 
 // Push an image to its registry using a Docker compatible CLI
-func (o *DockerCompatibleBackend) Push(image string) error {
+func (o *DockerCompatibleBackend) Push(image string) (string, error) {
 
 	// We use a "No Spin" since we are outputting to stdout / stderr
 	pushSpinner := log.SpinnerNoSpin("Pushing image to container registry")
 	defer pushSpinner.End(false)
-	klog.V(4).Infof("Running command: %s push %s", o.name, image)
 
-	cmd := exec.Command(o.name, "push", image)
+	args := []string{"push"}
+	// podman does not display the digest of the pushed image, but can write it to a file
+	var digestFile string
+	if o.isPodman() {
+		f, err := os.CreateTemp("", "odo_*.digest")
+		if err != nil {
+			return "", err
+		}
+		digestFile = f.Name()
+		_ = f.Close()
+		defer os.Remove(digestFile)
+		args = append(args, "--digestfile", digestFile)
+	}
+	args = append(args, image)
+	klog.V(4).Infof("Running command: %s %s", o.name, strings.Join(args, " "))
 
-	cmd.Stdout = log.GetStdout()
+	cmd := exec.Command(o.name, args...)
+
+	var stdout bytes.Buffer
+	cmd.Stdout = io.MultiWriter(log.GetStdout(), &stdout)
 	cmd.Stderr = log.GetStderr()
 
 	// Set all output as italic when doing a push, then return to normal at the end
@@ -166,11 +185,37 @@ func (o *DockerCompatibleBackend) Push(image string) error {
 	defer color.Unset()
 	err := cmd.Run()
 	if err != nil {
-		return fmt.Errorf("error running %s command: %w", o.name, err)
+		return "", fmt.Errorf("error running %s command: %w", o.name, err)
 	}
 
 	pushSpinner.End(true)
-	return nil
+
+	output := stdout.Bytes()
+	if digestFile != "" {
+		output, err = os.ReadFile(digestFile)
+		if err != nil {
+			klog.V(4).Infof("unable to read the digest of the image %q: %v", image, err)
+			return "", nil
+		}
+	}
+	return parseDigest(string(output)), nil
+}
+
+// digestRegexp matches the digest of an image, in the output of the push command of docker, or in the digest file written by podman
+var digestRegexp = regexp.MustCompile(`sha256:[0-9a-f]{64}`)
+
+// parseDigest returns the last digest found in the output of the push command, or an empty string if none is found
+func parseDigest(output string) string {
+	digests := digestRegexp.FindAllString(output, -1)
+	if len(digests) == 0 {
+		return ""
+	}
+	return digests[len(digests)-1]
+}
+
+// isPodman returns true if the CLI is podman
+func (o *DockerCompatibleBackend) isPodman() bool {
+	return strings.Contains(filepath.Base(o.name), "podman")
 }
 
 // String return the name of the docker compatible CLI used
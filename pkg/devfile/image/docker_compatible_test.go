@@ -253,3 +253,37 @@ func Test_resolveAndDownloadDockerfile(t *testing.T) {
 		})
 	}
 }
+
+func Test_parseDigest(t *testing.T) {
+	const digest = "sha256:5d0da3dc976460b72c77d94c8a1ad043720b0416bfc16c52c45d4847e53fadb6"
+	for _, tt := range []struct {
+		name   string
+		output string
+		want   string
+	}{
+		{
+			name: "docker push output",
+			output: `The push refers to repository [quay.io/user/app]
+5f70bf18a086: Pushed
+latest: digest: ` + digest + ` size: 528
+`,
+			want: digest,
+		},
+		{
+			name:   "podman digest file",
+			output: digest,
+			want:   digest,
+		},
+		{
+			name:   "no digest",
+			output: "Writing manifest to image destination\n",
+			want:   "",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseDigest(tt.output); got != tt.want {
+				t.Errorf("parseDigest() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -22,8 +22,9 @@ type Backend interface {
 	// Build the image as defined in the devfile.
 	// The filesystem specified will be used to download and store the Dockerfile if it is referenced as a remote URL.
 	Build(fs filesystem.Filesystem, image *devfile.ImageComponent, devfilePath string) error
-	// Push the image to its registry as defined in the devfile
-	Push(image string) error
+	// Push the image to its registry as defined in the devfile,
+	// and return the digest of the pushed image, or an empty digest if it cannot be determined
+	Push(image string) (string, error)
 	// Return the name of the backend
 	String() string
 }
@@ -99,7 +100,7 @@ func buildPushImage(backend Backend, fs filesystem.Filesystem, image *devfile.Im
 		return err
 	}
 	if push {
-		err = backend.Push(image.ImageName)
+		_, err = backend.Push(image.ImageName)
 		if err != nil {
 			return err
 		}
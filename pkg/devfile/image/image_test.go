@@ -105,7 +105,7 @@ func TestBuildPushImage(t *testing.T) {
 				backend.EXPECT().Build(fakeFs, nil, tt.devfilePath).Times(0)
 			}
 			if tt.wantPushCalled {
-				backend.EXPECT().Push(tt.image.ImageName).Return(tt.PushReturns).Times(1)
+				backend.EXPECT().Push(tt.image.ImageName).Return("", tt.PushReturns).Times(1)
 			} else {
 				backend.EXPECT().Push(nil).Times(0)
 			}
@@ -50,11 +50,12 @@ func (mr *MockBackendMockRecorder) Build(fs, image, devfilePath interface{}) *go
 }
 
 // Push mocks base method.
-func (m *MockBackend) Push(image string) error {
+func (m *MockBackend) Push(image string) (string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Push", image)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Push indicates an expected call of Push.
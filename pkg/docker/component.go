@@ -0,0 +1,32 @@
+package docker
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	"github.com/redhat-developer/odo/pkg/podman"
+)
+
+func (o *DockerCli) ListAllComponents() ([]api.ComponentAbstract, error) {
+	infras, err := o.getInfraContainersFromSelector("", false)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]podman.ListPodsReport, 0, len(infras))
+	for _, infra := range infras {
+		list = append(list, podman.ListPodsReport{
+			Name:   infra.Config.Labels[podLabel],
+			Labels: getPodLabels(infra.Config.Labels),
+		})
+	}
+	return podman.GetComponentsFromPodsReports(list, commonflags.PlatformDocker), nil
+}
+
+func (o *DockerCli) GetPodUsingComponentName(componentName string) (*corev1.Pod, error) {
+	podSelector := fmt.Sprintf("component=%s", componentName)
+	return o.GetRunningPodFromSelector(podSelector)
+}
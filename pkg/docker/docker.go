@@ -0,0 +1,264 @@
+// Package docker implements the platform running the components on a Docker Engine.
+//
+// Docker has no notion of pod: a pod is emulated by an "infra" container owning the network namespace
+// and publishing the ports of the pod, and by one container per container of the pod, joining the network namespace
+// of the infra container. The definition of the pod is saved in a label of the infra container.
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog"
+
+	envcontext "github.com/redhat-developer/odo/pkg/config/context"
+	"github.com/redhat-developer/odo/pkg/platform"
+	"github.com/redhat-developer/odo/pkg/podman"
+)
+
+const (
+	// infraImage is the image of the container owning the network namespace shared by the containers of a pod
+	infraImage = "registry.k8s.io/pause:3.9"
+
+	// podLabel is the label containing the name of the pod a container is part of
+	podLabel = "dev.odo.docker.pod"
+	// infraLabel is the label identifying the infra container of a pod
+	infraLabel = "dev.odo.docker.infra"
+	// podSpecLabel is the label of the infra container containing the definition of the pod, in JSON format
+	podSpecLabel = "dev.odo.docker.pod-spec"
+)
+
+type DockerCli struct {
+	dockerCmd            string
+	dockerCmdInitTimeout time.Duration
+	globalExtraArgs      []string
+}
+
+var _ podman.Client = (*DockerCli)(nil)
+var _ platform.Client = (*DockerCli)(nil)
+
+// NewDockerCli returns a new docker client, or nil if the docker command is not accessible in the system
+// or if the Docker Engine is not reachable
+func NewDockerCli(ctx context.Context) (*DockerCli, error) {
+	globalArgs := envcontext.GetEnvConfig(ctx).OdoContainerBackendGlobalArgs
+	cli := &DockerCli{
+		dockerCmd:            envcontext.GetEnvConfig(ctx).DockerCmd,
+		dockerCmdInitTimeout: envcontext.GetEnvConfig(ctx).DockerCmdInitTimeout,
+		// The capacity is limited, so that appending arguments always allocates a new slice
+		globalExtraArgs: globalArgs[:len(globalArgs):len(globalArgs)],
+	}
+	version, err := cli.Version(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if version.Client == nil {
+		return nil, fmt.Errorf("executable %q not recognized as docker client", cli.dockerCmd)
+	}
+	if version.Server == nil {
+		return nil, fmt.Errorf("unable to get the version of the Docker Engine with %q", cli.dockerCmd)
+	}
+	return cli, nil
+}
+
+// PlayKube creates the volumes and the containers emulating the pod, and starts the containers.
+// The init containers are run to completion, in order, before the containers are started.
+func (o *DockerCli) PlayKube(pod *corev1.Pod) error {
+	err := o.playKube(pod)
+	if err != nil {
+		// Do not leave a partially created pod
+		if cleanupErr := o.removeContainers(pod.GetName()); cleanupErr != nil {
+			klog.V(3).Infof("unable to remove the containers of pod %q: %v", pod.GetName(), cleanupErr)
+		}
+	}
+	return err
+}
+
+func (o *DockerCli) playKube(pod *corev1.Pod) error {
+	for _, volume := range pod.Spec.Volumes {
+		if volume.PersistentVolumeClaim == nil {
+			continue
+		}
+		// volume create is idempotent
+		_, err := o.run("volume", "create", volume.PersistentVolumeClaim.ClaimName)
+		if err != nil {
+			return err
+		}
+	}
+
+	if klog.V(4) {
+		klog.Infof("Pod spec to play: \n---\n%s\n---\n", podSpecString(pod))
+	}
+
+	args, err := getInfraRunArgs(pod)
+	if err != nil {
+		return err
+	}
+	_, err = o.run(args...)
+	if err != nil {
+		return err
+	}
+
+	for _, container := range pod.Spec.InitContainers {
+		args, err = getContainerRunArgs(pod, container, true)
+		if err != nil {
+			return err
+		}
+		_, err = o.run(args...)
+		if err != nil {
+			return fmt.Errorf("init container %q failed: %w", container.Name, err)
+		}
+	}
+
+	for _, container := range pod.Spec.Containers {
+		args, err = getContainerRunArgs(pod, container, false)
+		if err != nil {
+			return err
+		}
+		_, err = o.run(args...)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// KubeGenerate returns the definition of the pod saved in the infra container of the pod
+func (o *DockerCli) KubeGenerate(name string) (*corev1.Pod, error) {
+	containers, err := o.inspect(getInfraContainerName(name))
+	if err != nil {
+		return nil, err
+	}
+	if len(containers) == 0 {
+		return nil, fmt.Errorf("no pod with name %q found", name)
+	}
+	return containers[0].getPod()
+}
+
+func (o *DockerCli) PodStop(podname string) error {
+	ids, err := o.getPodContainers(podname)
+	if err != nil {
+		return err
+	}
+	out, err := o.run(append([]string{"stop"}, ids...)...)
+	if err != nil {
+		return err
+	}
+	klog.V(4).Infof("Stopped containers %s", out)
+	return nil
+}
+
+func (o *DockerCli) PodRm(podname string) error {
+	ids, err := o.getPodContainers(podname)
+	if err != nil {
+		return err
+	}
+	out, err := o.run(append([]string{"rm"}, ids...)...)
+	if err != nil {
+		return err
+	}
+	klog.V(4).Infof("Deleted containers %s", out)
+	return nil
+}
+
+func (o *DockerCli) PodLs() (map[string]bool, error) {
+	out, err := o.run("ps", "--all", "--filter", "label="+infraLabel+"=true", "--format", fmt.Sprintf("{{.Label %q}}", podLabel))
+	if err != nil {
+		return nil, err
+	}
+	return podman.SplitLinesAsSet(out), nil
+}
+
+func (o *DockerCli) VolumeRm(volumeName string) error {
+	out, err := o.run("volume", "rm", volumeName)
+	if err != nil {
+		return err
+	}
+	klog.V(4).Infof("Deleted volume %s", out)
+	return nil
+}
+
+func (o *DockerCli) VolumeLs() (map[string]bool, error) {
+	out, err := o.run("volume", "ls", "--format", "{{.Name}}")
+	if err != nil {
+		return nil, err
+	}
+	return podman.SplitLinesAsSet(out), nil
+}
+
+func (o *DockerCli) CleanupPodResources(pod *corev1.Pod, cleanupVolumes bool) error {
+	err := o.PodStop(pod.GetName())
+	if err != nil {
+		return err
+	}
+	err = o.PodRm(pod.GetName())
+	if err != nil {
+		return err
+	}
+
+	if !cleanupVolumes {
+		return nil
+	}
+
+	for _, volume := range pod.Spec.Volumes {
+		if volume.PersistentVolumeClaim == nil {
+			continue
+		}
+		volumeName := volume.PersistentVolumeClaim.ClaimName
+		klog.V(3).Infof("deleting docker volume %q", volumeName)
+		err = o.VolumeRm(volumeName)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeContainers forcibly removes all the containers of the pod, running or not
+func (o *DockerCli) removeContainers(podname string) error {
+	ids, err := o.getPodContainers(podname)
+	if err != nil {
+		return err
+	}
+	_, err = o.run(append([]string{"rm", "--force"}, ids...)...)
+	return err
+}
+
+// getPodContainers returns the IDs of the containers of the pod, including the infra container
+func (o *DockerCli) getPodContainers(podname string) ([]string, error) {
+	out, err := o.run("ps", "--all", "--quiet", "--filter", "label="+podLabel+"="+podname)
+	if err != nil {
+		return nil, err
+	}
+	ids := strings.Fields(out)
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("no pod with name %q found", podname)
+	}
+	return ids, nil
+}
+
+// run executes the docker command with the global arguments and the given arguments, and returns its standard output,
+// even if the command fails
+func (o *DockerCli) run(args ...string) (string, error) {
+	cmd := exec.Command(o.dockerCmd, append(o.globalExtraArgs, args...)...)
+	klog.V(3).Infof("executing %v", cmd.Args)
+	out, err := cmd.Output()
+	if err != nil {
+		if exiterr, ok := err.(*exec.ExitError); ok {
+			err = fmt.Errorf("%s: %s", err, string(exiterr.Stderr))
+		}
+	}
+	return string(out), err
+}
+
+func podSpecString(pod *corev1.Pod) string {
+	out, err := json.MarshalIndent(pod, "", "  ")
+	if err != nil {
+		return err.Error()
+	}
+	return string(out)
+}
@@ -0,0 +1,145 @@
+package docker
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+)
+
+// newFakeDockerCli returns a client executing a fake docker command, outputting the result of the inspection
+// of the infra container of the test pod, in the given state
+func newFakeDockerCli(t *testing.T, status string) *DockerCli {
+	pod := newTestPod()
+	spec, err := json.Marshal(pod)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var infra containerInspect
+	infra.ID = "abc123"
+	infra.Name = "/mycmp-app-infra"
+	infra.State.Status = status
+	infra.State.Running = status == "running"
+	infra.Config.Labels = map[string]string{
+		podLabel:                       "mycmp-app",
+		infraLabel:                     "true",
+		podSpecLabel:                   string(spec),
+		"component":                    "mycmp",
+		"app.kubernetes.io/instance":   "mycmp",
+		"app.kubernetes.io/managed-by": "odo",
+		"odo.dev/mode":                 "Dev",
+	}
+	inspect, err := json.Marshal([]containerInspect{infra})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	inspectFile := filepath.Join(dir, "inspect.json")
+	err = os.WriteFile(inspectFile, inspect, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	script := []byte(`#!/bin/sh
+case "$*" in
+	"ps --quiet --filter label=dev.odo.docker.infra=true --all --filter label=component=mycmp")
+		echo abc123
+		;;
+	"ps --quiet --filter label=dev.odo.docker.infra=true --filter status=running")
+		echo abc123
+		;;
+	"ps --quiet --filter label=dev.odo.docker.infra=true --all --filter label=component=other")
+		;;
+	"inspect --type container abc123")
+		cat ` + inspectFile + `
+		;;
+	*)
+		echo "unexpected arguments: $*" >&2
+		exit 1
+		;;
+esac`)
+	dockerCmd := filepath.Join(dir, "docker.fake.sh")
+	err = os.WriteFile(dockerCmd, script, 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &DockerCli{dockerCmd: dockerCmd}
+}
+
+func TestDockerCli_GetRunningPodFromSelector(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   string
+		selector string
+		wantErr  bool
+	}{
+		{
+			name:     "running pod",
+			status:   "running",
+			selector: "component=mycmp",
+		},
+		{
+			name:     "exited pod",
+			status:   "exited",
+			selector: "component=mycmp",
+			wantErr:  true,
+		},
+		{
+			name:     "no pod",
+			status:   "running",
+			selector: "component=other",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := newFakeDockerCli(t, tt.status)
+			got, err := o.GetRunningPodFromSelector(tt.selector)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetRunningPodFromSelector() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.GetName() != "mycmp-app" {
+				t.Errorf("pod name = %q, want %q", got.GetName(), "mycmp-app")
+			}
+			if got.Status.Phase != corev1.PodPhase("Running") {
+				t.Errorf("pod phase = %q, want %q", got.Status.Phase, "Running")
+			}
+			if diff := cmp.Diff(newTestPod().Spec.Containers, got.Spec.Containers); diff != "" {
+				t.Errorf("containers mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDockerCli_ListAllComponents(t *testing.T) {
+	o := newFakeDockerCli(t, "running")
+	got, err := o.ListAllComponents()
+	if err != nil {
+		t.Fatal(err)
+	}
+	runningIn := api.NewRunningModes()
+	runningIn.AddRunningMode(api.RunningModeDev)
+	want := []api.ComponentAbstract{
+		{
+			Name:      "mycmp",
+			ManagedBy: "odo",
+			Type:      api.TypeUnknown,
+			RunningIn: runningIn,
+			//lint:ignore SA1019 we need to output the deprecated value, before to remove it in a future release
+			RunningOn: commonflags.PlatformDocker,
+			Platform:  commonflags.PlatformDocker,
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ListAllComponents() mismatch (-want +got):\n%s", diff)
+	}
+}
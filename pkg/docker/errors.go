@@ -0,0 +1,21 @@
+package docker
+
+import (
+	"fmt"
+)
+
+type DockerNotFoundError struct {
+	err error
+}
+
+func NewDockerNotFoundError(err error) DockerNotFoundError {
+	return DockerNotFoundError{err: err}
+}
+
+func (o DockerNotFoundError) Error() string {
+	msg := "unable to access docker. Do you have docker client installed and the Docker Engine running?"
+	if o.err == nil {
+		return msg
+	}
+	return fmt.Errorf("%s cause: %w", msg, o.err).Error()
+}
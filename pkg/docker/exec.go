@@ -0,0 +1,25 @@
+package docker
+
+import (
+	"context"
+	"io"
+	"os/exec"
+
+	"k8s.io/klog"
+)
+
+func (o *DockerCli) ExecCMDInContainer(ctx context.Context, containerName, podName string, cmd []string, stdout, stderr io.Writer, stdin io.Reader, tty bool) error {
+	args := []string{"exec", "--interactive"}
+	if tty {
+		args = append(args, "--tty")
+	}
+	args = append(args, getContainerName(podName, containerName))
+	args = append(args, cmd...)
+
+	command := exec.CommandContext(ctx, o.dockerCmd, append(o.globalExtraArgs, args...)...)
+	command.Stdout = stdout
+	command.Stderr = stderr
+	command.Stdin = stdin
+	klog.V(3).Infof("executing %v", command.Args)
+	return command.Run()
+}
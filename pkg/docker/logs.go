@@ -0,0 +1,45 @@
+package docker
+
+import (
+	"errors"
+	"io"
+	"os/exec"
+	"strconv"
+
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/platform"
+)
+
+// GetPodLogs returns the logs of the specified pod container.
+// As Docker has no notion of pod, the name of the container is required.
+func (o *DockerCli) GetPodLogs(podName, containerName string, options platform.PodLogsOptions) (io.ReadCloser, error) {
+	if containerName == "" {
+		return nil, errors.New("the name of the container is required to get the logs of a pod on Docker")
+	}
+	args := []string{"logs"}
+	if options.Follow {
+		args = append(args, "--follow")
+	}
+	if options.Since > 0 {
+		args = append(args, "--since", options.Since.String())
+	}
+	if options.TailLines != nil {
+		args = append(args, "--tail", strconv.FormatInt(*options.TailLines, 10))
+	}
+	if options.Timestamps {
+		args = append(args, "--timestamps")
+	}
+	args = append(args, getContainerName(podName, containerName))
+
+	cmd := exec.Command(o.dockerCmd, append(o.globalExtraArgs, args...)...)
+	klog.V(3).Infof("executing %v", cmd.Args)
+
+	out, _ := cmd.StdoutPipe()
+	// docker logs outputs the standard error of the container on its standard error
+	cmd.Stderr = cmd.Stdout
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,179 @@
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/platform"
+	"github.com/redhat-developer/odo/pkg/podman"
+)
+
+// containerInspect contains the part of the result of the `docker inspect` command used by odo
+type containerInspect struct {
+	ID    string `json:"Id"`
+	Name  string
+	State struct {
+		Status  string
+		Running bool
+	}
+	Config struct {
+		Labels map[string]string
+	}
+}
+
+// getPod returns the definition of the pod saved in the infra container
+func (o containerInspect) getPod() (*corev1.Pod, error) {
+	spec, ok := o.Config.Labels[podSpecLabel]
+	if !ok {
+		return nil, fmt.Errorf("container %q is not the infra container of a pod", strings.TrimPrefix(o.Name, "/"))
+	}
+	var pod corev1.Pod
+	err := json.Unmarshal([]byte(spec), &pod)
+	if err != nil {
+		return nil, err
+	}
+	return &pod, nil
+}
+
+// getPodPhase returns the phase of the pod, given the state of its infra container, using the same values as Podman
+// (e.g. "Running", "Exited")
+func (o containerInspect) getPodPhase() corev1.PodPhase {
+	status := o.State.Status
+	if status == "" {
+		return ""
+	}
+	return corev1.PodPhase(strings.ToUpper(status[:1]) + status[1:])
+}
+
+// GetPodsMatchingSelector returns all pods matching the given label selector.
+func (o *DockerCli) GetPodsMatchingSelector(selector string) (*corev1.PodList, error) {
+	infras, err := o.getInfraContainersFromSelector(selector, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var result corev1.PodList
+	for _, infra := range infras {
+		pod, err := infra.getPod()
+		if err != nil {
+			return nil, err
+		}
+		pod.Status.Phase = infra.getPodPhase()
+		result.Items = append(result.Items, *pod)
+	}
+	return &result, nil
+}
+
+// GetAllResourcesFromSelector returns all resources of any kind matching the given label selector.
+func (o *DockerCli) GetAllResourcesFromSelector(selector string, _ string) ([]unstructured.Unstructured, error) {
+	infras, err := o.getInfraContainersFromSelector(selector, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []unstructured.Unstructured
+	for _, infra := range infras {
+		u := unstructured.Unstructured{}
+		u.SetName(infra.Config.Labels[podLabel])
+		u.SetLabels(getPodLabels(infra.Config.Labels))
+		result = append(result, u)
+	}
+	return result, nil
+}
+
+// GetAllPodsInNamespaceMatchingSelector returns all pods matching the given label selector and in the specified namespace.
+func (o *DockerCli) GetAllPodsInNamespaceMatchingSelector(selector string, ns string) (*corev1.PodList, error) {
+	// As on Podman, there is no resource containing PodSpec
+	return o.GetPodsMatchingSelector(selector)
+}
+
+// GetRunningPodFromSelector returns any pod matching the given label selector.
+// An error is returned if multiple pods are found.
+func (o *DockerCli) GetRunningPodFromSelector(selector string) (*corev1.Pod, error) {
+	infras, err := o.getInfraContainersFromSelector(selector, true)
+	if err != nil {
+		return nil, err
+	}
+	numPods := len(infras)
+	if numPods == 0 {
+		return nil, &platform.PodNotFoundError{Selector: selector}
+	} else if numPods > 1 {
+		return nil, fmt.Errorf("multiple Pods exist for the selector: %v. Only one must be present", selector)
+	}
+
+	infra := infras[0]
+	if !infra.State.Running {
+		return nil, fmt.Errorf("a pod exists but is not in Running state. Current status=%v", infra.getPodPhase())
+	}
+	pod, err := infra.getPod()
+	if err != nil {
+		return nil, err
+	}
+	pod.Status.Phase = infra.getPodPhase()
+	return pod, nil
+}
+
+func (o *DockerCli) PodWatcher(ctx context.Context, selector string) (watch.Interface, error) {
+	return podman.NewContainersWatcher(o.dockerCmd, o.globalExtraArgs), nil
+}
+
+// getInfraContainersFromSelector returns the infra containers of the pods matching the label selector,
+// running only, or in any state if all is true
+func (o *DockerCli) getInfraContainersFromSelector(selector string, all bool) ([]containerInspect, error) {
+	args := []string{"ps", "--quiet", "--filter", "label=" + infraLabel + "=true"}
+	if all {
+		args = append(args, "--all")
+	} else {
+		args = append(args, "--filter", "status=running")
+	}
+	if selector != "" {
+		for _, s := range strings.Split(selector, ",") {
+			args = append(args, "--filter", "label="+s)
+		}
+	}
+	out, err := o.run(args...)
+	if err != nil {
+		return nil, err
+	}
+	ids := strings.Fields(out)
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return o.inspect(ids...)
+}
+
+// inspect returns the description of the containers; the containers removed in the meantime are ignored
+func (o *DockerCli) inspect(containers ...string) ([]containerInspect, error) {
+	// docker inspect outputs the containers found, and fails if some of them are not found
+	out, err := o.run(append([]string{"inspect", "--type", "container"}, containers...)...)
+	var result []containerInspect
+	if jsonErr := json.Unmarshal([]byte(out), &result); jsonErr != nil || len(result) == 0 {
+		if err != nil {
+			return nil, err
+		}
+		return nil, jsonErr
+	}
+	if err != nil {
+		klog.V(4).Infof("some containers were not found: %v", err)
+	}
+	return result, nil
+}
+
+// getPodLabels returns the labels of the pod, given the labels of one of its containers
+func getPodLabels(containerLabels map[string]string) map[string]string {
+	result := make(map[string]string, len(containerLabels))
+	for k, v := range containerLabels {
+		if k == podLabel || k == infraLabel || k == podSpecLabel {
+			continue
+		}
+		result[k] = v
+	}
+	return result
+}
@@ -0,0 +1,149 @@
+package docker
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog"
+)
+
+// getInfraContainerName returns the name of the infra container of the pod
+func getInfraContainerName(podName string) string {
+	return podName + "-infra"
+}
+
+// getContainerName returns the name of the container of the pod; the name is prefixed with the name of the pod,
+// the same way Podman names the containers of a pod
+func getContainerName(podName, containerName string) string {
+	return podName + "-" + containerName
+}
+
+// getInfraRunArgs returns the arguments of the "docker run" command starting the infra container of the pod,
+// publishing the host ports of all the containers of the pod, and saving the definition of the pod in a label
+func getInfraRunArgs(pod *corev1.Pod) ([]string, error) {
+	spec, err := json.Marshal(pod)
+	if err != nil {
+		return nil, err
+	}
+	args := []string{
+		"run", "--detach",
+		"--name", getInfraContainerName(pod.GetName()),
+		"--hostname", pod.GetName(),
+		"--label", infraLabel + "=true",
+		"--label", podSpecLabel + "=" + string(spec),
+	}
+	args = append(args, getLabelsArgs(pod)...)
+	for _, container := range pod.Spec.Containers {
+		for _, port := range container.Ports {
+			if port.HostPort == 0 {
+				continue
+			}
+			publish := fmt.Sprintf("%d:%d/%s", port.HostPort, port.ContainerPort, getProtocol(port.Protocol))
+			if port.HostIP != "" {
+				publish = port.HostIP + ":" + publish
+			}
+			args = append(args, "--publish", publish)
+		}
+	}
+	return append(args, infraImage), nil
+}
+
+// getContainerRunArgs returns the arguments of the "docker run" command starting the container of the pod,
+// sharing the network namespace of the infra container of the pod.
+// An init container is run in the foreground, and removed when it exits.
+func getContainerRunArgs(pod *corev1.Pod, container corev1.Container, init bool) ([]string, error) {
+	args := []string{"run"}
+	if init {
+		args = append(args, "--rm")
+	} else {
+		args = append(args, "--detach")
+	}
+	args = append(args,
+		"--name", getContainerName(pod.GetName(), container.Name),
+		"--network", "container:"+getInfraContainerName(pod.GetName()),
+	)
+	args = append(args, getLabelsArgs(pod)...)
+
+	for _, env := range container.Env {
+		if env.ValueFrom != nil {
+			klog.V(3).Infof("ignoring environment variable %q of container %q, as its value is not defined inline", env.Name, container.Name)
+			continue
+		}
+		args = append(args, "--env", env.Name+"="+env.Value)
+	}
+
+	for _, mount := range container.VolumeMounts {
+		volumeName, err := getVolumeName(pod, mount.Name)
+		if err != nil {
+			return nil, err
+		}
+		if mount.SubPath != "" {
+			klog.V(3).Infof("ignoring sub path %q of volume %q mounted in container %q, not supported by Docker", mount.SubPath, mount.Name, container.Name)
+		}
+		volume := volumeName + ":" + mount.MountPath
+		if mount.ReadOnly {
+			volume += ":ro"
+		}
+		args = append(args, "--volume", volume)
+	}
+
+	if container.WorkingDir != "" {
+		args = append(args, "--workdir", container.WorkingDir)
+	}
+	if memory, ok := container.Resources.Limits[corev1.ResourceMemory]; ok {
+		args = append(args, "--memory", strconv.FormatInt(memory.Value(), 10))
+	}
+	if cpu, ok := container.Resources.Limits[corev1.ResourceCPU]; ok {
+		args = append(args, "--cpus", strconv.FormatFloat(float64(cpu.MilliValue())/1000, 'f', -1, 64))
+	}
+
+	// The command of the container replaces the entrypoint of the image, the same way as on Kubernetes
+	if len(container.Command) > 0 {
+		args = append(args, "--entrypoint", container.Command[0])
+	}
+	args = append(args, container.Image)
+	if len(container.Command) > 1 {
+		args = append(args, container.Command[1:]...)
+	}
+	return append(args, container.Args...), nil
+}
+
+// getLabelsArgs returns the arguments setting the labels of the pod, and the name of the pod, on a container
+func getLabelsArgs(pod *corev1.Pod) []string {
+	args := []string{"--label", podLabel + "=" + pod.GetName()}
+	keys := make([]string, 0, len(pod.GetLabels()))
+	for k := range pod.GetLabels() {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		args = append(args, "--label", k+"="+pod.GetLabels()[k])
+	}
+	return args
+}
+
+// getVolumeName returns the name of the Docker volume backing the volume of the pod
+func getVolumeName(pod *corev1.Pod, name string) (string, error) {
+	for _, volume := range pod.Spec.Volumes {
+		if volume.Name != name {
+			continue
+		}
+		if volume.PersistentVolumeClaim == nil {
+			return "", fmt.Errorf("volume %q is not supported by Docker, only persistent volume claims are supported", name)
+		}
+		return volume.PersistentVolumeClaim.ClaimName, nil
+	}
+	return "", fmt.Errorf("volume %q not found in pod %q", name, pod.GetName())
+}
+
+// getProtocol returns the protocol of the port, as expected by the --publish flag
+func getProtocol(protocol corev1.Protocol) string {
+	if protocol == "" {
+		return "tcp"
+	}
+	return strings.ToLower(string(protocol))
+}
@@ -0,0 +1,167 @@
+package docker
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newTestPod() *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "mycmp-app",
+			Labels: map[string]string{
+				"component":                    "mycmp",
+				"app.kubernetes.io/managed-by": "odo",
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:    "runtime",
+					Image:   "registry.access.redhat.com/ubi8/nodejs-16",
+					Command: []string{"tail"},
+					Args:    []string{"-f", "/dev/null"},
+					Env: []corev1.EnvVar{
+						{Name: "PROJECT_SOURCE", Value: "/projects"},
+					},
+					Ports: []corev1.ContainerPort{
+						{Name: "http", ContainerPort: 3000, HostPort: 20001, HostIP: "127.0.0.1", Protocol: corev1.ProtocolTCP},
+						{Name: "dns", ContainerPort: 5353, HostPort: 20002, Protocol: corev1.ProtocolUDP},
+						{Name: "internal", ContainerPort: 8080},
+					},
+					VolumeMounts: []corev1.VolumeMount{
+						{Name: "odo-projects", MountPath: "/projects"},
+						{Name: "config", MountPath: "/config", ReadOnly: true},
+					},
+					Resources: corev1.ResourceRequirements{
+						Limits: corev1.ResourceList{
+							corev1.ResourceMemory: resource.MustParse("1Gi"),
+							corev1.ResourceCPU:    resource.MustParse("500m"),
+						},
+					},
+				},
+			},
+			Volumes: []corev1.Volume{
+				{
+					Name: "odo-projects",
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "odo-projects-mycmp-app"},
+					},
+				},
+				{
+					Name: "config",
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "config-mycmp-app"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func Test_getInfraRunArgs(t *testing.T) {
+	pod := newTestPod()
+	spec, err := json.Marshal(pod)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := getInfraRunArgs(pod)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"run", "--detach",
+		"--name", "mycmp-app-infra",
+		"--hostname", "mycmp-app",
+		"--label", "dev.odo.docker.infra=true",
+		"--label", "dev.odo.docker.pod-spec=" + string(spec),
+		"--label", "dev.odo.docker.pod=mycmp-app",
+		"--label", "app.kubernetes.io/managed-by=odo",
+		"--label", "component=mycmp",
+		"--publish", "127.0.0.1:20001:3000/tcp",
+		"--publish", "20002:5353/udp",
+		infraImage,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("getInfraRunArgs() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_getContainerRunArgs(t *testing.T) {
+	pod := newTestPod()
+	tests := []struct {
+		name      string
+		container corev1.Container
+		init      bool
+		want      []string
+		wantErr   bool
+	}{
+		{
+			name:      "container",
+			container: pod.Spec.Containers[0],
+			want: []string{
+				"run", "--detach",
+				"--name", "mycmp-app-runtime",
+				"--network", "container:mycmp-app-infra",
+				"--label", "dev.odo.docker.pod=mycmp-app",
+				"--label", "app.kubernetes.io/managed-by=odo",
+				"--label", "component=mycmp",
+				"--env", "PROJECT_SOURCE=/projects",
+				"--volume", "odo-projects-mycmp-app:/projects",
+				"--volume", "config-mycmp-app:/config:ro",
+				"--memory", "1073741824",
+				"--cpus", "0.5",
+				"--entrypoint", "tail",
+				"registry.access.redhat.com/ubi8/nodejs-16",
+				"-f", "/dev/null",
+			},
+		},
+		{
+			name: "init container without command",
+			container: corev1.Container{
+				Name:       "copy-supervisord",
+				Image:      "quay.io/devfile/supervisord",
+				Args:       []string{"--copy"},
+				WorkingDir: "/opt",
+			},
+			init: true,
+			want: []string{
+				"run", "--rm",
+				"--name", "mycmp-app-copy-supervisord",
+				"--network", "container:mycmp-app-infra",
+				"--label", "dev.odo.docker.pod=mycmp-app",
+				"--label", "app.kubernetes.io/managed-by=odo",
+				"--label", "component=mycmp",
+				"--workdir", "/opt",
+				"quay.io/devfile/supervisord",
+				"--copy",
+			},
+		},
+		{
+			name: "unknown volume",
+			container: corev1.Container{
+				Name:         "runtime",
+				Image:        "image",
+				VolumeMounts: []corev1.VolumeMount{{Name: "unknown", MountPath: "/data"}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := getContainerRunArgs(pod, tt.container, tt.init)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("getContainerRunArgs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("getContainerRunArgs() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package docker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/podman"
+)
+
+// Version returns the version of the Docker client and of the Docker Engine.
+func (o *DockerCli) Version(ctx context.Context) (podman.SystemVersionReport, error) {
+	// As for Podman, Version is used when injecting dependencies, and is expected to return in a timely manner
+	ctx, cancel := context.WithTimeout(ctx, o.dockerCmdInitTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, o.dockerCmd, append(o.globalExtraArgs, "version", "--format", "{{json .}}")...)
+	klog.V(3).Infof("executing %v", cmd.Args)
+
+	outbuf, errbuf := new(bytes.Buffer), new(bytes.Buffer)
+	cmd.Stdout, cmd.Stderr = outbuf, errbuf
+
+	err := cmd.Run()
+	if ctx.Err() == context.DeadlineExceeded {
+		return podman.SystemVersionReport{}, fmt.Errorf("timeout (%s) while waiting for Docker version", o.dockerCmdInitTimeout.String())
+	}
+	if err != nil {
+		// The docker command fails when the Docker Engine is not reachable
+		klog.V(3).Infof("Non-zero exit code for docker version: %v", err)
+		if stderr := strings.TrimSpace(errbuf.String()); stderr != "" {
+			return podman.SystemVersionReport{}, fmt.Errorf("%w: %s", err, stderr)
+		}
+		return podman.SystemVersionReport{}, err
+	}
+
+	var result podman.SystemVersionReport
+	err = json.NewDecoder(outbuf).Decode(&result)
+	if err != nil {
+		klog.V(3).Infof("unable to decode output: %v", err)
+		return podman.SystemVersionReport{}, err
+	}
+	return result, nil
+}
+
+// dockerInfo contains the part of the result of the `docker info` command used by odo
+type dockerInfo struct {
+	CgroupVersion   string
+	SecurityOptions []string
+}
+
+func (o *DockerCli) getInfo() (dockerInfo, error) {
+	out, err := o.run("info", "--format", "{{json .}}")
+	if err != nil {
+		return dockerInfo{}, err
+	}
+	var result dockerInfo
+	err = json.Unmarshal([]byte(out), &result)
+	return result, err
+}
+
+func (o *DockerCli) GetCapabilities() (podman.Capabilities, error) {
+	info, err := o.getInfo()
+	if err != nil {
+		return podman.Capabilities{}, err
+	}
+	return podman.Capabilities{
+		Cgroupv2: info.CgroupVersion == "2",
+	}, nil
+}
+
+// GetConnection returns an empty string, as the Docker Engine is selected by the docker command itself
+// (e.g. with the DOCKER_HOST or DOCKER_CONTEXT environment variables)
+func (o *DockerCli) GetConnection() string {
+	return ""
+}
+
+// GetHostInfo returns information about the host running the Docker Engine
+func (o *DockerCli) GetHostInfo() (podman.HostInfo, error) {
+	info, err := o.getInfo()
+	if err != nil {
+		return podman.HostInfo{}, err
+	}
+	result := podman.HostInfo{}
+	if info.CgroupVersion != "" {
+		result.CgroupsVersion = "v" + info.CgroupVersion
+	}
+	for _, option := range info.SecurityOptions {
+		if option == "name=rootless" {
+			result.Security.Rootless = true
+		}
+	}
+	return result, nil
+}
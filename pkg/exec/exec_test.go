@@ -10,6 +10,8 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/redhat-developer/odo/pkg/platform"
 )
 
 const (
@@ -25,7 +27,7 @@ func (o fakePlatform) ExecCMDInContainer(ctx context.Context, containerName, pod
 	return o.execCMDInContainer(containerName, podName, cmd, stdout, stderr, stdin, tty)
 }
 
-func (o fakePlatform) GetPodLogs(podName, containerName string, followLog bool) (io.ReadCloser, error) {
+func (o fakePlatform) GetPodLogs(podName, containerName string, options platform.PodLogsOptions) (io.ReadCloser, error) {
 	panic("not implemented yet")
 }
 
@@ -10,9 +10,12 @@ import (
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog"
 )
 
@@ -54,6 +57,60 @@ func (c *Client) PatchDynamicResource(resource unstructured.Unstructured) (bool,
 	return newGeneration > previousGeneration, nil
 }
 
+// DryRunPatchDynamicResource applies a dynamic resource via server-side apply in dry-run mode,
+// and returns the resource currently deployed (or nil if the resource does not exist)
+// and the resource as it would be after the apply
+func (c *Client) DryRunPatchDynamicResource(resource unstructured.Unstructured) (*unstructured.Unstructured, *unstructured.Unstructured, error) {
+	klog.V(5).Infoln("Applying resource via server-side apply in dry-run mode:")
+	klog.V(5).Infoln(resourceAsJson(resource.Object))
+	unversionedResource := resource.DeepCopy()
+	unversionedResource.SetResourceVersion("")
+	data, err := json.Marshal(unversionedResource.Object)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to marshal resource: %w", err)
+	}
+
+	gvr, err := c.GetRestMappingFromUnstructured(*unversionedResource)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	current, err := c.DynamicClient.Resource(gvr.Resource).Namespace(c.Namespace).Get(context.TODO(), unversionedResource.GetName(), metav1.GetOptions{})
+	if err != nil {
+		if !kerrors.IsNotFound(err) {
+			return nil, nil, err
+		}
+		current = nil
+	}
+
+	applied, err := c.DynamicClient.Resource(gvr.Resource).Namespace(c.Namespace).Patch(context.TODO(), unversionedResource.GetName(), types.ApplyPatchType, data, metav1.PatchOptions{
+		DryRun:       []string{metav1.DryRunAll},
+		FieldManager: FieldManager,
+		Force:        Bool(true),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return current, applied, nil
+}
+
+// DynamicResourceInformer returns an informer of the resource with the given name in the current namespace,
+// using the dynamic client. The informer is not started.
+func (c *Client) DynamicResourceInformer(gvr schema.GroupVersionResource, name string) cache.SharedIndexInformer {
+	fieldSelector := fields.OneTermEqualSelector("metadata.name", name).String()
+	lw := &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			options.FieldSelector = fieldSelector
+			return c.DynamicClient.Resource(gvr).Namespace(c.Namespace).List(context.TODO(), options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.FieldSelector = fieldSelector
+			return c.DynamicClient.Resource(gvr).Namespace(c.Namespace).Watch(context.TODO(), options)
+		},
+	}
+	return cache.NewSharedIndexInformer(lw, &unstructured.Unstructured{}, 0, cache.Indexers{})
+}
+
 // ListDynamicResources returns an unstructured list of instances of a Custom
 // Resource currently deployed in the specified namespace of the cluster. The current namespace is used if the namespace is not specified.
 // If a selector is passed, then it will be used as a label selector to list the resources.
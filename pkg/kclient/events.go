@@ -3,6 +3,7 @@ package kclient
 import (
 	"context"
 
+	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/watch"
@@ -34,3 +35,16 @@ func (c *Client) PodWarningEventWatcher(ctx context.Context) (result watch.Inter
 	}
 	return result, false, nil
 }
+
+// ListPodEvents returns the events related to the pod in the current namespace
+func (c *Client) ListPodEvents(podName string) ([]corev1.Event, error) {
+	selector := "involvedObject.kind=Pod,involvedObject.name=" + podName
+	list, err := c.GetClient().CoreV1().Events(c.GetCurrentNamespace()).
+		List(context.TODO(), metav1.ListOptions{
+			FieldSelector: selector,
+		})
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
@@ -21,6 +21,7 @@ import (
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/clientcmd"
 	psaApi "k8s.io/pod-security-admission/api"
 
@@ -60,6 +61,8 @@ type ClientInterface interface {
 
 	// dynamic.go
 	PatchDynamicResource(exampleCustomResource unstructured.Unstructured) (bool, error)
+	DryRunPatchDynamicResource(resource unstructured.Unstructured) (*unstructured.Unstructured, *unstructured.Unstructured, error)
+	DynamicResourceInformer(gvr schema.GroupVersionResource, name string) cache.SharedIndexInformer
 	ListDynamicResources(namespace string, gvr schema.GroupVersionResource, selector string) (*unstructured.UnstructuredList, error)
 	GetDynamicResource(gvr schema.GroupVersionResource, name string) (*unstructured.Unstructured, error)
 	UpdateDynamicResource(gvr schema.GroupVersionResource, name string, u *unstructured.Unstructured) error
@@ -67,6 +70,7 @@ type ClientInterface interface {
 
 	// events.go
 	PodWarningEventWatcher(ctx context.Context) (result watch.Interface, isForbidden bool, err error)
+	ListPodEvents(podName string) ([]corev1.Event, error)
 
 	// kclient.go
 	GetClient() kubernetes.Interface
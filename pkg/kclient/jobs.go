@@ -10,6 +10,8 @@ import (
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/platform"
 )
 
 // constants for volumes
@@ -89,7 +91,7 @@ func (c *Client) GetJobLogs(job *batchv1.Job, containerName string) (io.ReadClos
 		return nil, fmt.Errorf("no pod found for job %q", job.Name)
 	}
 	pod := pods.Items[0]
-	return c.GetPodLogs(pod.Name, containerName, false)
+	return c.GetPodLogs(pod.Name, containerName, platform.PodLogsOptions{})
 }
 
 func (c *Client) DeleteJob(jobName string) error {
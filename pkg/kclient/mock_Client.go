@@ -15,6 +15,7 @@ import (
 	v1 "github.com/openshift/api/project/v1"
 	v1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	api "github.com/redhat-developer/odo/pkg/api"
+	platform "github.com/redhat-developer/odo/pkg/platform"
 	v1alpha10 "github.com/redhat-developer/service-binding-operator/apis/binding/v1alpha1"
 	v1alpha3 "github.com/redhat-developer/service-binding-operator/apis/spec/v1alpha3"
 	v10 "k8s.io/api/apps/v1"
@@ -30,6 +31,7 @@ import (
 	dynamic "k8s.io/client-go/dynamic"
 	kubernetes "k8s.io/client-go/kubernetes"
 	rest "k8s.io/client-go/rest"
+	cache "k8s.io/client-go/tools/cache"
 	clientcmd "k8s.io/client-go/tools/clientcmd"
 	api0 "k8s.io/pod-security-admission/api"
 )
@@ -317,6 +319,36 @@ func (mr *MockClientInterfaceMockRecorder) DeploymentWatcher(ctx, selector inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeploymentWatcher", reflect.TypeOf((*MockClientInterface)(nil).DeploymentWatcher), ctx, selector)
 }
 
+// DryRunPatchDynamicResource mocks base method.
+func (m *MockClientInterface) DryRunPatchDynamicResource(resource unstructured.Unstructured) (*unstructured.Unstructured, *unstructured.Unstructured, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DryRunPatchDynamicResource", resource)
+	ret0, _ := ret[0].(*unstructured.Unstructured)
+	ret1, _ := ret[1].(*unstructured.Unstructured)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// DryRunPatchDynamicResource indicates an expected call of DryRunPatchDynamicResource.
+func (mr *MockClientInterfaceMockRecorder) DryRunPatchDynamicResource(resource interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DryRunPatchDynamicResource", reflect.TypeOf((*MockClientInterface)(nil).DryRunPatchDynamicResource), resource)
+}
+
+// DynamicResourceInformer mocks base method.
+func (m *MockClientInterface) DynamicResourceInformer(gvr schema.GroupVersionResource, name string) cache.SharedIndexInformer {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DynamicResourceInformer", gvr, name)
+	ret0, _ := ret[0].(cache.SharedIndexInformer)
+	return ret0
+}
+
+// DynamicResourceInformer indicates an expected call of DynamicResourceInformer.
+func (mr *MockClientInterfaceMockRecorder) DynamicResourceInformer(gvr, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DynamicResourceInformer", reflect.TypeOf((*MockClientInterface)(nil).DynamicResourceInformer), gvr, name)
+}
+
 // ExecCMDInContainer mocks base method.
 func (m *MockClientInterface) ExecCMDInContainer(ctx context.Context, containerName, podName string, cmd []string, stdout, stderr io.Writer, stdin io.Reader, tty bool) error {
 	m.ctrl.T.Helper()
@@ -804,18 +836,18 @@ func (mr *MockClientInterfaceMockRecorder) GetPVCFromName(pvcName interface{}) *
 }
 
 // GetPodLogs mocks base method.
-func (m *MockClientInterface) GetPodLogs(podName, containerName string, followLog bool) (io.ReadCloser, error) {
+func (m *MockClientInterface) GetPodLogs(podName, containerName string, options platform.PodLogsOptions) (io.ReadCloser, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPodLogs", podName, containerName, followLog)
+	ret := m.ctrl.Call(m, "GetPodLogs", podName, containerName, options)
 	ret0, _ := ret[0].(io.ReadCloser)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPodLogs indicates an expected call of GetPodLogs.
-func (mr *MockClientInterfaceMockRecorder) GetPodLogs(podName, containerName, followLog interface{}) *gomock.Call {
+func (mr *MockClientInterfaceMockRecorder) GetPodLogs(podName, containerName, options interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPodLogs", reflect.TypeOf((*MockClientInterface)(nil).GetPodLogs), podName, containerName, followLog)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPodLogs", reflect.TypeOf((*MockClientInterface)(nil).GetPodLogs), podName, containerName, options)
 }
 
 // GetPodUsingComponentName mocks base method.
@@ -1208,6 +1240,21 @@ func (mr *MockClientInterfaceMockRecorder) ListPVCs(selector interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPVCs", reflect.TypeOf((*MockClientInterface)(nil).ListPVCs), selector)
 }
 
+// ListPodEvents mocks base method.
+func (m *MockClientInterface) ListPodEvents(podName string) ([]v12.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPodEvents", podName)
+	ret0, _ := ret[0].([]v12.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPodEvents indicates an expected call of ListPodEvents.
+func (mr *MockClientInterfaceMockRecorder) ListPodEvents(podName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPodEvents", reflect.TypeOf((*MockClientInterface)(nil).ListPodEvents), podName)
+}
+
 // ListProjectNames mocks base method.
 func (m *MockClientInterface) ListProjectNames() ([]string, error) {
 	m.ctrl.T.Helper()
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"math"
 
 	// api resource types
 
@@ -98,18 +99,20 @@ func (c *Client) GetRunningPodFromSelector(selector string) (*corev1.Pod, error)
 }
 
 // GetPodLogs prints the log from pod to stdout
-func (c *Client) GetPodLogs(podName, containerName string, followLog bool) (io.ReadCloser, error) {
+func (c *Client) GetPodLogs(podName, containerName string, options platform.PodLogsOptions) (io.ReadCloser, error) {
 
 	// Set standard log options
-	podLogOptions := corev1.PodLogOptions{Follow: false, Container: containerName}
-
-	// If the log is being followed, set it to follow / don't wait
-	if followLog {
-		podLogOptions = corev1.PodLogOptions{
-			Follow:    true,
-			Previous:  false,
-			Container: containerName,
-		}
+	podLogOptions := corev1.PodLogOptions{
+		Follow:     options.Follow,
+		Previous:   false,
+		Container:  containerName,
+		TailLines:  options.TailLines,
+		Timestamps: options.Timestamps,
+	}
+	if options.Since > 0 {
+		// the API only accepts a number of seconds, round it up to not miss any log
+		sinceSeconds := int64(math.Ceil(options.Since.Seconds()))
+		podLogOptions.SinceSeconds = &sinceSeconds
 	}
 
 	// RESTClient call to kubernetes
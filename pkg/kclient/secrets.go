@@ -128,6 +128,7 @@ func (c *Client) DeleteSecret(secretName, namespace string) error {
 
 // CreateSecret generates and creates the secret
 // commonObjectMeta is the ObjectMeta for the service
+// ownerReference is not added to the secret if empty
 func (c *Client) CreateSecret(objectMeta metav1.ObjectMeta, data map[string]string, ownerReference metav1.OwnerReference) error {
 
 	secret := corev1.Secret{
@@ -135,7 +136,9 @@ func (c *Client) CreateSecret(objectMeta metav1.ObjectMeta, data map[string]stri
 		Type:       corev1.SecretTypeOpaque,
 		StringData: data,
 	}
-	secret.SetOwnerReferences(append(secret.GetOwnerReferences(), ownerReference))
+	if ownerReference.UID != "" {
+		secret.SetOwnerReferences(append(secret.GetOwnerReferences(), ownerReference))
+	}
 	_, err := c.KubeClient.CoreV1().Secrets(c.Namespace).Create(context.TODO(), &secret, metav1.CreateOptions{FieldManager: FieldManager})
 	if err != nil {
 		return fmt.Errorf("unable to create secret for %s: %w", objectMeta.Name, err)
@@ -25,6 +25,9 @@ const (
 	// odoModeLabel indicates which command were used to create the component, either dev or deploy
 	odoModeLabel = "odo.dev/mode"
 
+	// odoDeployRevisionLabel indicates the revision of a deployment recorded by the deploy command
+	odoDeployRevisionLabel = "odo.dev/deploy-revision"
+
 	// odoProjectTypeAnnotation indicates the project type of the component
 	odoProjectTypeAnnotation = "odo.dev/project-type"
 
@@ -178,6 +178,14 @@ func GetMode(labels map[string]string) string {
 	return labels[odoModeLabel]
 }
 
+func GetDeployRevision(labels map[string]string) string {
+	return labels[odoDeployRevisionLabel]
+}
+
+func SetDeployRevision(labels map[string]string, revision string) {
+	labels[odoDeployRevisionLabel] = revision
+}
+
 // IsProjectTypeSetInAnnotations checks if the ProjectType annotation is set;
 // this function is helpful in identifying if a resource is created by odo
 func IsProjectTypeSetInAnnotations(annotations map[string]string) bool {
@@ -215,6 +223,11 @@ func GetSelector(componentName string, applicationName string, mode string, isPa
 	return labels.String()
 }
 
+// GetDeployRevisionSelector returns a selector string used for selection of the revisions recorded by the deploy command for the given component
+func GetDeployRevisionSelector(componentName string, applicationName string) string {
+	return GetSelector(componentName, applicationName, ComponentDeployMode, false) + "," + odoDeployRevisionLabel
+}
+
 func GetNameSelector(componentName string) string {
 	labels := k8slabels.Set{
 		kubernetesInstanceLabel: componentName,
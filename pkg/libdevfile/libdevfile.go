@@ -2,6 +2,9 @@ package libdevfile
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"regexp"
@@ -553,6 +556,19 @@ func substituteVariables(devfileVars map[string]string, val string) (string, err
 	return val, nil
 }
 
+// GetDevfileHash returns a hash of the content of the Devfile, which can be used to detect changes in the Devfile
+func GetDevfileHash(devfileObj parser.DevfileObj) (string, error) {
+	if devfileObj.Data == nil {
+		return "", nil
+	}
+	content, err := json.Marshal(devfileObj.Data)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:]), nil
+}
+
 // findComponentByNameAndType returns the Devfile component that matches the specified name and type.
 func findComponentByNameAndType(d parser.DevfileObj, n string, t v1alpha2.ComponentType) (v1alpha2.Component, bool, error) {
 	comps, err := d.Data.GetComponents(common.DevfileOptions{ComponentOptions: common.ComponentOptions{ComponentType: t}})
@@ -132,6 +132,23 @@ func (s *Status) Start(status string, debug bool) {
 	}
 }
 
+// UpdateStatus changes the status of the current phase, without ending it.
+// When not attached to a terminal, the new status is displayed on a new line
+func (s *Status) UpdateStatus(status string) {
+	if s.status == "" || s.status == status {
+		return
+	}
+	s.status = status
+	if IsJSON() {
+		return
+	}
+	if !IsTerminal(s.writer) {
+		fmt.Fprintf(s.writer, prefixSpacing+getSpacingString()+suffixSpacing+"%s  ...\n", s.status)
+		return
+	}
+	s.updateStatus()
+}
+
 // truncateSuffixIfNeeded returns a representation of the 'suffix' parameter that fits within the terminal
 // (including the extra space occupied by the padding parameter).
 func truncateSuffixIfNeeded(suffix string, w io.Writer, padding int) string {
@@ -6,12 +6,23 @@ import (
 )
 
 type Client interface {
+	// DisplayLogs displays the logs of the containers for the specified mode (Dev, Deploy or both) of the provided
+	// component name and namespace, filtered and formatted depending on options.
 	DisplayLogs(
 		ctx context.Context,
 		mode string,
 		componentName string,
 		namespace string,
-		follow bool,
+		options Options,
+		out io.Writer,
+	) error
+
+	// DisplaySessionLogs displays the logs recorded by the session of the component in the working directory,
+	// filtered and formatted depending on options. options.Follow and options.Recorder are not used.
+	DisplaySessionLogs(
+		ctx context.Context,
+		session string,
+		options Options,
 		out io.Writer,
 	) error
 
@@ -21,12 +32,12 @@ type Client interface {
 	// have been fetched.
 	// The accepted values for mode are ComponentDevMode, ComponentDeployMode and ComponentAnyMode
 	// found in the pkg/labels package.
-	// Setting follow boolean to true helps follow/tail the logs of the pods.
+	// Setting options.Follow to true helps follow/tail the logs of the pods; options.Grep and options.JSON are not used.
 	GetLogsForMode(
 		ctx context.Context,
 		mode string,
 		componentName string,
 		namespace string,
-		follow bool,
+		options Options,
 	) (Events, error)
 }
@@ -3,31 +3,41 @@ package logs
 import (
 	"bufio"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/klog"
 
 	"github.com/fatih/color"
+	"github.com/redhat-developer/odo/pkg/api"
 	odolabels "github.com/redhat-developer/odo/pkg/labels"
 	"github.com/redhat-developer/odo/pkg/log"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
 	"github.com/redhat-developer/odo/pkg/platform"
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
 )
 
 type LogsClient struct {
 	platformClient platform.Client
+	fs             filesystem.Filesystem
 }
 
 type ContainerLogs struct {
 	PodName       string
 	ContainerName string
-	Logs          io.ReadCloser
+	// Mode is the running mode of the pod, either Dev or Deploy
+	Mode string
+	// Timestamps indicates that each line of Logs is prefixed by its timestamp
+	Timestamps bool
+	Logs       io.ReadCloser
 }
 
 type Events struct {
@@ -41,9 +51,10 @@ type Events struct {
 
 var _ Client = (*LogsClient)(nil)
 
-func NewLogsClient(platformClient platform.Client) *LogsClient {
+func NewLogsClient(platformClient platform.Client, fs filesystem.Filesystem) *LogsClient {
 	return &LogsClient{
 		platformClient: platformClient,
+		fs:             fs,
 	}
 }
 
@@ -54,7 +65,7 @@ func (o *LogsClient) DisplayLogs(
 	mode string,
 	componentName string,
 	namespace string,
-	follow bool,
+	options Options,
 	out io.Writer,
 ) error {
 	events, err := o.GetLogsForMode(
@@ -62,12 +73,17 @@ func (o *LogsClient) DisplayLogs(
 		mode,
 		componentName,
 		namespace,
-		follow,
+		options,
 	)
 	if err != nil {
 		return err
 	}
 
+	if options.Lines != nil {
+		options.linesQueue = newLineQueue()
+		go options.linesQueue.forward(ctx, options.Lines)
+	}
+
 	uniqueContainerNames := map[string]struct{}{}
 	var goroutines struct{ count int64 } // keep a track of running goroutines so that we don't exit prematurely
 	errChan := make(chan error)          // errors are put on this channel
@@ -86,9 +102,11 @@ func (o *LogsClient) DisplayLogs(
 			uniqueName := getUniqueContainerName(containerLogs.ContainerName, uniqueContainerNames)
 			uniqueContainerNames[uniqueName] = struct{}{}
 			colour := log.ColorPicker()
-			logs := containerLogs.Logs
 
 			func() {
+				if options.JSON {
+					return
+				}
 				mu.Lock()
 				defer mu.Unlock()
 				color.Set(colour)
@@ -103,13 +121,13 @@ func (o *LogsClient) DisplayLogs(
 				}
 			}()
 
-			if follow {
+			if options.Follow {
 				atomic.AddInt64(&goroutines.count, 1)
 				go func(out io.Writer) {
 					defer func() {
 						atomic.AddInt64(&goroutines.count, -1)
 					}()
-					err = printLogs(uniqueName, logs, out, colour, &mu)
+					err = printLogs(containerLogs, uniqueName, options, out, colour, &mu)
 					if err != nil {
 						errChan <- err
 					}
@@ -117,7 +135,7 @@ func (o *LogsClient) DisplayLogs(
 					events.Done <- struct{}{}
 				}(out)
 			} else {
-				err = printLogs(uniqueName, logs, out, colour, &mu)
+				err = printLogs(containerLogs, uniqueName, options, out, colour, &mu)
 				if err != nil {
 					return err
 				}
@@ -127,13 +145,18 @@ func (o *LogsClient) DisplayLogs(
 		case err = <-events.Err:
 			return err
 		case <-events.Done:
-			if !follow && goroutines.count == 0 {
-				if len(uniqueContainerNames) == 0 {
+			if !options.Follow && goroutines.count == 0 {
+				if len(uniqueContainerNames) == 0 && !options.JSON {
 					// This will be the case when:
 					// 1. user specifies --dev flag, but the component's running in Deploy mode
 					// 2. user specified --deploy flag, but the component's running in Dev mode
 					// 3. user passes no flag, but component is running in neither Dev nor Deploy mode
-					fmt.Fprintf(out, "no containers running in the specified mode for the component %q\n", componentName)
+					// 4. user specifies --container flag, but no container with this name is running
+					if options.Container != "" {
+						fmt.Fprintf(out, "no container %q running in the specified mode for the component %q\n", options.Container, componentName)
+					} else {
+						fmt.Fprintf(out, "no containers running in the specified mode for the component %q\n", componentName)
+					}
 				}
 				return nil
 			}
@@ -143,6 +166,55 @@ func (o *LogsClient) DisplayLogs(
 	}
 }
 
+// lineQueue queues the lines of logs to be sent to a channel, so that no line is dropped,
+// and the display of the logs is not blocked, when the receiver of the channel is busy
+type lineQueue struct {
+	mu    sync.Mutex
+	lines []api.ContainerLogLine
+	// pushed receives a value when lines are pushed to the queue
+	pushed chan struct{}
+}
+
+func newLineQueue() *lineQueue {
+	return &lineQueue{
+		pushed: make(chan struct{}, 1),
+	}
+}
+
+// push adds the line to the queue, without blocking
+func (o *lineQueue) push(line api.ContainerLogLine) {
+	o.mu.Lock()
+	o.lines = append(o.lines, line)
+	o.mu.Unlock()
+	select {
+	case o.pushed <- struct{}{}:
+	default:
+		// the forwarder has already been notified
+	}
+}
+
+// forward sends the queued lines to out, in order, until ctx is cancelled
+func (o *lineQueue) forward(ctx context.Context, out chan<- api.ContainerLogLine) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-o.pushed:
+		}
+		o.mu.Lock()
+		lines := o.lines
+		o.lines = nil
+		o.mu.Unlock()
+		for _, line := range lines {
+			select {
+			case <-ctx.Done():
+				return
+			case out <- line:
+			}
+		}
+	}
+}
+
 func getUniqueContainerName(name string, uniqueNames map[string]struct{}) string {
 	if _, ok := uniqueNames[name]; ok {
 		// name already present in uniqueNames; find another name
@@ -168,19 +240,55 @@ func getUniqueContainerName(name string, uniqueNames map[string]struct{}) string
 	return name
 }
 
-// printLogs prints the logs of the containers with container name prefixed to the log message
-func printLogs(containerName string, rd io.ReadCloser, out io.Writer, colour color.Attribute, mu *sync.Mutex) error {
-	scanner := bufio.NewScanner(rd)
+// printLogs prints the logs of the container, filtered and formatted depending on options,
+// after recording them with options.Recorder, if any, and queuing them to be sent to options.Lines.
+// In text format, the container name is prefixed to each log message;
+// in JSON format, each line is printed as a JSON object.
+func printLogs(containerLogs ContainerLogs, containerName string, options Options, out io.Writer, colour color.Attribute, mu *sync.Mutex) error {
+	scanner := bufio.NewScanner(containerLogs.Logs)
 	scanner.Split(bufio.ScanLines)
 
 	for scanner.Scan() {
-		line := scanner.Text()
+		if options.Recorder != nil {
+			err := options.Recorder.RecordLine(containerLogs.ContainerName, scanner.Text())
+			if err != nil {
+				klog.V(2).Infof("unable to record logs of container %q: %v", containerLogs.ContainerName, err)
+			}
+		}
+		timestamp, message := parseLogLine(scanner.Text(), containerLogs.Timestamps)
+		if options.linesQueue != nil {
+			options.linesQueue.push(api.ContainerLogLine{
+				Pod:       containerLogs.PodName,
+				Container: containerLogs.ContainerName,
+				Mode:      containerLogs.Mode,
+				Timestamp: timestamp,
+				Message:   message,
+			})
+		}
+		if options.Grep != nil && !options.Grep.MatchString(message) {
+			continue
+		}
 		err := func() error {
 			mu.Lock()
 			defer mu.Unlock()
+
+			if options.JSON {
+				return json.NewEncoder(out).Encode(api.ContainerLogLine{
+					Pod:       containerLogs.PodName,
+					Container: containerLogs.ContainerName,
+					Mode:      containerLogs.Mode,
+					Timestamp: timestamp,
+					Message:   message,
+				})
+			}
+
 			color.Set(colour)
 			defer color.Unset()
 
+			line := message
+			if options.Timestamps && timestamp != "" {
+				line = timestamp + " " + message
+			}
 			_, err := fmt.Fprintln(out, containerName+": "+line)
 			return err
 		}()
@@ -192,12 +300,25 @@ func printLogs(containerName string, rd io.ReadCloser, out io.Writer, colour col
 	return nil
 }
 
+// parseLogLine separates the timestamp prefixed to the line by the platform from the log message.
+// The line is returned as message if withTimestamp is false or the line does not start with a timestamp.
+func parseLogLine(line string, withTimestamp bool) (timestamp string, message string) {
+	if !withTimestamp {
+		return "", line
+	}
+	timestamp, message, _ = strings.Cut(line, " ")
+	if _, err := time.Parse(time.RFC3339Nano, timestamp); err != nil {
+		return "", line
+	}
+	return timestamp, message
+}
+
 func (o *LogsClient) GetLogsForMode(
 	ctx context.Context,
 	mode string,
 	componentName string,
 	namespace string,
-	follow bool,
+	options Options,
 ) (Events, error) {
 	events := Events{
 		Logs: make(chan ContainerLogs),
@@ -205,7 +326,7 @@ func (o *LogsClient) GetLogsForMode(
 		Done: make(chan struct{}),
 	}
 
-	go o.getLogsForMode(ctx, events, mode, componentName, namespace, follow)
+	go o.getLogsForMode(ctx, events, mode, componentName, namespace, options)
 	return events, nil
 }
 
@@ -215,7 +336,7 @@ func (o *LogsClient) getLogsForMode(
 	mode string,
 	componentName string,
 	namespace string,
-	follow bool,
+	options Options,
 ) {
 	var selector string
 	podChan := make(chan corev1.Pod) // grab the logs of the pod put on this channel
@@ -228,13 +349,19 @@ func (o *LogsClient) getLogsForMode(
 			select {
 			case pod := <-podChan:
 				for _, container := range pod.Spec.Containers {
-					containerLogs, err := o.platformClient.GetPodLogs(pod.Name, container.Name, follow)
+					if options.Container != "" && container.Name != options.Container {
+						continue
+					}
+					podLogsOptions := options.podLogsOptions()
+					containerLogs, err := o.platformClient.GetPodLogs(pod.Name, container.Name, podLogsOptions)
 					if err != nil {
 						events.Err <- fmt.Errorf("failed to get logs for container %s; error: %v", container.Name, err)
 					}
 					events.Logs <- ContainerLogs{
 						PodName:       pod.GetName(),
 						ContainerName: container.Name,
+						Mode:          odolabels.GetMode(pod.GetLabels()),
+						Timestamps:    podLogsOptions.Timestamps,
 						Logs:          containerLogs,
 					}
 				}
@@ -271,7 +398,7 @@ func (o *LogsClient) getLogsForMode(
 		errChan <- err
 	}
 
-	if follow {
+	if options.Follow {
 		podWatcher, err := o.platformClient.PodWatcher(ctx, "")
 		if err != nil {
 			errChan <- err
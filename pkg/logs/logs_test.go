@@ -0,0 +1,230 @@
+package logs
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/platform"
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+)
+
+func Test_parseLogLine(t *testing.T) {
+	tests := []struct {
+		name          string
+		line          string
+		withTimestamp bool
+		wantTimestamp string
+		wantMessage   string
+	}{
+		{
+			name:        "without timestamp",
+			line:        "2023-04-01T10:00:00.123456789Z App started",
+			wantMessage: "2023-04-01T10:00:00.123456789Z App started",
+		},
+		{
+			name:          "with UTC timestamp",
+			line:          "2023-04-01T10:00:00.123456789Z App started",
+			withTimestamp: true,
+			wantTimestamp: "2023-04-01T10:00:00.123456789Z",
+			wantMessage:   "App started",
+		},
+		{
+			name:          "with timestamp with time zone",
+			line:          "2023-04-01T10:00:00.123456789+02:00 App started",
+			withTimestamp: true,
+			wantTimestamp: "2023-04-01T10:00:00.123456789+02:00",
+			wantMessage:   "App started",
+		},
+		{
+			name:          "with timestamp and empty message",
+			line:          "2023-04-01T10:00:00Z",
+			withTimestamp: true,
+			wantTimestamp: "2023-04-01T10:00:00Z",
+		},
+		{
+			name:          "not starting with a timestamp",
+			line:          "App started",
+			withTimestamp: true,
+			wantMessage:   "App started",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotTimestamp, gotMessage := parseLogLine(tt.line, tt.withTimestamp)
+			if gotTimestamp != tt.wantTimestamp {
+				t.Errorf("parseLogLine() timestamp = %q, want %q", gotTimestamp, tt.wantTimestamp)
+			}
+			if gotMessage != tt.wantMessage {
+				t.Errorf("parseLogLine() message = %q, want %q", gotMessage, tt.wantMessage)
+			}
+		})
+	}
+}
+
+func Test_printLogs(t *testing.T) {
+	const logsWithTimestamps = `2023-04-01T10:00:00Z npm start
+2023-04-01T10:00:01Z App started on port 3000
+2023-04-01T10:00:02Z ERROR: connection refused
+`
+	const logsWithoutTimestamps = `npm start
+App started on port 3000
+ERROR: connection refused
+`
+	tests := []struct {
+		name    string
+		logs    string
+		options Options
+		want    string
+	}{
+		{
+			name: "all lines",
+			logs: logsWithoutTimestamps,
+			want: `runtime: npm start
+runtime: App started on port 3000
+runtime: ERROR: connection refused
+`,
+		},
+		{
+			name:    "with timestamps",
+			logs:    logsWithTimestamps,
+			options: Options{Timestamps: true},
+			want: `runtime: 2023-04-01T10:00:00Z npm start
+runtime: 2023-04-01T10:00:01Z App started on port 3000
+runtime: 2023-04-01T10:00:02Z ERROR: connection refused
+`,
+		},
+		{
+			name:    "matching the regular expression",
+			logs:    logsWithoutTimestamps,
+			options: Options{Grep: regexp.MustCompile("(?i)error|start$")},
+			want: `runtime: npm start
+runtime: ERROR: connection refused
+`,
+		},
+		{
+			name:    "regular expression not matching the timestamp",
+			logs:    logsWithTimestamps,
+			options: Options{Timestamps: true, Grep: regexp.MustCompile("^ERROR")},
+			want: `runtime: 2023-04-01T10:00:02Z ERROR: connection refused
+`,
+		},
+		{
+			name:    "JSON",
+			logs:    logsWithTimestamps,
+			options: Options{JSON: true, Grep: regexp.MustCompile("port")},
+			want: `{"pod":"mycmp-app","container":"runtime","mode":"Dev","timestamp":"2023-04-01T10:00:01Z","message":"App started on port 3000"}
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			containerLogs := ContainerLogs{
+				PodName:       "mycmp-app",
+				ContainerName: "runtime",
+				Mode:          odolabels.ComponentDevMode,
+				Timestamps:    tt.options.podLogsOptions().Timestamps,
+				Logs:          io.NopCloser(strings.NewReader(tt.logs)),
+			}
+			err := printLogs(containerLogs, "runtime", tt.options, &out, color.FgGreen, &sync.Mutex{})
+			if err != nil {
+				t.Fatalf("printLogs() unexpected error: %v", err)
+			}
+			if got := out.String(); got != tt.want {
+				t.Errorf("printLogs() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLogsClient_DisplayLogs(t *testing.T) {
+	tail := int64(1)
+	pod := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "mycmp-app",
+			Labels: map[string]string{"odo.dev/mode": odolabels.ComponentDevMode},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "runtime"}, {Name: "tools"}},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+
+	ctrl := gomock.NewController(t)
+	platformClient := platform.NewMockClient(ctrl)
+	platformClient.EXPECT().GetPodsMatchingSelector(gomock.Any()).Return(&corev1.PodList{Items: []corev1.Pod{pod}}, nil)
+	platformClient.EXPECT().GetAllPodsInNamespaceMatchingSelector(gomock.Any(), "ns").Return(&corev1.PodList{}, nil)
+	// only the logs of the runtime container are requested, with the timestamps for the JSON output
+	platformClient.EXPECT().GetPodLogs("mycmp-app", "runtime", platform.PodLogsOptions{TailLines: &tail, Timestamps: true}).
+		Return(io.NopCloser(strings.NewReader("2023-04-01T10:00:01Z App started\n")), nil)
+
+	ctx := odocontext.WithApplication(context.Background(), "app")
+	var out bytes.Buffer
+	err := NewLogsClient(platformClient, filesystem.NewFakeFs()).DisplayLogs(ctx, odolabels.ComponentDevMode, "mycmp", "ns", Options{
+		Tail:      &tail,
+		Container: "runtime",
+		JSON:      true,
+	}, &out)
+	if err != nil {
+		t.Fatalf("DisplayLogs() unexpected error: %v", err)
+	}
+	want := `{"pod":"mycmp-app","container":"runtime","mode":"Dev","timestamp":"2023-04-01T10:00:01Z","message":"App started"}
+`
+	if got := out.String(); got != want {
+		t.Errorf("DisplayLogs() = %q, want %q", got, want)
+	}
+}
+
+func Test_printLogs_lines(t *testing.T) {
+	const logs = "2023-04-01T10:00:00Z npm start\n2023-04-01T10:00:01Z listening on port 3000\n2023-04-01T10:00:02Z ready\n"
+	containerLogs := ContainerLogs{
+		PodName:       "mycmp-app",
+		ContainerName: "runtime",
+		Mode:          "Dev",
+		Timestamps:    true,
+		Logs:          io.NopCloser(strings.NewReader(logs)),
+	}
+	// the channel can hold only 2 lines, the next ones wait in the queue
+	lines := make(chan api.ContainerLogLine, 2)
+	queue := newLineQueue()
+	options := Options{Grep: regexp.MustCompile("ready"), Lines: lines, linesQueue: queue}
+	err := printLogs(containerLogs, "runtime", options, io.Discard, color.FgGreen, &sync.Mutex{})
+	if err != nil {
+		t.Fatalf("printLogs() unexpected error: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go queue.forward(ctx, lines)
+	var got []api.ContainerLogLine
+	for len(got) < 3 {
+		select {
+		case line := <-lines:
+			got = append(got, line)
+		case <-time.After(time.Second):
+			t.Fatalf("timeout waiting for the lines, got %v", got)
+		}
+	}
+	want := []api.ContainerLogLine{
+		{Pod: "mycmp-app", Container: "runtime", Mode: "Dev", Timestamp: "2023-04-01T10:00:00Z", Message: "npm start"},
+		{Pod: "mycmp-app", Container: "runtime", Mode: "Dev", Timestamp: "2023-04-01T10:00:01Z", Message: "listening on port 3000"},
+		{Pod: "mycmp-app", Container: "runtime", Mode: "Dev", Timestamp: "2023-04-01T10:00:02Z", Message: "ready"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("printLogs() lines mismatch (-want +got):\n%s", diff)
+	}
+}
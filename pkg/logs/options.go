@@ -0,0 +1,47 @@
+package logs
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/platform"
+)
+
+// Options are the options to get and display the logs of the containers of a component
+type Options struct {
+	// Follow streams the logs as they are written
+	Follow bool
+	// Since displays only the logs more recent than this duration, or all the logs if zero
+	Since time.Duration
+	// Tail displays only this number of lines from the end of the logs of each container, or all the lines if nil.
+	// The lines are selected before being filtered by Grep.
+	Tail *int64
+	// Timestamps displays the timestamp of each line
+	Timestamps bool
+	// Container displays only the logs of the container with this name, or of all the containers if empty
+	Container string
+	// Grep displays only the lines whose message matches this regular expression, or all the lines if nil
+	Grep *regexp.Regexp
+	// JSON displays each line as a JSON object, on a single line
+	JSON bool
+	// Recorder records all the lines of logs, before they are filtered by Grep, if not nil
+	Recorder *Recorder
+	// Lines, if not nil, receives all the lines of logs, before they are filtered by Grep.
+	// The lines are queued when the channel is full, so the display of the logs is never blocked, and no line is dropped.
+	Lines chan<- api.ContainerLogLine
+
+	// linesQueue queues the lines sent to Lines
+	linesQueue *lineQueue
+}
+
+// podLogsOptions returns the options to get the logs of a container from the platform.
+// The timestamps are always requested for the JSON output, for recording the logs, and for the lines sent to Lines.
+func (o Options) podLogsOptions() platform.PodLogsOptions {
+	return platform.PodLogsOptions{
+		Follow:     o.Follow,
+		Since:      o.Since,
+		TailLines:  o.Tail,
+		Timestamps: o.Timestamps || o.JSON || o.Recorder != nil || o.Lines != nil,
+	}
+}
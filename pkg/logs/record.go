@@ -0,0 +1,229 @@
+package logs
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+const (
+	// DefaultMaxLogFileSize is the size, in bytes, above which the log file of a container is rotated
+	DefaultMaxLogFileSize = 10 * 1024 * 1024
+	// DefaultMaxLogFiles is the number of rotated log files retained for each container, in addition to the current one
+	DefaultMaxLogFiles = 5
+
+	// logsDirectory is the directory, in the .odo directory, containing a directory per recorded session
+	logsDirectory = "logs"
+	// DefaultMaxSessions is the number of sessions whose logs are retained, 0 meaning all the sessions are retained
+	DefaultMaxSessions = 10
+
+	// commandsStream is the name of the stream recording the output of the Devfile commands, in place of a container name.
+	// It cannot conflict with the name of a container, which cannot contain dots.
+	commandsStream = "odo.commands"
+
+	// logFileExtension is the extension of the log file of a container, rotated files being suffixed with .1, .2, etc
+	logFileExtension = ".log"
+)
+
+// GetSessionsDirectory returns the directory containing the logs recorded by the sessions of the component in workingDir
+func GetSessionsDirectory(workingDir string) string {
+	return filepath.Join(workingDir, util.DotOdoDirectory, logsDirectory)
+}
+
+// NewSessionID returns the identifier of a session started at the given time by the process pid.
+// The identifiers of the sessions are sorted by start time.
+func NewSessionID(pid int, startTime time.Time) string {
+	return fmt.Sprintf("%s-%d", startTime.Format("20060102-150405"), pid)
+}
+
+// Recorder records the lines of logs of the containers into the directory of a session,
+// in a file per container, rotated when its size exceeds a maximum size
+type Recorder struct {
+	fs       filesystem.Filesystem
+	dir      string
+	maxSize  int64
+	maxFiles int
+
+	// mu protects files and closed
+	mu     sync.Mutex
+	files  map[string]*rotatingFile
+	closed bool
+}
+
+// NewRecorder returns a Recorder writing the log files into dir, which is created on the first recorded line.
+// A log file is rotated when its size exceeds maxSize bytes, and maxFiles rotated files are retained.
+func NewRecorder(fs filesystem.Filesystem, dir string, maxSize int64, maxFiles int) *Recorder {
+	return &Recorder{
+		fs:       fs,
+		dir:      dir,
+		maxSize:  maxSize,
+		maxFiles: maxFiles,
+		files:    make(map[string]*rotatingFile),
+	}
+}
+
+// Dir returns the directory into which the log files are written
+func (o *Recorder) Dir() string {
+	return o.dir
+}
+
+// RecordLine appends the line to the log file of the container.
+// The lines recorded after the recorder is closed are ignored.
+func (o *Recorder) RecordLine(containerName string, line string) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.closed {
+		return nil
+	}
+	file, found := o.files[containerName]
+	if !found {
+		file = &rotatingFile{
+			fs:       o.fs,
+			path:     filepath.Join(o.dir, containerName+logFileExtension),
+			maxSize:  o.maxSize,
+			maxFiles: o.maxFiles,
+		}
+		o.files[containerName] = file
+	}
+	return file.write([]byte(line + "\n"))
+}
+
+// RecordCommandOutput appends the lines output by the Devfile command commandID in the container containerName
+// to the log file of the commands, prefixed with the current time and the names of the container and of the command,
+// followed by a line reporting err, if not nil
+func (o *Recorder) RecordCommandOutput(commandID string, containerName string, lines []string, err error) {
+	now := time.Now().Format(time.RFC3339Nano)
+	prefix := fmt.Sprintf("%s [%s/%s] ", now, containerName, commandID)
+	if err != nil {
+		// the error may contain the output of the command, which is already recorded
+		cause := err
+		for errors.Unwrap(cause) != nil {
+			cause = errors.Unwrap(cause)
+		}
+		lines = append(lines[:len(lines):len(lines)], fmt.Sprintf("the command failed: %v", cause))
+	}
+	for _, line := range lines {
+		if recordErr := o.RecordLine(commandsStream, prefix+line); recordErr != nil {
+			klog.V(4).Infof("unable to record the output of the command %q: %v", commandID, recordErr)
+			return
+		}
+	}
+}
+
+// Close closes the log files
+func (o *Recorder) Close() error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.closed = true
+	var firstErr error
+	for _, file := range o.files {
+		if err := file.close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// rotatingFile is a file rotated when its size exceeds maxSize:
+// the file is renamed with the .1 suffix, the previous .1 file is renamed with the .2 suffix, and so on,
+// up to maxFiles rotated files
+type rotatingFile struct {
+	fs       filesystem.Filesystem
+	path     string
+	maxSize  int64
+	maxFiles int
+
+	file filesystem.File
+	size int64
+}
+
+func (o *rotatingFile) write(p []byte) error {
+	if o.file == nil {
+		if err := o.open(); err != nil {
+			return err
+		}
+	}
+	if o.size > 0 && o.size+int64(len(p)) > o.maxSize {
+		if err := o.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := o.file.Write(p)
+	o.size += int64(n)
+	return err
+}
+
+// open opens the file in append mode, creating it and its directory if necessary
+func (o *rotatingFile) open() error {
+	err := o.fs.MkdirAll(filepath.Dir(o.path), 0750)
+	if err != nil {
+		return err
+	}
+	o.file, err = o.fs.OpenFile(o.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0640)
+	if err != nil {
+		return err
+	}
+	info, err := o.fs.Stat(o.path)
+	if err != nil {
+		return err
+	}
+	o.size = info.Size()
+	return nil
+}
+
+func (o *rotatingFile) rotate() error {
+	err := o.close()
+	if err != nil {
+		return err
+	}
+	if o.maxFiles == 0 {
+		err = o.fs.Remove(o.path)
+		if err != nil {
+			return err
+		}
+		return o.open()
+	}
+	err = o.fs.Remove(rotatedFilename(o.path, o.maxFiles))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	for i := o.maxFiles - 1; i >= 1; i-- {
+		err = o.fs.Rename(rotatedFilename(o.path, i), rotatedFilename(o.path, i+1))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+	err = o.fs.Rename(o.path, rotatedFilename(o.path, 1))
+	if err != nil {
+		return err
+	}
+	return o.open()
+}
+
+func (o *rotatingFile) close() error {
+	if o.file == nil {
+		return nil
+	}
+	err := o.file.Close()
+	o.file = nil
+	return err
+}
+
+// rotatedFilename returns the name of the i-th rotated file, the current file being the 0-th one
+func rotatedFilename(path string, i int) string {
+	if i == 0 {
+		return path
+	}
+	return fmt.Sprintf("%s.%d", path, i)
+}
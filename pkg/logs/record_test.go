@@ -0,0 +1,165 @@
+package logs
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/fatih/color"
+
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+)
+
+func TestNewSessionID(t *testing.T) {
+	got := NewSessionID(12345, time.Date(2023, 10, 18, 15, 30, 0, 0, time.UTC))
+	if want := "20231018-153000-12345"; got != want {
+		t.Errorf("NewSessionID() = %q, want %q", got, want)
+	}
+}
+
+func TestRecorder_RecordLine(t *testing.T) {
+	dir := filepath.Join("/tmp/cmp", ".odo", "logs", "session")
+	tests := []struct {
+		name     string
+		maxFiles int
+		lines    []string
+		want     map[string]string
+	}{
+		{
+			name:     "no rotation",
+			maxFiles: 2,
+			lines:    []string{"line1", "line2"},
+			want: map[string]string{
+				"runtime.log": "line1\nline2\n",
+			},
+		},
+		{
+			name:     "rotation",
+			maxFiles: 2,
+			lines:    []string{"line1", "line2", "line3", "line4", "line5"},
+			want: map[string]string{
+				"runtime.log":   "line5\n",
+				"runtime.log.1": "line3\nline4\n",
+				"runtime.log.2": "line1\nline2\n",
+			},
+		},
+		{
+			name:     "rotation with oldest file removed",
+			maxFiles: 1,
+			lines:    []string{"line1", "line2", "line3", "line4", "line5"},
+			want: map[string]string{
+				"runtime.log":   "line5\n",
+				"runtime.log.1": "line3\nline4\n",
+			},
+		},
+		{
+			name:     "rotation without retention",
+			maxFiles: 0,
+			lines:    []string{"line1", "line2", "line3"},
+			want: map[string]string{
+				"runtime.log": "line3\n",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := filesystem.NewFakeFs()
+			// each line is 6 bytes long, a file contains at most 2 lines
+			recorder := NewRecorder(fs, dir, 12, tt.maxFiles)
+			for _, line := range tt.lines {
+				if err := recorder.RecordLine("runtime", line); err != nil {
+					t.Fatalf("RecordLine() unexpected error: %v", err)
+				}
+			}
+			if err := recorder.Close(); err != nil {
+				t.Fatalf("Close() unexpected error: %v", err)
+			}
+			// lines recorded after Close are ignored
+			if err := recorder.RecordLine("runtime", "ignored"); err != nil {
+				t.Fatalf("RecordLine() unexpected error: %v", err)
+			}
+
+			entries, err := fs.ReadDir(dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(entries) != len(tt.want) {
+				t.Errorf("got %d files, want %d", len(entries), len(tt.want))
+			}
+			for name, want := range tt.want {
+				got, err := fs.ReadFile(filepath.Join(dir, name))
+				if err != nil {
+					t.Errorf("unable to read file %q: %v", name, err)
+					continue
+				}
+				if string(got) != want {
+					t.Errorf("content of %q = %q, want %q", name, string(got), want)
+				}
+			}
+		})
+	}
+}
+
+func TestRecorder_RecordCommandOutput(t *testing.T) {
+	fs := filesystem.NewFakeFs()
+	recorder := NewRecorder(fs, "/tmp/session", DefaultMaxLogFileSize, DefaultMaxLogFiles)
+	recorder.RecordCommandOutput("build", "runtime", []string{"npm install", "added 1 package"}, nil)
+	recorder.RecordCommandOutput("test", "runtime", []string{"1 failing"}, fmt.Errorf("unable to exec command: %w", errors.New("command terminated with exit code 1")))
+	if err := recorder.Close(); err != nil {
+		t.Fatalf("Close() unexpected error: %v", err)
+	}
+	got, err := fs.ReadFile("/tmp/session/odo.commands.log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := regexp.MustCompile(`^\S+ \[runtime/build\] npm install
+\S+ \[runtime/build\] added 1 package
+\S+ \[runtime/test\] 1 failing
+\S+ \[runtime/test\] the command failed: command terminated with exit code 1
+$`)
+	if !want.Match(got) {
+		t.Errorf("content of odo.commands.log = %q, want to match %q", string(got), want)
+	}
+	for _, line := range strings.Split(strings.TrimSpace(string(got)), "\n") {
+		timestamp, _ := parseLogLine(line, true)
+		if _, err := time.Parse(time.RFC3339Nano, timestamp); err != nil {
+			t.Errorf("line %q does not start with a timestamp: %v", line, err)
+		}
+	}
+}
+
+func Test_printLogs_recorder(t *testing.T) {
+	fs := filesystem.NewFakeFs()
+	recorder := NewRecorder(fs, "/tmp/session", DefaultMaxLogFileSize, DefaultMaxLogFiles)
+	const logs = "2023-04-01T10:00:00Z npm start\n2023-04-01T10:00:01Z ERROR: connection refused\n"
+	containerLogs := ContainerLogs{
+		PodName:       "mycmp-app",
+		ContainerName: "runtime",
+		Timestamps:    true,
+		Logs:          io.NopCloser(strings.NewReader(logs)),
+	}
+	var out bytes.Buffer
+	options := Options{Grep: regexp.MustCompile("ERROR"), Recorder: recorder}
+	err := printLogs(containerLogs, "runtime", options, &out, color.FgGreen, &sync.Mutex{})
+	if err != nil {
+		t.Fatalf("printLogs() unexpected error: %v", err)
+	}
+	if want := "runtime: ERROR: connection refused\n"; out.String() != want {
+		t.Errorf("printLogs() = %q, want %q", out.String(), want)
+	}
+	// all the lines are recorded with their timestamps, before being filtered
+	got, err := fs.ReadFile("/tmp/session/runtime.log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != logs {
+		t.Errorf("recorded logs = %q, want %q", string(got), logs)
+	}
+}
@@ -0,0 +1,189 @@
+package logs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fatih/color"
+
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/log"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+)
+
+// ListSessions returns the identifiers of the sessions which have recorded logs for the component in workingDir,
+// sorted by start time
+func ListSessions(fsys filesystem.Filesystem, workingDir string) ([]string, error) {
+	entries, err := fsys.ReadDir(GetSessionsDirectory(workingDir))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var sessions []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			sessions = append(sessions, entry.Name())
+		}
+	}
+	sort.Strings(sessions)
+	return sessions, nil
+}
+
+// PruneSessions deletes the logs recorded by the oldest sessions of the component in workingDir,
+// so that at most keep sessions are retained
+func PruneSessions(fsys filesystem.Filesystem, workingDir string, keep int) error {
+	sessions, err := ListSessions(fsys, workingDir)
+	if err != nil {
+		return err
+	}
+	if keep < 0 {
+		keep = 0
+	}
+	for len(sessions) > keep {
+		err = fsys.RemoveAll(filepath.Join(GetSessionsDirectory(workingDir), sessions[0]))
+		if err != nil {
+			return err
+		}
+		sessions = sessions[1:]
+	}
+	return nil
+}
+
+func (o *LogsClient) DisplaySessionLogs(
+	ctx context.Context,
+	session string,
+	options Options,
+	out io.Writer,
+) error {
+	workingDir := odocontext.GetWorkingDirectory(ctx)
+	if session == "" || filepath.Base(session) != session {
+		return fmt.Errorf("invalid session %q", session)
+	}
+	dir := filepath.Join(GetSessionsDirectory(workingDir), session)
+	containers, err := o.getRecordedContainers(dir)
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		sessions, listErr := ListSessions(o.fs, workingDir)
+		if listErr != nil {
+			return listErr
+		}
+		if len(sessions) == 0 {
+			return fmt.Errorf("no logs recorded for session %q; run 'odo dev --record-logs' to record the logs of a session", session)
+		}
+		return fmt.Errorf("no logs recorded for session %q; available sessions: %s", session, strings.Join(sessions, ", "))
+	}
+
+	var mu sync.Mutex
+	now := time.Now()
+	displayed := false
+	for _, container := range containers {
+		if options.Container != "" && container != options.Container {
+			continue
+		}
+		displayed = true
+		lines, err := o.readRecordedLines(dir, container, options, now)
+		if err != nil {
+			return err
+		}
+		colour := log.ColorPicker()
+		if !options.JSON {
+			func() {
+				color.Set(colour)
+				defer color.Unset()
+				fmt.Fprintf(out, "--> Logs for session %s / %s\n", session, container)
+			}()
+		}
+		containerLogs := ContainerLogs{
+			ContainerName: container,
+			Mode:          odolabels.ComponentDevMode,
+			Timestamps:    true,
+			Logs:          io.NopCloser(strings.NewReader(strings.Join(lines, "\n"))),
+		}
+		err = printLogs(containerLogs, container, options, out, colour, &mu)
+		if err != nil {
+			return err
+		}
+	}
+	if !displayed && !options.JSON {
+		fmt.Fprintf(out, "no logs recorded for the container %q in session %q\n", options.Container, session)
+	}
+	return nil
+}
+
+// getRecordedContainers returns the names of the containers having a log file in the directory of a session, sorted
+func (o *LogsClient) getRecordedContainers(dir string) ([]string, error) {
+	entries, err := o.fs.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var containers []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), logFileExtension) {
+			containers = append(containers, strings.TrimSuffix(entry.Name(), logFileExtension))
+		}
+	}
+	sort.Strings(containers)
+	return containers, nil
+}
+
+// readRecordedLines returns the lines recorded for the container, from the oldest rotated file to the current file,
+// filtered by the Since and Tail options
+func (o *LogsClient) readRecordedLines(dir string, container string, options Options, now time.Time) ([]string, error) {
+	path := filepath.Join(dir, container+logFileExtension)
+	entries, err := o.fs.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	// indexes of the rotated files, the current file having index 0
+	indexes := []int{0}
+	prefix := filepath.Base(path) + "."
+	for _, entry := range entries {
+		if !strings.HasPrefix(entry.Name(), prefix) {
+			continue
+		}
+		i, err := strconv.Atoi(strings.TrimPrefix(entry.Name(), prefix))
+		if err != nil || i <= 0 {
+			continue
+		}
+		indexes = append(indexes, i)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(indexes)))
+
+	var lines []string
+	for _, i := range indexes {
+		content, err := o.fs.ReadFile(rotatedFilename(path, i))
+		if err != nil {
+			return nil, err
+		}
+		for _, line := range strings.Split(string(content), "\n") {
+			if line == "" {
+				continue
+			}
+			if options.Since > 0 {
+				timestamp, _ := parseLogLine(line, true)
+				if t, err := time.Parse(time.RFC3339Nano, timestamp); err == nil && t.Before(now.Add(-options.Since)) {
+					continue
+				}
+			}
+			lines = append(lines, line)
+		}
+	}
+	if options.Tail != nil && int64(len(lines)) > *options.Tail {
+		lines = lines[int64(len(lines))-*options.Tail:]
+	}
+	return lines, nil
+}
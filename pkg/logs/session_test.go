@@ -0,0 +1,154 @@
+package logs
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+)
+
+func TestPruneSessions(t *testing.T) {
+	const workingDir = "/tmp/cmp"
+	sessions := []string{"20231018-153000-12345", "20231018-160000-23456", "20231019-090000-34567"}
+	tests := []struct {
+		name string
+		keep int
+		want []string
+	}{
+		{
+			name: "oldest sessions deleted",
+			keep: 1,
+			want: []string{"20231019-090000-34567"},
+		},
+		{
+			name: "fewer sessions than retained",
+			keep: 5,
+			want: sessions,
+		},
+		{
+			name: "all sessions deleted",
+			keep: 0,
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := filesystem.NewFakeFs()
+			for _, session := range sessions {
+				dir := filepath.Join(GetSessionsDirectory(workingDir), session)
+				_ = fs.MkdirAll(dir, 0750)
+				_ = fs.WriteFile(filepath.Join(dir, "runtime.log"), []byte("line\n"), 0640)
+			}
+			if err := PruneSessions(fs, workingDir, tt.keep); err != nil {
+				t.Fatalf("PruneSessions() unexpected error: %v", err)
+			}
+			got, err := ListSessions(fs, workingDir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("sessions = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLogsClient_DisplaySessionLogs(t *testing.T) {
+	const (
+		workingDir = "/tmp/cmp"
+		session    = "20231018-153000-12345"
+	)
+	now := time.Now().UTC()
+	recent := now.Add(-time.Minute).Format(time.RFC3339Nano)
+	old := now.Add(-time.Hour).Format(time.RFC3339Nano)
+
+	buildFs := func() filesystem.Filesystem {
+		fs := filesystem.NewFakeFs()
+		dir := filepath.Join(GetSessionsDirectory(workingDir), session)
+		_ = fs.MkdirAll(dir, 0750)
+		_ = fs.WriteFile(filepath.Join(dir, "runtime.log.2"), []byte(old+" npm install\n"), 0640)
+		_ = fs.WriteFile(filepath.Join(dir, "runtime.log.1"), []byte(old+" npm start\n"), 0640)
+		_ = fs.WriteFile(filepath.Join(dir, "runtime.log"), []byte(recent+" App started\n"+recent+" ERROR: connection refused\n"), 0640)
+		_ = fs.WriteFile(filepath.Join(dir, "tools.log"), []byte(recent+" sleeping\n"), 0640)
+		return fs
+	}
+	tail := int64(2)
+
+	tests := []struct {
+		name    string
+		session string
+		options Options
+		want    string
+		wantErr string
+	}{
+		{
+			name:    "all the logs, from the oldest rotated file",
+			session: session,
+			want: `--> Logs for session 20231018-153000-12345 / runtime
+runtime: npm install
+runtime: npm start
+runtime: App started
+runtime: ERROR: connection refused
+--> Logs for session 20231018-153000-12345 / tools
+tools: sleeping
+`,
+		},
+		{
+			name:    "logs of a container, with since and tail",
+			session: session,
+			options: Options{Container: "runtime", Since: 10 * time.Minute, Tail: &tail, Grep: regexp.MustCompile("ERROR")},
+			want: `--> Logs for session 20231018-153000-12345 / runtime
+runtime: ERROR: connection refused
+`,
+		},
+		{
+			name:    "JSON",
+			session: session,
+			options: Options{Container: "tools", JSON: true},
+			want: `{"container":"tools","mode":"Dev","timestamp":"` + recent + `","message":"sleeping"}
+`,
+		},
+		{
+			name:    "unknown container",
+			session: session,
+			options: Options{Container: "unknown"},
+			want: `no logs recorded for the container "unknown" in session "20231018-153000-12345"
+`,
+		},
+		{
+			name:    "unknown session",
+			session: "20231018-160000-42",
+			wantErr: `no logs recorded for session "20231018-160000-42"; available sessions: 20231018-153000-12345`,
+		},
+		{
+			name:    "invalid session",
+			session: "../logs",
+			wantErr: `invalid session "../logs"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := odocontext.WithWorkingDirectory(context.Background(), workingDir)
+			var out bytes.Buffer
+			err := NewLogsClient(nil, buildFs()).DisplaySessionLogs(ctx, tt.session, tt.options, &out)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Errorf("DisplaySessionLogs() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DisplaySessionLogs() unexpected error: %v", err)
+			}
+			if got := out.String(); got != tt.want {
+				t.Errorf("DisplaySessionLogs() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -85,6 +85,10 @@ func (c *NoOpMachineEventLoggingClient) KubernetesPodStatus(pods []KubernetesPod
 
 }
 
+// SyncStatistics ignores the provided event.
+func (c *NoOpMachineEventLoggingClient) SyncStatistics(compression string, uncompressedBytes int64, transferredBytes int64, duration time.Duration, timestamp string) {
+}
+
 // NewConsoleMachineEventLoggingClient creates a new instance of ConsoleMachineEventLoggingClient,
 // which will output events as JSON to the console.
 func NewConsoleMachineEventLoggingClient() *ConsoleMachineEventLoggingClient {
@@ -203,6 +207,20 @@ func (c *ConsoleMachineEventLoggingClient) KubernetesPodStatus(pods []Kubernetes
 	c.outputJSON(json)
 }
 
+// SyncStatistics outputs the provided event as JSON to the console.
+func (c *ConsoleMachineEventLoggingClient) SyncStatistics(compression string, uncompressedBytes int64, transferredBytes int64, duration time.Duration, timestamp string) {
+	json := MachineEventWrapper{
+		SyncStatistics: &SyncStatistics{
+			Compression:       compression,
+			UncompressedBytes: uncompressedBytes,
+			TransferredBytes:  transferredBytes,
+			DurationMs:        duration.Milliseconds(),
+			AbstractLogEvent:  AbstractLogEvent{Timestamp: timestamp},
+		},
+	}
+	c.outputJSON(json)
+}
+
 func (c *ConsoleMachineEventLoggingClient) outputJSON(machineOutput MachineEventWrapper) {
 
 	if c.logFunc != nil {
@@ -244,6 +262,10 @@ func (w MachineEventWrapper) GetEntry() (MachineEventLogEntry, error) {
 		return w.URLReachable, nil
 	}
 
+	if w.SyncStatistics != nil {
+		return w.SyncStatistics, nil
+	}
+
 	return nil, errors.New("unexpected machine event log entry")
 }
 
@@ -275,6 +297,9 @@ func (c URLReachable) GetType() MachineEventLogEntryType { return TypeURLReachab
 // GetType returns the event type for this event.
 func (c KubernetesPodStatus) GetType() MachineEventLogEntryType { return TypeKubernetesPodStatus }
 
+// GetType returns the event type for this event.
+func (c SyncStatistics) GetType() MachineEventLogEntryType { return TypeSyncStatistics }
+
 // MachineEventLogEntryType indicates the machine-readable event type from an ODO operation
 type MachineEventLogEntryType int
 
@@ -293,6 +318,8 @@ const (
 	TypeURLReachable MachineEventLogEntryType = 6
 	// TypeKubernetesPodStatus is the entry type for that event.
 	TypeKubernetesPodStatus MachineEventLogEntryType = 7
+	// TypeSyncStatistics is the entry type for that event.
+	TypeSyncStatistics MachineEventLogEntryType = 8
 )
 
 // createWriterAndChannel is similar to the exec.CreateConsoleOutputWriterAndChannel(); see that function's comment for details.
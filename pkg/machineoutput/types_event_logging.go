@@ -2,6 +2,7 @@ package machineoutput
 
 import (
 	"io"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 )
@@ -22,6 +23,8 @@ type MachineEventLoggingClient interface {
 
 	KubernetesPodStatus(pods []KubernetesPodStatusEntry, timestamp string)
 
+	SyncStatistics(compression string, uncompressedBytes int64, transferredBytes int64, duration time.Duration, timestamp string)
+
 	// CreateContainerOutputWriter is used to capture output from container processes, and synchronously write it to the screen as LogText. See implementation comments for details.
 	CreateContainerOutputWriter() (*io.PipeWriter, chan interface{}, *io.PipeWriter, chan interface{})
 }
@@ -36,6 +39,7 @@ type MachineEventWrapper struct {
 	ContainerStatus                 *ContainerStatus                 `json:"containerStatus,omitempty"`
 	URLReachable                    *URLReachable                    `json:"urlReachable,omitempty"`
 	KubernetesPodStatus             *KubernetesPodStatus             `json:"kubernetesPodStatus,omitempty"`
+	SyncStatistics                  *SyncStatistics                  `json:"syncStatistics,omitempty"`
 }
 
 // DevFileCommandExecutionBegin is the JSON event that is emitted when a dev file command begins execution.
@@ -110,6 +114,15 @@ type KubernetesPodStatusEntry struct {
 	// vast majority are useful.
 }
 
+// SyncStatistics is the JSON event that is emitted after files are transferred to a container
+type SyncStatistics struct {
+	Compression       string `json:"compression"`
+	UncompressedBytes int64  `json:"uncompressedBytes"`
+	TransferredBytes  int64  `json:"transferredBytes"`
+	DurationMs        int64  `json:"durationMs"`
+	AbstractLogEvent
+}
+
 // AbstractLogEvent is the base struct for all events; all events must at a minimum contain a timestamp.
 type AbstractLogEvent struct {
 	Timestamp string `json:"timestamp"`
@@ -123,6 +136,7 @@ var _ MachineEventLogEntry = &ReportError{}
 var _ MachineEventLogEntry = &ContainerStatus{}
 var _ MachineEventLogEntry = &URLReachable{}
 var _ MachineEventLogEntry = &KubernetesPodStatus{}
+var _ MachineEventLogEntry = &SyncStatistics{}
 
 // MachineEventLogEntry contains the expected methods for every event that is emitted.
 // (This is mainly used for test purposes.)
@@ -82,6 +82,7 @@ func (o *ApiServerOptions) Run(ctx context.Context) (err error) {
 		nil,
 		nil,
 		o.clientset.StateClient,
+		nil,
 		o.clientset.PreferenceClient,
 		o.clientset.InformerClient,
 	)
@@ -35,6 +35,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/odo/cli/registry"
 	"github.com/redhat-developer/odo/pkg/odo/cli/remove"
 	"github.com/redhat-developer/odo/pkg/odo/cli/set"
+	"github.com/redhat-developer/odo/pkg/odo/cli/sync"
 	"github.com/redhat-developer/odo/pkg/odo/cli/telemetry"
 	"github.com/redhat-developer/odo/pkg/odo/cli/version"
 	"github.com/redhat-developer/odo/pkg/odo/util"
@@ -203,6 +204,7 @@ func odoRootCmd(ctx context.Context, name, fullName string, unknownCmdHandler fu
 		logs.NewCmdLogs(logs.RecommendedCommandName, util.GetFullName(fullName, logs.RecommendedCommandName), testClientset),
 		completion.NewCmdCompletion(completion.RecommendedCommandName, util.GetFullName(fullName, completion.RecommendedCommandName)),
 		run.NewCmdRun(run.RecommendedCommandName, util.GetFullName(fullName, run.RecommendedCommandName), testClientset),
+		sync.NewCmdSync(ctx, sync.RecommendedCommandName, util.GetFullName(fullName, sync.RecommendedCommandName), testClientset),
 	)
 	if feature.IsExperimentalModeEnabled(ctx) {
 		rootCmdList = append(rootCmdList, apiserver.NewCmdApiServer(ctx, apiserver.RecommendedCommandName, util.GetFullName(fullName, apiserver.RecommendedCommandName), testClientset))
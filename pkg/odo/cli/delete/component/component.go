@@ -108,7 +108,7 @@ func (o *ComponentOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline
 	switch fcontext.GetPlatform(ctx, "") {
 	case commonflags.PlatformCluster:
 		o.clientset.PodmanClient = nil
-	case commonflags.PlatformPodman:
+	case commonflags.PlatformPodman, commonflags.PlatformDocker:
 		o.clientset.KubernetesClient = nil
 	}
 
@@ -2,11 +2,9 @@ package deploy
 
 import (
 	"context"
+	"errors"
 	"fmt"
-
-	dfutil "github.com/devfile/library/v2/pkg/util"
-
-	"github.com/redhat-developer/odo/pkg/kclient"
+	"time"
 
 	"github.com/spf13/cobra"
 	"k8s.io/kubectl/pkg/util/templates"
@@ -14,6 +12,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/component"
 	"github.com/redhat-developer/odo/pkg/log"
 	"github.com/redhat-developer/odo/pkg/odo/cli/messages"
+	"github.com/redhat-developer/odo/pkg/odo/cli/ui"
 	"github.com/redhat-developer/odo/pkg/odo/cmdline"
 	"github.com/redhat-developer/odo/pkg/odo/commonflags"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
@@ -31,13 +30,41 @@ const RecommendedCommandName = "deploy"
 type DeployOptions struct {
 	// Clients
 	clientset *clientset.Clientset
+
+	// Flags
+	dryRunFlag bool
+	pruneFlag  bool
+	forceFlag  bool
+	waitFlag   string
+
+	// pruneFlagSet indicates if the --prune flag has been explicitly set, overriding the "deploy.odo.prune" attribute of the Devfile
+	pruneFlagSet bool
+
+	// waitTimeout is the timeout parsed from the --wait flag, or zero if the rollout must not be waited for
+	waitTimeout time.Duration
 }
 
+// defaultWaitTimeout is the timeout used when the --wait flag is set without a value
+const defaultWaitTimeout = "5m"
+
 var _ genericclioptions.Runnable = (*DeployOptions)(nil)
+var _ genericclioptions.JsonOutputter = (*DeployOptions)(nil)
 
 var deployExample = templates.Examples(`
   # Run the components defined in the Devfile on the cluster in the Deploy mode
   %[1]s
+
+  # Show the changes the Deploy mode would make to the resources deployed on the cluster, without deploying them
+  %[1]s --dry-run
+
+  # Delete the resources deployed previously which are not defined in the Devfile anymore
+  %[1]s --prune
+
+  # Wait for the deployed workloads to be rolled out, with the default timeout of 5 minutes
+  %[1]s --wait
+
+  # Wait for the deployed workloads to be rolled out, for 10 minutes at most
+  %[1]s --wait=10m
 `)
 
 // NewDeployOptions creates a new DeployOptions instance
@@ -56,25 +83,42 @@ func (o *DeployOptions) PreInit() string {
 // Complete DeployOptions after they've been created
 func (o *DeployOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
 	scontext.SetPlatform(ctx, o.clientset.KubernetesClient)
+	o.pruneFlagSet = cmdline.IsFlagSet("prune")
 	return nil
 }
 
 // Validate validates the DeployOptions based on completed values
 func (o *DeployOptions) Validate(ctx context.Context) error {
-	devfileObj := odocontext.GetEffectiveDevfileObj(ctx)
-	if devfileObj == nil {
-		return genericclioptions.NewNoDevfileError(odocontext.GetWorkingDirectory(ctx))
+	if log.IsJSON() && !o.dryRunFlag {
+		return errors.New("the JSON output is only supported with the --dry-run flag")
 	}
-	if o.clientset.KubernetesClient == nil {
-		return kclient.NewNoConnectionError()
+	if o.waitFlag != "" {
+		if o.dryRunFlag {
+			return errors.New("the --wait flag cannot be used with the --dry-run flag")
+		}
+		timeout, err := time.ParseDuration(o.waitFlag)
+		if err != nil {
+			return fmt.Errorf("invalid value for the --wait flag: %w", err)
+		}
+		if timeout <= 0 {
+			return errors.New("invalid value for the --wait flag: the timeout must be positive")
+		}
+		o.waitTimeout = timeout
 	}
-	componentName := odocontext.GetComponentName(ctx)
-	err := dfutil.ValidateK8sResourceName("component name", componentName)
-	return err
+	return validateDeploy(ctx, o.clientset)
 }
 
 // Run contains the logic for the odo command
 func (o *DeployOptions) Run(ctx context.Context) error {
+	prune, err := isPruneEnabled(ctx, o.pruneFlag, o.pruneFlagSet)
+	if err != nil {
+		return err
+	}
+
+	if o.dryRunFlag {
+		return runDryRun(ctx, o.clientset, prune)
+	}
+
 	var (
 		devfileObj  = odocontext.GetEffectiveDevfileObj(ctx)
 		devfileName = odocontext.GetComponentName(ctx)
@@ -92,13 +136,72 @@ func (o *DeployOptions) Run(ctx context.Context) error {
 	genericclioptions.WarnIfDefaultNamespace(namespace, o.clientset.KubernetesClient)
 
 	// Run actual deploy command to be used
-	err := o.clientset.DeployClient.Deploy(ctx)
+	err = o.clientset.DeployClient.Deploy(ctx)
+	if err != nil {
+		return err
+	}
+
+	if prune {
+		err = o.prune(ctx)
+		if err != nil {
+			return err
+		}
+	}
 
-	if err == nil {
-		log.Info("\nYour Devfile has been successfully deployed")
+	if o.waitTimeout > 0 {
+		err = o.clientset.DeployClient.Wait(ctx, o.waitTimeout)
+		if err != nil {
+			return err
+		}
 	}
 
-	return err
+	log.Info("\nYour Devfile has been successfully deployed")
+	return nil
+}
+
+// prune deletes the resources deployed previously which are not defined in the Devfile anymore, after confirmation
+func (o *DeployOptions) prune(ctx context.Context) error {
+	resources, err := o.clientset.DeployClient.ListResourcesToPrune(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to determine resources to prune: %w", err)
+	}
+	if len(resources) == 0 {
+		return nil
+	}
+
+	log.Info("\nThe following resources are not defined in the Devfile anymore and will get deleted from the cluster:")
+	for _, resource := range resources {
+		log.Printf("%s: %s", resource.GetKind(), resource.GetName())
+	}
+
+	proceed := o.forceFlag
+	if !proceed {
+		proceed, err = ui.Proceed("Are you sure you want to delete these resources?")
+		if err != nil {
+			return err
+		}
+	}
+	if !proceed {
+		log.Warning("Aborting the pruning of the resources")
+		return nil
+	}
+
+	spinner := log.Spinner("Deleting the resources not defined in the Devfile")
+	err = o.clientset.DeployClient.Prune(resources)
+	spinner.End(err == nil)
+	if err != nil {
+		return fmt.Errorf("unable to prune the resources not defined in the Devfile anymore: %w", err)
+	}
+	return nil
+}
+
+// RunForJsonOutput contains the logic for the JSON Output, only supported in dry-run mode
+func (o *DeployOptions) RunForJsonOutput(ctx context.Context) (out interface{}, err error) {
+	prune, err := isPruneEnabled(ctx, o.pruneFlag, o.pruneFlagSet)
+	if err != nil {
+		return nil, err
+	}
+	return o.clientset.DeployClient.DryRun(ctx, prune)
 }
 
 // NewCmdDeploy implements the odo command
@@ -115,10 +218,22 @@ func NewCmdDeploy(name, fullName string, testClientset clientset.Clientset) *cob
 		},
 	}
 	clientset.Add(deployCmd, clientset.INIT, clientset.DEPLOY, clientset.FILESYSTEM, clientset.KUBERNETES)
+	deployCmd.Flags().BoolVar(&o.dryRunFlag, "dry-run", false, "Show the changes the Deploy mode would make on the cluster, without deploying the resources nor building the images")
+	deployCmd.Flags().BoolVar(&o.pruneFlag, "prune", false, "Delete the resources deployed previously which are not defined in the Devfile anymore. Defaults to the value of the \"deploy.odo.prune\" attribute of the Devfile")
+	deployCmd.Flags().BoolVarP(&o.forceFlag, "force", "f", false, "Delete the resources to prune without prompting")
+	deployCmd.Flags().StringVar(&o.waitFlag, "wait", "", "Wait for the deployed workloads to be rolled out, and fail if a rollout fails or does not complete before the timeout (e.g. --wait=10m). Defaults to "+defaultWaitTimeout+" when set without a value")
+	deployCmd.Flags().Lookup("wait").NoOptDefVal = defaultWaitTimeout
+
+	diffCmd := NewCmdDiff(DiffRecommendedCommandName, util.GetFullName(fullName, DiffRecommendedCommandName), testClientset)
+	historyCmd := NewCmdHistory(HistoryRecommendedCommandName, util.GetFullName(fullName, HistoryRecommendedCommandName), testClientset)
+	rollbackCmd := NewCmdRollback(RollbackRecommendedCommandName, util.GetFullName(fullName, RollbackRecommendedCommandName), testClientset)
+	exportCmd := NewCmdExport(ExportRecommendedCommandName, util.GetFullName(fullName, ExportRecommendedCommandName), testClientset)
+	deployCmd.AddCommand(diffCmd, historyCmd, rollbackCmd, exportCmd)
 
 	// Add a defined annotation in order to appear in the help menu
 	util.SetCommandGroup(deployCmd, util.MainGroup)
 	deployCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	commonflags.UseOutputFlag(deployCmd)
 	commonflags.UseVariablesFlags(deployCmd)
 	return deployCmd
 }
@@ -0,0 +1,180 @@
+package deploy
+
+import (
+	"context"
+	"fmt"
+
+	dfutil "github.com/devfile/library/v2/pkg/util"
+	"github.com/spf13/cobra"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/component"
+	"github.com/redhat-developer/odo/pkg/deploy"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+	scontext "github.com/redhat-developer/odo/pkg/segment/context"
+)
+
+// DiffRecommendedCommandName is the recommended diff command name
+const DiffRecommendedCommandName = "diff"
+
+// DiffOptions encapsulates the options for the odo deploy diff command
+type DiffOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+
+	// Flags
+	pruneFlag bool
+
+	// pruneFlagSet indicates if the --prune flag has been explicitly set, overriding the "deploy.odo.prune" attribute of the Devfile
+	pruneFlagSet bool
+}
+
+var _ genericclioptions.Runnable = (*DiffOptions)(nil)
+var _ genericclioptions.JsonOutputter = (*DiffOptions)(nil)
+
+var diffExample = templates.Examples(`
+  # Show the changes the Deploy mode would make to the resources deployed on the cluster
+  %[1]s
+
+  # Show the changes in JSON format
+  %[1]s -o json
+
+  # Show also the resources which would be pruned
+  %[1]s --prune
+`)
+
+// NewDiffOptions creates a new DiffOptions instance
+func NewDiffOptions() *DiffOptions {
+	return &DiffOptions{}
+}
+
+func (o *DiffOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete DiffOptions after they've been created
+func (o *DiffOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
+	scontext.SetPlatform(ctx, o.clientset.KubernetesClient)
+	o.pruneFlagSet = cmdline.IsFlagSet("prune")
+	return nil
+}
+
+// Validate validates the DiffOptions based on completed values
+func (o *DiffOptions) Validate(ctx context.Context) error {
+	return validateDeploy(ctx, o.clientset)
+}
+
+// Run contains the logic for the odo command
+func (o *DiffOptions) Run(ctx context.Context) error {
+	prune, err := isPruneEnabled(ctx, o.pruneFlag, o.pruneFlagSet)
+	if err != nil {
+		return err
+	}
+	return runDryRun(ctx, o.clientset, prune)
+}
+
+// RunForJsonOutput contains the logic for the JSON Output
+func (o *DiffOptions) RunForJsonOutput(ctx context.Context) (out interface{}, err error) {
+	prune, err := isPruneEnabled(ctx, o.pruneFlag, o.pruneFlagSet)
+	if err != nil {
+		return nil, err
+	}
+	return o.clientset.DeployClient.DryRun(ctx, prune)
+}
+
+// isPruneEnabled returns the value of the --prune flag if explicitly set, or the value of the "deploy.odo.prune" attribute of the Devfile
+func isPruneEnabled(ctx context.Context, pruneFlag bool, pruneFlagSet bool) (bool, error) {
+	if pruneFlagSet {
+		return pruneFlag, nil
+	}
+	return deploy.IsPruneEnabled(*odocontext.GetEffectiveDevfileObj(ctx))
+}
+
+// validateDeploy validates that the Deploy mode can be run, either for real or in dry-run mode
+func validateDeploy(ctx context.Context, clientset *clientset.Clientset) error {
+	devfileObj := odocontext.GetEffectiveDevfileObj(ctx)
+	if devfileObj == nil {
+		return genericclioptions.NewNoDevfileError(odocontext.GetWorkingDirectory(ctx))
+	}
+	if clientset.KubernetesClient == nil {
+		return kclient.NewNoConnectionError()
+	}
+	componentName := odocontext.GetComponentName(ctx)
+	return dfutil.ValidateK8sResourceName("component name", componentName)
+}
+
+// runDryRun runs the Deploy mode in dry-run mode, and displays the changes it would make on the cluster
+func runDryRun(ctx context.Context, clientset *clientset.Clientset, prune bool) error {
+	var (
+		devfileObj  = odocontext.GetEffectiveDevfileObj(ctx)
+		devfileName = odocontext.GetComponentName(ctx)
+		namespace   = odocontext.GetNamespace(ctx)
+	)
+
+	scontext.SetComponentType(ctx, component.GetComponentTypeFromDevfileMetadata(devfileObj.Data.GetMetadata()))
+	scontext.SetLanguage(ctx, devfileObj.Data.GetMetadata().Language)
+	scontext.SetProjectType(ctx, devfileObj.Data.GetMetadata().ProjectType)
+	scontext.SetDevfileName(ctx, devfileName)
+	log.Title("Comparing the Deploy mode of the \""+devfileName+"\" Devfile with the cluster",
+		"Namespace: "+namespace)
+
+	diff, err := clientset.DeployClient.DryRun(ctx, prune)
+	if err != nil {
+		return err
+	}
+	printDeployDiff(diff)
+	return nil
+}
+
+func printDeployDiff(diff api.DeployDiff) {
+	if len(diff.Images) > 0 {
+		log.Info("\nImages which would be built and pushed (not built in dry-run mode):")
+		for _, img := range diff.Images {
+			log.Printf("%s", img)
+		}
+	}
+
+	for _, r := range diff.Resources {
+		log.Sectionf("%s/%s: %s", r.Kind, r.Name, r.Status)
+		if r.Diff != "" {
+			fmt.Fprint(log.GetStdout(), r.Diff)
+		}
+	}
+
+	if !diff.HasChanges() {
+		log.Info("\nNo changes would be made to the resources deployed on the cluster")
+	}
+}
+
+// NewCmdDiff implements the odo deploy diff command
+func NewCmdDiff(name, fullName string, testClientset clientset.Clientset) *cobra.Command {
+	o := NewDiffOptions()
+	diffCmd := &cobra.Command{
+		Use:   name,
+		Short: "Show the changes the Deploy mode would make on the cluster",
+		Long: `Show the changes the Deploy mode would make on the cluster.
+
+The resources of the Deploy mode are applied in dry-run mode on the cluster, and a unified diff is displayed for each resource
+against the resource currently deployed. The images are not built nor pushed, and the commands are not executed.`,
+		Example: fmt.Sprintf(diffExample, fullName),
+		Args:    genericclioptions.NoArgsAndSilenceJSON,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, testClientset, cmd, args)
+		},
+	}
+	clientset.Add(diffCmd, clientset.DEPLOY, clientset.FILESYSTEM, clientset.KUBERNETES)
+
+	diffCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	diffCmd.Flags().BoolVar(&o.pruneFlag, "prune", false, "Show also the resources deployed previously which are not defined in the Devfile anymore, and would be deleted. Defaults to the value of the \"deploy.odo.prune\" attribute of the Devfile")
+	commonflags.UseOutputFlag(diffCmd)
+	commonflags.UseVariablesFlags(diffCmd)
+	return diffCmd
+}
@@ -0,0 +1,151 @@
+package deploy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	dfutil "github.com/devfile/library/v2/pkg/util"
+	"github.com/spf13/cobra"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/deploy"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+	scontext "github.com/redhat-developer/odo/pkg/segment/context"
+)
+
+// ExportRecommendedCommandName is the recommended export command name
+const ExportRecommendedCommandName = "export"
+
+// ExportOptions encapsulates the options for the odo deploy export command
+type ExportOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+
+	// Flags
+	formatFlag    string
+	outputDirFlag string
+	forceFlag     bool
+}
+
+var _ genericclioptions.Runnable = (*ExportOptions)(nil)
+
+var exportExample = templates.Examples(`
+  # Export the resources of the Deploy mode as plain YAML files into the manifests directory
+  %[1]s --output-dir manifests
+
+  # Export the resources of the Deploy mode with a kustomization.yaml file
+  %[1]s --format kustomize --output-dir manifests
+
+  # Export the resources of the Deploy mode as a Helm chart, with the Devfile variables as values
+  %[1]s --format helm --output-dir chart
+`)
+
+// NewExportOptions creates a new ExportOptions instance
+func NewExportOptions() *ExportOptions {
+	return &ExportOptions{}
+}
+
+func (o *ExportOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete ExportOptions after they've been created
+func (o *ExportOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
+	scontext.SetPlatform(ctx, o.clientset.KubernetesClient)
+	return nil
+}
+
+// Validate validates the ExportOptions based on completed values
+func (o *ExportOptions) Validate(ctx context.Context) error {
+	devfileObj := odocontext.GetEffectiveDevfileObj(ctx)
+	if devfileObj == nil {
+		return genericclioptions.NewNoDevfileError(odocontext.GetWorkingDirectory(ctx))
+	}
+	if !isValidExportFormat(o.formatFlag) {
+		return fmt.Errorf("invalid value %q for the --format flag, supported formats: %s", o.formatFlag, strings.Join(getExportFormats(), ", "))
+	}
+	if o.outputDirFlag == "" {
+		return errors.New("the --output-dir flag is required")
+	}
+	if !o.forceFlag {
+		files, err := o.clientset.FS.ReadDir(o.outputDirFlag)
+		if err == nil && len(files) > 0 {
+			return fmt.Errorf("the directory %q is not empty, use the --force flag to overwrite its files", o.outputDirFlag)
+		}
+	}
+	componentName := odocontext.GetComponentName(ctx)
+	return dfutil.ValidateK8sResourceName("component name", componentName)
+}
+
+// Run contains the logic for the odo command
+func (o *ExportOptions) Run(ctx context.Context) error {
+	devfileName := odocontext.GetComponentName(ctx)
+
+	scontext.SetDevfileName(ctx, devfileName)
+	log.Title("Exporting the Deploy mode of the \""+devfileName+"\" Devfile", "Format: "+o.formatFlag)
+
+	files, err := o.clientset.DeployClient.Export(ctx, deploy.ExportFormat(o.formatFlag), o.outputDirFlag, o.clientset.PreferenceClient.GetImageRegistry())
+	if err != nil {
+		return err
+	}
+
+	log.Infof("\nThe Deploy mode has been exported into %q:", o.outputDirFlag)
+	for _, file := range files {
+		log.Printf("%s", file)
+	}
+	return nil
+}
+
+func getExportFormats() []string {
+	result := make([]string, 0, len(deploy.ExportFormats))
+	for _, format := range deploy.ExportFormats {
+		result = append(result, string(format))
+	}
+	return result
+}
+
+func isValidExportFormat(format string) bool {
+	for _, f := range deploy.ExportFormats {
+		if string(f) == format {
+			return true
+		}
+	}
+	return false
+}
+
+// NewCmdExport implements the odo deploy export command
+func NewCmdExport(name, fullName string, testClientset clientset.Clientset) *cobra.Command {
+	o := NewExportOptions()
+	exportCmd := &cobra.Command{
+		Use:   name,
+		Short: "Export the resources of the Deploy mode as plain YAML, Kustomize or Helm",
+		Long: `Export the resources of the Deploy mode as plain YAML, Kustomize or Helm.
+
+The resources of the Kubernetes and OpenShift components applied by the Deploy mode are rendered with the labels and annotations
+added by odo, and written into the output directory, without being applied on the cluster. The images are not built nor pushed,
+and the commands are not executed.
+
+With the helm format, the references to the images and the Devfile variables are values of the chart.`,
+		Example: fmt.Sprintf(exportExample, fullName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, testClientset, cmd, args)
+		},
+	}
+	clientset.Add(exportCmd, clientset.DEPLOY, clientset.FILESYSTEM, clientset.KUBERNETES_NULLABLE, clientset.PREFERENCE)
+
+	exportCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	exportCmd.Flags().StringVar(&o.formatFlag, "format", string(deploy.ExportFormatYAML), "Format of the exported resources: "+strings.Join(getExportFormats(), ", "))
+	exportCmd.Flags().StringVar(&o.outputDirFlag, "output-dir", "", "Directory into which the resources are exported")
+	exportCmd.Flags().BoolVarP(&o.forceFlag, "force", "f", false, "Overwrite the files of the output directory if it is not empty")
+	commonflags.UseVariablesFlags(exportCmd)
+	return exportCmd
+}
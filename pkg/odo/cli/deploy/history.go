@@ -0,0 +1,132 @@
+package deploy
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cli/ui"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+	scontext "github.com/redhat-developer/odo/pkg/segment/context"
+)
+
+// HistoryRecommendedCommandName is the recommended history command name
+const HistoryRecommendedCommandName = "history"
+
+// HistoryOptions encapsulates the options for the odo deploy history command
+type HistoryOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+}
+
+var _ genericclioptions.Runnable = (*HistoryOptions)(nil)
+var _ genericclioptions.JsonOutputter = (*HistoryOptions)(nil)
+
+var historyExample = templates.Examples(`
+  # List the deployments recorded for the component
+  %[1]s
+
+  # List the deployments in JSON format
+  %[1]s -o json
+`)
+
+// NewHistoryOptions creates a new HistoryOptions instance
+func NewHistoryOptions() *HistoryOptions {
+	return &HistoryOptions{}
+}
+
+func (o *HistoryOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete HistoryOptions after they've been created
+func (o *HistoryOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
+	scontext.SetPlatform(ctx, o.clientset.KubernetesClient)
+	return nil
+}
+
+// Validate validates the HistoryOptions based on completed values
+func (o *HistoryOptions) Validate(ctx context.Context) error {
+	return validateDeploy(ctx, o.clientset)
+}
+
+// Run contains the logic for the odo command
+func (o *HistoryOptions) Run(ctx context.Context) error {
+	revisions, err := o.clientset.DeployClient.ListRevisions(ctx)
+	if err != nil {
+		return err
+	}
+	if len(revisions) == 0 {
+		log.Infof("No deployment has been recorded for the component %q", odocontext.GetComponentName(ctx))
+		return nil
+	}
+
+	t := ui.NewTable()
+	t.AppendHeader(table.Row{"REVISION", "DATE", "IMAGES", "RESOURCES", "DESCRIPTION"})
+	for _, revision := range revisions {
+		resources := make([]string, 0, len(revision.Resources))
+		for _, r := range revision.Resources {
+			resources = append(resources, r.Kind+"/"+r.Name)
+		}
+		t.AppendRow(table.Row{
+			revision.Revision,
+			revision.Date.Local().Format("2006-01-02 15:04:05"),
+			strings.Join(revision.Images, "\n"),
+			strings.Join(resources, "\n"),
+			getRevisionDescription(revision),
+		})
+	}
+	t.Render()
+	return nil
+}
+
+// RunForJsonOutput contains the logic for the JSON Output
+func (o *HistoryOptions) RunForJsonOutput(ctx context.Context) (out interface{}, err error) {
+	revisions, err := o.clientset.DeployClient.ListRevisions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return api.DeployHistory{Revisions: revisions}, nil
+}
+
+func getRevisionDescription(revision api.DeployRevision) string {
+	if revision.RollbackOf != 0 {
+		return "Rollback to revision " + strconv.Itoa(revision.RollbackOf)
+	}
+	return "Deployment"
+}
+
+// NewCmdHistory implements the odo deploy history command
+func NewCmdHistory(name, fullName string, testClientset clientset.Clientset) *cobra.Command {
+	o := NewHistoryOptions()
+	historyCmd := &cobra.Command{
+		Use:   name,
+		Short: "List the deployments of the component",
+		Long: `List the deployments of the component.
+
+Each successful run of the Deploy mode is recorded on the cluster as a new revision, containing the resources applied
+and the references of the images built. The last 10 revisions are kept, and can be reapplied with the rollback command.`,
+		Example: fmt.Sprintf(historyExample, fullName),
+		Args:    genericclioptions.NoArgsAndSilenceJSON,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, testClientset, cmd, args)
+		},
+	}
+	clientset.Add(historyCmd, clientset.DEPLOY, clientset.FILESYSTEM, clientset.KUBERNETES)
+
+	historyCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	commonflags.UseOutputFlag(historyCmd)
+	return historyCmd
+}
@@ -0,0 +1,119 @@
+package deploy
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+	scontext "github.com/redhat-developer/odo/pkg/segment/context"
+)
+
+// RollbackRecommendedCommandName is the recommended rollback command name
+const RollbackRecommendedCommandName = "rollback"
+
+// RollbackOptions encapsulates the options for the odo deploy rollback command
+type RollbackOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+
+	// revision is the revision to roll back to, or zero to roll back to the revision preceding the last one
+	revision int
+}
+
+var _ genericclioptions.Runnable = (*RollbackOptions)(nil)
+
+var rollbackExample = templates.Examples(`
+  # Reapply the deployment preceding the last one
+  %[1]s
+
+  # Reapply the revision 3 of the deployments listed by 'odo deploy history'
+  %[1]s 3
+`)
+
+// NewRollbackOptions creates a new RollbackOptions instance
+func NewRollbackOptions() *RollbackOptions {
+	return &RollbackOptions{}
+}
+
+func (o *RollbackOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete RollbackOptions after they've been created
+func (o *RollbackOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
+	scontext.SetPlatform(ctx, o.clientset.KubernetesClient)
+	if len(args) == 1 {
+		o.revision, err = strconv.Atoi(args[0])
+		if err != nil || o.revision <= 0 {
+			return fmt.Errorf("invalid revision %q: the revision must be a positive number", args[0])
+		}
+	}
+	return nil
+}
+
+// Validate validates the RollbackOptions based on completed values
+func (o *RollbackOptions) Validate(ctx context.Context) error {
+	return validateDeploy(ctx, o.clientset)
+}
+
+// Run contains the logic for the odo command
+func (o *RollbackOptions) Run(ctx context.Context) error {
+	var (
+		devfileName = odocontext.GetComponentName(ctx)
+		namespace   = odocontext.GetNamespace(ctx)
+	)
+
+	scontext.SetDevfileName(ctx, devfileName)
+	log.Title("Rolling back the deployment of the \""+devfileName+"\" component",
+		"Namespace: "+namespace)
+
+	revision, err := o.clientset.DeployClient.Rollback(ctx, o.revision)
+	if err != nil {
+		return err
+	}
+
+	if len(revision.Images) > 0 {
+		log.Info("\nThe images were not rebuilt; the resources reference the images:")
+		for _, img := range revision.Images {
+			if digest, found := revision.ImageDigests[img]; found {
+				log.Printf("%s (pinned to %s)", img, digest)
+			} else {
+				log.Printf("%s (not reverted, the digest of the image is unknown)", img)
+			}
+		}
+	}
+	log.Infof("\nThe revision %d has been successfully reapplied", revision.Revision)
+	return nil
+}
+
+// NewCmdRollback implements the odo deploy rollback command
+func NewCmdRollback(name, fullName string, testClientset clientset.Clientset) *cobra.Command {
+	o := NewRollbackOptions()
+	rollbackCmd := &cobra.Command{
+		Use:   name + " [revision]",
+		Short: "Reapply a previous deployment of the component",
+		Long: `Reapply a previous deployment of the component, as listed by the history command.
+
+The resources recorded in the revision (or in the revision preceding the last one, if no revision is specified) are applied again
+on the cluster, without building the images nor running the commands of the Deploy mode, and the rollback is recorded as a new revision.
+The resources deployed since the revision are not deleted.`,
+		Example: fmt.Sprintf(rollbackExample, fullName),
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, testClientset, cmd, args)
+		},
+	}
+	clientset.Add(rollbackCmd, clientset.DEPLOY, clientset.FILESYSTEM, clientset.KUBERNETES)
+
+	rollbackCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	return rollbackCmd
+}
@@ -101,6 +101,10 @@ func (o *ComponentOptions) Validate(ctx context.Context) (err error) {
 		if o.namespaceFlag != "" {
 			log.Warning("--namespace flag ignored on Podman")
 		}
+	case commonflags.PlatformDocker:
+		if o.namespaceFlag != "" {
+			log.Warning("--namespace flag ignored on Docker")
+		}
 	}
 
 	return nil
@@ -180,6 +184,9 @@ func printHumanReadableOutput(ctx context.Context, cmp api.Component, devfileObj
 		log.Info("Forwarded ports:")
 		for _, port := range cmp.DevForwardedPorts {
 			details := fmt.Sprintf("%s:%d -> %s:%d", port.LocalAddress, port.LocalPort, port.ContainerName, port.ContainerPort)
+			if port.IsReverse {
+				details = fmt.Sprintf("%s:%d <- %s:%d", port.LocalAddress, port.LocalPort, port.ContainerName, port.ContainerPort)
+			}
 			if withPlatformFeature {
 				p := port.Platform
 				if p == "" {
@@ -196,6 +203,9 @@ func printHumanReadableOutput(ctx context.Context, cmp api.Component, devfileObj
 			if port.IsDebug {
 				details += "\n    Debug: true"
 			}
+			if port.IsReverse {
+				details += "\n    Reverse: true"
+			}
 			log.Printf(details)
 		}
 		fmt.Println()
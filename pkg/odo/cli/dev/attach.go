@@ -0,0 +1,225 @@
+package dev
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/apiserver-impl/sse"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	"github.com/redhat-developer/odo/pkg/watch"
+)
+
+// AttachRecommendedCommandName is the recommended attach sub-command name
+const AttachRecommendedCommandName = "attach"
+
+var attachExample = ktemplates.Examples(`
+	# Attach to the Dev session running for the component in the current directory
+	%[1]s
+
+	# Attach to the Dev session running on Podman for the component in the current directory
+	%[1]s --platform podman
+`)
+
+type AttachOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+
+	out io.Writer
+}
+
+var _ genericclioptions.Runnable = (*AttachOptions)(nil)
+
+func NewAttachOptions() *AttachOptions {
+	return &AttachOptions{
+		out: log.GetStdout(),
+	}
+}
+
+func (o *AttachOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+func (o *AttachOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) error {
+	return nil
+}
+
+func (o *AttachOptions) Validate(ctx context.Context) error {
+	return nil
+}
+
+func (o *AttachOptions) Run(ctx context.Context) error {
+	controlPlanes, err := o.clientset.StateClient.GetAPIServerPorts(ctx)
+	if err != nil {
+		return err
+	}
+	switch len(controlPlanes) {
+	case 0:
+		return errors.New("no 'odo dev' session with an API Server is running for the component in the current directory")
+	case 1:
+	default:
+		var platforms []string
+		for _, controlPlane := range controlPlanes {
+			platforms = append(platforms, controlPlane.Platform)
+		}
+		return fmt.Errorf("'odo dev' sessions are running on several platforms (%s), please select one with the --platform flag", strings.Join(platforms, ", "))
+	}
+	controlPlane := controlPlanes[0]
+	baseURL := getAPIServerURL(controlPlane)
+
+	log.Title("Attaching to the \"odo dev\" session running on "+controlPlane.Platform, "API Server: "+baseURL)
+	fmt.Fprintln(o.out, log.Sbold("Keyboard Commands:")+"\n"+
+		"[Ctrl+c] - Detach from the session\n"+
+		"     [p] - Manually apply local changes to the application\n"+
+		"     [x] - Stop the session and delete resources\n")
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	subscriptionDone := make(chan error)
+	go func() {
+		subscriptionDone <- sse.Subscribe(ctx, baseURL+"notifications", func(ev sse.ReceivedEvent) {
+			printEvent(o.out, ev)
+		})
+	}()
+
+	keyWatcher := watch.GetKeyWatcher(ctx, o.out)
+	for {
+		select {
+		case key := <-keyWatcher:
+			switch key {
+			case 'p':
+				err = o.request(ctx, http.MethodPost, baseURL+"component/command", map[string]string{"name": "push"})
+			case 'x':
+				err = o.request(ctx, http.MethodDelete, baseURL+"instance", nil)
+			}
+			if err != nil {
+				log.Warning(err)
+			}
+
+		case err = <-subscriptionDone:
+			if err != nil {
+				return fmt.Errorf("connection to the 'odo dev' session lost: %w", err)
+			}
+			log.Info("The \"odo dev\" session has ended")
+			return nil
+
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// request sends a request to the API Server and returns an error if the request is not successful
+func (o *AttachOptions) request(ctx context.Context, method string, url string, body interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		jsonBody, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(jsonBody)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Message string `json:"message"`
+	}
+	_ = json.NewDecoder(resp.Body).Decode(&result)
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("request to the 'odo dev' session failed: %s", result.Message)
+	}
+	klog.V(4).Infof("response from API Server: %s", result.Message)
+	return nil
+}
+
+func getAPIServerURL(controlPlane api.DevControlPlane) string {
+	return fmt.Sprintf("http://localhost:%d%s", controlPlane.LocalPort, controlPlane.APIServerPath)
+}
+
+// printEvent displays to out an event received from the Dev session
+func printEvent(out io.Writer, ev sse.ReceivedEvent) {
+	switch ev.Name {
+	case sse.OutputEventName:
+		var data struct {
+			Text string `json:"text"`
+		}
+		if err := json.Unmarshal([]byte(ev.Data), &data); err != nil {
+			klog.V(4).Infof("unable to decode %s event: %v", ev.Name, err)
+			return
+		}
+		fmt.Fprint(out, data.Text)
+
+	case sse.ComponentStatusChangedEventName:
+		var data struct {
+			State string `json:"state"`
+		}
+		if err := json.Unmarshal([]byte(ev.Data), &data); err != nil {
+			klog.V(4).Infof("unable to decode %s event: %v", ev.Name, err)
+			return
+		}
+		fmt.Fprintf(out, "%s %s\n", log.Sbold("Component status:"), data.State)
+
+	case sse.FilesSyncedEventName:
+		var data struct {
+			ChangedFiles []string `json:"changedFiles"`
+			DeletedFiles []string `json:"deletedFiles"`
+		}
+		if err := json.Unmarshal([]byte(ev.Data), &data); err != nil {
+			klog.V(4).Infof("unable to decode %s event: %v", ev.Name, err)
+			return
+		}
+		fmt.Fprintf(out, "%s %d changed, %d deleted\n", log.Sbold("Files synced:"), len(data.ChangedFiles), len(data.DeletedFiles))
+
+	case sse.DevfileUpdatedEventName:
+		fmt.Fprintf(out, "%s\n", log.Sbold("Devfile updated"))
+
+	default:
+		klog.V(4).Infof("ignoring event %q", ev.Name)
+	}
+}
+
+// NewCmdDevAttach implements the odo dev attach command
+func NewCmdDevAttach(ctx context.Context, name, fullName string, testClientset clientset.Clientset) *cobra.Command {
+	o := NewAttachOptions()
+	attachCmd := &cobra.Command{
+		Use:   name,
+		Short: "Attach to a running Dev session",
+		Long: `odo dev attach connects to the API Server of an 'odo dev' session running for the component in the current directory.
+It displays the status, the output and the sync events of the session, and lets you trigger a sync or stop the session.`,
+		Example: fmt.Sprintf(attachExample, fullName),
+		Args:    cobra.MaximumNArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, testClientset, cmd, args)
+		},
+	}
+	clientset.Add(attachCmd,
+		clientset.FILESYSTEM,
+		clientset.STATE,
+	)
+	commonflags.UsePlatformFlag(attachCmd)
+	return attachCmd
+}
@@ -10,10 +10,14 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	apiserver_impl "github.com/redhat-developer/odo/pkg/apiserver-impl"
 
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	parsercommon "github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
+	dfutil "github.com/devfile/library/v2/pkg/util"
 	"github.com/spf13/cobra"
 	"k8s.io/klog/v2"
 	ktemplates "k8s.io/kubectl/pkg/util/templates"
@@ -22,10 +26,14 @@ import (
 	"github.com/redhat-developer/odo/pkg/api"
 	"github.com/redhat-developer/odo/pkg/component"
 	"github.com/redhat-developer/odo/pkg/dev"
+	devcommon "github.com/redhat-developer/odo/pkg/dev/common"
+	"github.com/redhat-developer/odo/pkg/dev/workspace"
+	"github.com/redhat-developer/odo/pkg/docker"
 	"github.com/redhat-developer/odo/pkg/kclient"
 	odolabels "github.com/redhat-developer/odo/pkg/labels"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
 	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/logs"
 	"github.com/redhat-developer/odo/pkg/odo/cli/messages"
 	"github.com/redhat-developer/odo/pkg/odo/cmdline"
 	"github.com/redhat-developer/odo/pkg/odo/commonflags"
@@ -35,6 +43,8 @@ import (
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
 	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
 	"github.com/redhat-developer/odo/pkg/podman"
+	"github.com/redhat-developer/odo/pkg/portForward"
+	"github.com/redhat-developer/odo/pkg/preference"
 	scontext "github.com/redhat-developer/odo/pkg/segment/context"
 	"github.com/redhat-developer/odo/pkg/state"
 	"github.com/redhat-developer/odo/pkg/util"
@@ -45,6 +55,10 @@ const (
 	RecommendedCommandName = "dev"
 )
 
+// applicationLogsBufferSize is the number of lines of logs waiting to be matched against the log patterns of the run command,
+// before the next ones are queued by the logs client
+const applicationLogsBufferSize = 1000
+
 type DevOptions struct {
 	// Clients
 	clientset *clientset.Clientset
@@ -54,6 +68,8 @@ type DevOptions struct {
 	out            io.Writer
 	errOut         io.Writer
 	forwardedPorts []api.ForwardedPort
+	// reverseForwardedPorts are the ports defined by the --reverse-port-forward flag
+	reverseForwardedPorts []api.ForwardedPort
 
 	// ctx is used to communicate with WatchAndPush to stop watching and start cleaning up
 	ctx context.Context
@@ -61,25 +77,50 @@ type DevOptions struct {
 	// cancel function ensures that any function/method listening on ctx.Done channel stops doing its work
 	cancel context.CancelFunc
 
+	// workspace is the content of the workspace file, when --workspace is set
+	workspace workspace.Workspace
+	// workspaceArgs are the flags passed to the Dev session of each component of the workspace
+	workspaceArgs []string
+	// workspaceIncompatibleFlagsSet are the flags set that cannot be used with --workspace
+	workspaceIncompatibleFlagsSet []string
+
 	// Flags
-	noWatchFlag          bool
-	randomPortsFlag      bool
-	debugFlag            bool
-	buildCommandFlag     string
-	runCommandFlag       string
-	ignoreLocalhostFlag  bool
-	forwardLocalhostFlag bool
-	portForwardFlag      []string
-	addressFlag          string
-	noCommandsFlag       bool
-	apiServerFlag        bool
-	apiServerPortFlag    int
-	syncGitDirFlag       bool
-	logsFlag             bool
+	noWatchFlag               bool
+	randomPortsFlag           bool
+	debugFlag                 bool
+	buildCommandFlag          string
+	runCommandFlag            string
+	ignoreLocalhostFlag       bool
+	forwardLocalhostFlag      bool
+	portForwardFlag           []string
+	reversePortForwardFlag    []string
+	addressFlag               string
+	noCommandsFlag            bool
+	apiServerFlag             bool
+	apiServerPortFlag         int
+	syncGitDirFlag            bool
+	logsFlag                  bool
+	recordLogsFlag            bool
+	recordLogsMaxSizeFlag     int
+	recordLogsMaxFilesFlag    int
+	recordLogsMaxSessionsFlag int
+	watchBackendFlag          string
+	pollingIntervalFlag       time.Duration
+	syncDelayFlag             time.Duration
+	autoRestartFlag           bool
+	maxRestartsFlag           int
+	detectPortsFlag           string
+	workspaceFlag             string
+
+	// syncDelayFlagSet is true if --sync-delay is set, to accept a zero delay
+	syncDelayFlagSet bool
+	// maxRestartsFlagSet is true if --max-restarts is set, to accept zero restarts
+	maxRestartsFlagSet bool
 }
 
 var _ genericclioptions.Runnable = (*DevOptions)(nil)
 var _ genericclioptions.SignalHandler = (*DevOptions)(nil)
+var _ genericclioptions.DevfileUser = (*DevOptions)(nil)
 
 func NewDevOptions() *DevOptions {
 	return &DevOptions{
@@ -98,8 +139,29 @@ var devExample = ktemplates.Examples(`
 	# Run your application on the cluster in the Dev mode, without automatically syncing the code upon any file changes
 	%[1]s --no-watch
 
+	# Run your application on the cluster in the Dev mode, syncing the changed files after 1 second without file changes
+	%[1]s --sync-delay 1s
+
+	# Run your application on the cluster in the Dev mode, restarting it at most 3 times when it stops
+	%[1]s --auto-restart --max-restarts 3
+
+	# Run your application on the cluster in the Dev mode, forwarding the ports opened by the application which are not declared as endpoints
+	%[1]s --detect-ports forward
+
+	# Run your application on the cluster in the Dev mode, following the logs of the containers and recording them into the .odo/logs directory
+	%[1]s --logs --record-logs
+
+	# Run your application on the cluster in the Dev mode, detecting the file changes by scanning the files every 2 seconds
+	%[1]s --watch-backend polling --polling-interval 2s
+
+	# Run the applications of all the components listed in a workspace file in the Dev mode
+	%[1]s --workspace odo-workspace.yaml
+
 	# Run your application on cluster in the Dev mode, using custom port-mapping for port-forwarding
 	%[1]s --port-forward 8080:3000 --port-forward 5000:runtime:5858
+
+	# Run your application on cluster in the Dev mode, reaching a database running locally on port 5432 from port 5432 in the container
+	%[1]s --reverse-port-forward 5432:5432
 `)
 
 func (o *DevOptions) SetClientset(clientset *clientset.Clientset) {
@@ -110,13 +172,27 @@ func (o *DevOptions) PreInit() string {
 	return messages.DevInitializeExistingComponent
 }
 
+// UseDevfile returns false when a workspace file is used, as the Devfiles are in the directories of the components
+func (o *DevOptions) UseDevfile(ctx context.Context, cmdline cmdline.Cmdline, args []string) bool {
+	return !cmdline.IsFlagSet(workspaceFlagName)
+}
+
 func (o *DevOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) error {
 	// Define this first so that if user hits Ctrl+c very soon after running odo dev, odo doesn't panic
 	o.ctx, o.cancel = context.WithCancel(ctx)
+	o.syncDelayFlagSet = cmdline.IsFlagSet("sync-delay")
+	o.maxRestartsFlagSet = cmdline.IsFlagSet("max-restarts")
+	if o.workspaceFlag != "" {
+		return o.completeWorkspace(cmdline)
+	}
 	return nil
 }
 
 func (o *DevOptions) Validate(ctx context.Context) error {
+	if o.workspaceFlag != "" {
+		return o.validateWorkspace()
+	}
+
 	devfileObj := *odocontext.GetEffectiveDevfileObj(ctx)
 	if o.noCommandsFlag {
 		if o.buildCommandFlag != "" || o.runCommandFlag != "" {
@@ -145,17 +221,49 @@ func (o *DevOptions) Validate(ctx context.Context) error {
 			return podman.NewPodmanNotFoundError(nil)
 		}
 		scontext.SetPlatform(ctx, o.clientset.PodmanClient)
+	case commonflags.PlatformDocker:
+		if o.ignoreLocalhostFlag && o.forwardLocalhostFlag {
+			return errors.New("--ignore-localhost and --forward-localhost cannot be used together")
+		}
+		if o.clientset.PodmanClient == nil {
+			return docker.NewDockerNotFoundError(nil)
+		}
+		scontext.SetPlatform(ctx, o.clientset.PodmanClient)
 	}
 
 	if o.randomPortsFlag && o.portForwardFlag != nil {
 		return errors.New("--random-ports and --port-forward cannot be used together")
 	}
+	if o.detectPortsFlag != "" && !dfutil.In(dev.DetectPortsValues, o.detectPortsFlag) {
+		return fmt.Errorf("--detect-ports must be one of %s", strings.Join(dev.DetectPortsValues, ", "))
+	}
+	if o.recordLogsMaxSizeFlag <= 0 {
+		return errors.New("--record-logs-max-size must be a positive number of MiB")
+	}
+	if o.recordLogsMaxFilesFlag < 0 {
+		return errors.New("--record-logs-max-files must not be negative")
+	}
+	if o.recordLogsMaxSessionsFlag < 0 {
+		return errors.New("--record-logs-max-sessions must not be negative")
+	}
 	// Validate the custom address and return an error (if any) early on, if we do not validate here, it will only throw an error at the stage of port forwarding.
 	if o.addressFlag != "" {
 		if err := validateCustomAddress(o.addressFlag); err != nil {
 			return err
 		}
 	}
+	if o.reversePortForwardFlag != nil {
+		reverseForwardedPorts, err := parseReversePortForwardFlag(o.reversePortForwardFlag, devfileObj)
+		if err != nil {
+			return err
+		}
+		o.reverseForwardedPorts = reverseForwardedPorts
+	}
+	// Validate the reverse forwarded ports defined in the Devfile early on
+	if _, err := portForward.GetReverseForwardedPorts(devfileObj, o.reverseForwardedPorts); err != nil {
+		return err
+	}
+
 	if o.portForwardFlag != nil {
 		containerEndpointMapping, err := libdevfile.GetDevfileContainerEndpointMapping(devfileObj, true)
 		if err != nil {
@@ -178,6 +286,26 @@ func (o *DevOptions) Validate(ctx context.Context) error {
 		return err
 	}
 
+	if o.watchBackendFlag != "" && !dfutil.In(preference.WatchBackendValues, o.watchBackendFlag) {
+		return fmt.Errorf("--watch-backend must be one of %s", strings.Join(preference.WatchBackendValues, ", "))
+	}
+
+	if o.pollingIntervalFlag != 0 && o.pollingIntervalFlag < time.Second {
+		return errors.New("--polling-interval must be at least 1s")
+	}
+
+	if o.syncDelayFlag < 0 {
+		return errors.New("--sync-delay must be a positive duration")
+	}
+
+	if o.maxRestartsFlagSet && !o.autoRestartFlag {
+		return errors.New("--max-restarts makes sense only if --auto-restart is enabled")
+	}
+
+	if o.maxRestartsFlag < 0 {
+		return errors.New("--max-restarts must be a positive integer")
+	}
+
 	if !o.apiServerFlag && o.apiServerPortFlag != 0 {
 		return errors.New("--api-server-port makes sense only if --api-server is enabled")
 	}
@@ -195,6 +323,10 @@ func (o *DevOptions) Validate(ctx context.Context) error {
 }
 
 func (o *DevOptions) Run(ctx context.Context) (err error) {
+	if o.workspaceFlag != "" {
+		return o.runWorkspace(o.ctx)
+	}
+
 	var (
 		devFileObj    = odocontext.GetEffectiveDevfileObj(ctx)
 		devfilePath   = odocontext.GetDevfilePath(ctx)
@@ -209,7 +341,13 @@ func (o *DevOptions) Run(ctx context.Context) (err error) {
 	switch platform {
 	case commonflags.PlatformPodman:
 		dest = "Platform: podman"
+		if connection := o.clientset.PodmanClient.GetConnection(); connection != "" {
+			dest += ", Connection: " + connection
+		}
 		deployingTo = "podman"
+	case commonflags.PlatformDocker:
+		dest = "Platform: docker"
+		deployingTo = "docker"
 	case commonflags.PlatformCluster:
 		dest = "Namespace: " + odocontext.GetNamespace(ctx)
 		deployingTo = "the cluster"
@@ -261,8 +399,18 @@ func (o *DevOptions) Run(ctx context.Context) (err error) {
 		err = fmt.Errorf("unable to save state file: %w", err)
 		return err
 	}
+	if platform == commonflags.PlatformPodman {
+		// Record the connection, so other commands target the same Podman service as the session
+		err = o.clientset.StateClient.SetPodmanConnection(ctx, o.clientset.PodmanClient.GetConnection())
+		if err != nil {
+			return fmt.Errorf("unable to save state file: %w", err)
+		}
+	}
 
-	var apiServer apiserver_impl.ApiServer
+	var (
+		apiServer      apiserver_impl.ApiServer
+		eventsNotifier dev.EventsNotifier
+	)
 	if o.apiServerFlag {
 		var devfileFiles []string
 		devfileFiles, err = libdevfile.GetReferencedLocalFiles(*devFileObj)
@@ -282,20 +430,81 @@ func (o *DevOptions) Run(ctx context.Context) (err error) {
 			o.clientset.KubernetesClient,
 			o.clientset.PodmanClient,
 			o.clientset.StateClient,
+			o.clientset.PortForwardClient,
 			o.clientset.PreferenceClient,
 			o.clientset.InformerClient,
 		)
 		if err != nil {
 			return err
 		}
+		// Mirror the output of the session to the subscribers of the API Server notifications, for 'odo dev attach'
+		o.out = io.MultiWriter(o.out, apiServer.Notifier.OutputWriter())
+		eventsNotifier = apiServer.Notifier
 	}
 
-	if o.logsFlag {
+	// The logs are followed to detect the readiness and the errors of the application, when the run (or debug) command declares log patterns
+	var applicationLogs chan api.ContainerLogLine
+	if !o.noCommandsFlag {
+		var patterns *devcommon.LogPatterns
+		patterns, err = devcommon.GetRunCommandLogPatterns(*devFileObj, dev.StartOptions{Debug: o.debugFlag, RunCommand: o.runCommandFlag})
+		if err != nil {
+			return err
+		}
+		if patterns != nil {
+			applicationLogs = make(chan api.ContainerLogLine, applicationLogsBufferSize)
+		}
+	}
+
+	var (
+		recorder *logs.Recorder
+		// commandsRecorder is kept nil when not recording, as a nil *logs.Recorder would not be a nil interface
+		commandsRecorder dev.CommandsRecorder
+	)
+	if o.logsFlag || o.recordLogsFlag || applicationLogs != nil {
+		if o.recordLogsFlag {
+			workingDir := odocontext.GetWorkingDirectory(ctx)
+			if o.recordLogsMaxSessionsFlag > 0 {
+				// Make room for the logs of the new session
+				err = logs.PruneSessions(o.clientset.FS, workingDir, o.recordLogsMaxSessionsFlag-1)
+				if err != nil {
+					log.Warningf("unable to delete the logs of the previous sessions: %v", err)
+				}
+			}
+			session := logs.NewSessionID(odocontext.GetPID(ctx), time.Now())
+			recorder = logs.NewRecorder(
+				o.clientset.FS,
+				filepath.Join(logs.GetSessionsDirectory(workingDir), session),
+				int64(o.recordLogsMaxSizeFlag)*1024*1024,
+				o.recordLogsMaxFilesFlag,
+			)
+			log.Finfof(o.out, "Recording the logs of the containers for the session %s; run 'odo logs --session %s' to display them", session, session)
+			commandsRecorder = recorder
+		}
 		go func() {
-			_ = o.followLogs(ctx)
+			_ = o.followLogs(ctx, recorder, applicationLogs)
+			if recorder != nil {
+				_ = recorder.Close()
+			}
 		}()
 	}
 
+	watchBackend := o.watchBackendFlag
+	if watchBackend == "" {
+		watchBackend = o.clientset.PreferenceClient.GetWatchBackend()
+	}
+	pollingInterval := o.pollingIntervalFlag
+	if pollingInterval == 0 {
+		pollingInterval = o.clientset.PreferenceClient.GetWatchPollingInterval()
+	}
+	syncDelay := o.syncDelayFlag
+	if !o.syncDelayFlagSet {
+		syncDelay = o.clientset.PreferenceClient.GetSyncDelay()
+	}
+	maxRestarts := o.maxRestartsFlag
+	if !o.maxRestartsFlagSet {
+		maxRestarts = o.clientset.PreferenceClient.GetMaxRestarts()
+	}
+
 	o.clientset.InformerClient.AppendInfo(log.Sbold("Keyboard Commands:") + "\n" +
 		"[Ctrl+c] - Exit and delete resources from " + deployingTo + "\n" +
 		"     [p] - Manually apply local changes to the application on " + deployingTo + "\n")
@@ -303,27 +512,41 @@ func (o *DevOptions) Run(ctx context.Context) (err error) {
 	return o.clientset.DevClient.Start(
 		o.ctx,
 		dev.StartOptions{
-			IgnorePaths:          o.ignorePaths,
-			Debug:                o.debugFlag,
-			BuildCommand:         o.buildCommandFlag,
-			RunCommand:           o.runCommandFlag,
-			SkipCommands:         o.noCommandsFlag,
-			RandomPorts:          o.randomPortsFlag,
-			WatchFiles:           !o.noWatchFlag,
-			IgnoreLocalhost:      o.ignoreLocalhostFlag,
-			ForwardLocalhost:     o.forwardLocalhostFlag,
-			Variables:            variables,
-			CustomForwardedPorts: o.forwardedPorts,
-			CustomAddress:        o.addressFlag,
-			PushWatcher:          apiServer.PushWatcher,
-			Out:                  o.out,
-			ErrOut:               o.errOut,
+			IgnorePaths:                 o.ignorePaths,
+			Debug:                       o.debugFlag,
+			BuildCommand:                o.buildCommandFlag,
+			RunCommand:                  o.runCommandFlag,
+			SkipCommands:                o.noCommandsFlag,
+			RandomPorts:                 o.randomPortsFlag,
+			WatchFiles:                  !o.noWatchFlag,
+			WatchBackend:                watchBackend,
+			WatchPollingInterval:        pollingInterval,
+			SyncDelay:                   syncDelay,
+			AutoRestart:                 o.autoRestartFlag,
+			MaxRestarts:                 maxRestarts,
+			DetectPorts:                 o.detectPortsFlag,
+			IgnoreLocalhost:             o.ignoreLocalhostFlag,
+			ForwardLocalhost:            o.forwardLocalhostFlag,
+			Variables:                   variables,
+			CustomForwardedPorts:        o.forwardedPorts,
+			CustomAddress:               o.addressFlag,
+			CustomReverseForwardedPorts: o.reverseForwardedPorts,
+			PushWatcher:                 apiServer.PushWatcher,
+			EventsNotifier:              eventsNotifier,
+			ApplicationLogs:             applicationLogs,
+			CommandsRecorder:            commandsRecorder,
+			Out:                         o.out,
+			ErrOut:                      o.errOut,
 		},
 	)
 }
 
+// followLogs follows the logs of the containers of the component, displaying them if --logs is set,
+// recording them with recorder, if not nil, and sending them to lines, if not nil
 func (o *DevOptions) followLogs(
 	ctx context.Context,
+	recorder *logs.Recorder,
+	lines chan<- api.ContainerLogLine,
 ) error {
 	var (
 		componentName = odocontext.GetComponentName(ctx)
@@ -334,13 +557,18 @@ func (o *DevOptions) followLogs(
 		ns = odocontext.GetNamespace(ctx)
 	}
 
+	out := o.out
+	if !o.logsFlag {
+		out = io.Discard
+	}
+
 	return o.clientset.LogsClient.DisplayLogs(
 		ctx,
 		odolabels.ComponentDevMode,
 		componentName,
 		ns,
-		true,
-		o.out,
+		logs.Options{Follow: true, Recorder: recorder, Lines: lines},
+		out,
 	)
 }
 
@@ -364,6 +592,10 @@ func (o *DevOptions) HandleSignal(ctx context.Context, cancelFunc context.Cancel
 }
 
 func (o *DevOptions) Cleanup(ctx context.Context, commandError error) error {
+	if o.workspaceFlag != "" {
+		// the resources are deleted by the Dev session of each component
+		return commandError
+	}
 	if errors.As(commandError, &state.ErrAlreadyRunningOnPlatform{}) {
 		klog.V(4).Info("session already running, no need to cleanup")
 		return commandError
@@ -404,6 +636,17 @@ It forwards endpoints with any exposure values ('public', 'internal' or 'none')
 		},
 	}
 	devCmd.Flags().BoolVar(&o.noWatchFlag, "no-watch", false, "Do not watch for file changes")
+	devCmd.Flags().StringVar(&o.watchBackendFlag, "watch-backend", "",
+		fmt.Sprintf("Mechanism used to detect file changes, one of %s. The WatchBackend preference is used if this flag is not set.", strings.Join(preference.WatchBackendValues, ", ")))
+	devCmd.Flags().DurationVar(&o.pollingIntervalFlag, "polling-interval", 0,
+		"Interval between two scans of the files, when the polling watch backend is used. The WatchPollingInterval preference is used if this flag is not set.")
+	devCmd.Flags().DurationVar(&o.syncDelayFlag, "sync-delay", 0,
+		"Period without file changes after which the changed files are synced, 0 to sync the changes immediately. The SyncDelay preference is used if this flag is not set.")
+	devCmd.Flags().BoolVar(&o.autoRestartFlag, "auto-restart", false, "Check regularly that the run command is running, and restart it when it stops")
+	devCmd.Flags().IntVar(&o.maxRestartsFlag, "max-restarts", 0,
+		"Maximum number of restarts of the run command between two pushes, when --auto-restart is enabled. The MaxRestarts preference is used if this flag is not set.")
+	devCmd.Flags().StringVar(&o.detectPortsFlag, "detect-ports", "",
+		fmt.Sprintf("Scan regularly the ports listening in the component, and report (%s) or forward (%s) the ones not declared as endpoints in the Devfile.", dev.DetectPortsNotify, dev.DetectPortsForward))
 	devCmd.Flags().BoolVar(&o.randomPortsFlag, "random-ports", false, "Assign random ports to redirected ports")
 	devCmd.Flags().BoolVar(&o.debugFlag, "debug", false, "Execute the debug command within the component")
 	devCmd.Flags().StringVar(&o.buildCommandFlag, "build-command", "",
@@ -416,11 +659,23 @@ It forwards endpoints with any exposure values ('public', 'internal' or 'none')
 		"Whether to enable port-forwarding if app is listening on the container loopback interface. Applicable only if platform is podman.")
 	devCmd.Flags().StringArrayVar(&o.portForwardFlag, "port-forward", nil,
 		"Define custom port mapping for port forwarding. Acceptable formats: LOCAL_PORT:REMOTE_PORT, LOCAL_PORT:CONTAINER_NAME:REMOTE_PORT.")
+	devCmd.Flags().StringArrayVar(&o.reversePortForwardFlag, "reverse-port-forward", nil,
+		"Define a port inside the container tunneling back to a local address. Acceptable formats: [LOCAL_ADDRESS:]LOCAL_PORT:[CONTAINER_NAME:]REMOTE_PORT.")
 	devCmd.Flags().StringVar(&o.addressFlag, "address", "127.0.0.1", "Define custom address for port forwarding.")
 	devCmd.Flags().BoolVar(&o.noCommandsFlag, "no-commands", false, "Do not run any commands; just start the development environment.")
 	devCmd.Flags().BoolVar(&o.syncGitDirFlag, "sync-git-dir", false, "Synchronize the .git directory to the container. By default, this directory is not synchronized.")
 	devCmd.Flags().BoolVar(&o.logsFlag, "logs", false, "Follow logs of component")
+	devCmd.Flags().BoolVar(&o.recordLogsFlag, "record-logs", false,
+		"Record the logs of the containers and the output of the Devfile commands into .odo/logs, to display them later with 'odo logs --session', even after the component is deleted")
+	devCmd.Flags().IntVar(&o.recordLogsMaxSizeFlag, "record-logs-max-size", logs.DefaultMaxLogFileSize/(1024*1024),
+		"Size, in MiB, above which a log file recorded by --record-logs is rotated")
+	devCmd.Flags().IntVar(&o.recordLogsMaxFilesFlag, "record-logs-max-files", logs.DefaultMaxLogFiles,
+		"Number of rotated log files retained for each container by --record-logs, in addition to the current one")
+	devCmd.Flags().IntVar(&o.recordLogsMaxSessionsFlag, "record-logs-max-sessions", logs.DefaultMaxSessions,
+		"Number of sessions whose logs recorded by --record-logs are retained, including the current one, 0 to retain all the sessions")
 	devCmd.Flags().BoolVar(&o.apiServerFlag, "api-server", true, "Start the API Server")
+	devCmd.Flags().StringVar(&o.workspaceFlag, workspaceFlagName, "",
+		"Workspace file listing the directories of components to run together in the Dev mode, each in its own Dev session.")
 	devCmd.Flags().IntVar(&o.apiServerPortFlag, "api-server-port", 0, "Define custom port for API Server; this flag should be used in combination with --api-server flag.")
 
 	clientset.Add(devCmd,
@@ -439,6 +694,9 @@ It forwards endpoints with any exposure values ('public', 'internal' or 'none')
 		clientset.SYNC,
 		clientset.WATCH,
 	)
+	attachCmd := NewCmdDevAttach(ctx, AttachRecommendedCommandName, odoutil.GetFullName(fullName, AttachRecommendedCommandName), testClientset)
+	devCmd.AddCommand(attachCmd)
+
 	// Add a defined annotation in order to appear in the help menu
 	odoutil.SetCommandGroup(devCmd, odoutil.MainGroup)
 	devCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
@@ -557,6 +815,39 @@ func parsePortForwardFlag(portForwardFlag []string) (forwardedPorts []api.Forwar
 	return forwardedPorts, nil
 }
 
+// parseReversePortForwardFlag parses the ports defined by the --reverse-port-forward flag,
+// and checks that their containers exist in the Devfile and that their container ports are unique
+func parseReversePortForwardFlag(reversePortForwardFlag []string, devfileObj parser.DevfileObj) ([]api.ForwardedPort, error) {
+	containers, err := devfileObj.Data.GetComponents(parsercommon.DevfileOptions{
+		ComponentOptions: parsercommon.ComponentOptions{ComponentType: v1alpha2.ContainerComponentType},
+	})
+	if err != nil {
+		return nil, err
+	}
+	containerNames := make([]string, 0, len(containers))
+	for _, container := range containers {
+		containerNames = append(containerNames, container.Name)
+	}
+
+	var result []api.ForwardedPort
+	containerPorts := make(map[int]struct{})
+	for _, definition := range reversePortForwardFlag {
+		port, err := portForward.ParseReversePort(definition)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for --reverse-port-forward flag: %w", err)
+		}
+		if port.ContainerName != "" && !dfutil.In(containerNames, port.ContainerName) {
+			return nil, fmt.Errorf("invalid value for --reverse-port-forward flag: container %q not found in the devfile", port.ContainerName)
+		}
+		if _, found := containerPorts[port.ContainerPort]; found {
+			return nil, fmt.Errorf("invalid value for --reverse-port-forward flag: container port %d is used more than once", port.ContainerPort)
+		}
+		containerPorts[port.ContainerPort] = struct{}{}
+		result = append(result, port)
+	}
+	return result, nil
+}
+
 // validateCustomAddress validates if the provided ip address is valid;
 // it uses the same checks as defined by func parseAddresses() in "k8s.io/client-go/tools/portforward"
 func validateCustomAddress(address string) error {
@@ -3,6 +3,8 @@ package dev
 import (
 	"fmt"
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/redhat-developer/odo/pkg/api"
@@ -330,6 +332,64 @@ func Test_parsePortForwardFlag(t *testing.T) {
 	}
 }
 
+func Test_parseReversePortForwardFlag(t *testing.T) {
+	tests := []struct {
+		name                   string
+		reversePortForwardFlag []string
+		want                   []api.ForwardedPort
+		wantErr                bool
+	}{
+		{
+			name:                   "ports with and without container name",
+			reversePortForwardFlag: []string{"5432:5432", "localhost:8081:runtime:18081"},
+			want: []api.ForwardedPort{
+				{IsReverse: true, LocalAddress: "127.0.0.1", LocalPort: 5432, ContainerPort: 5432},
+				{IsReverse: true, LocalAddress: "localhost", LocalPort: 8081, ContainerName: "runtime", ContainerPort: 18081},
+			},
+		},
+		{
+			name:                   "invalid format",
+			reversePortForwardFlag: []string{"5432"},
+			wantErr:                true,
+		},
+		{
+			name:                   "container not found in the devfile",
+			reversePortForwardFlag: []string{"5432:tools:5432"},
+			wantErr:                true,
+		},
+		{
+			name:                   "container port used more than once",
+			reversePortForwardFlag: []string{"5432:5432", "15432:runtime:5432"},
+			wantErr:                true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			devfileData, err := data.NewDevfileData(string(data.APISchemaVersion200))
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = devfileData.AddComponents([]v1alpha2.Component{{
+				Name: "runtime",
+				ComponentUnion: v1alpha2.ComponentUnion{
+					Container: &v1alpha2.ContainerComponent{Container: v1alpha2.Container{Image: "image"}},
+				},
+			}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := parseReversePortForwardFlag(tt.reversePortForwardFlag, parser.DevfileObj{Data: devfileData})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseReversePortForwardFlag() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("parseReversePortForwardFlag() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func Test_validateCustomAddress(t *testing.T) {
 	type args struct {
 		address string
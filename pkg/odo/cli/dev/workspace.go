@@ -0,0 +1,136 @@
+package dev
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+
+	"github.com/redhat-developer/odo/pkg/dev/workspace"
+	"github.com/redhat-developer/odo/pkg/devfile"
+	"github.com/redhat-developer/odo/pkg/devfile/location"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+)
+
+// workspaceFlagName is the name of the flag defining the workspace file
+const workspaceFlagName = "workspace"
+
+// workspaceForwardedFlags are the flags passed to the Dev session of each component of a workspace
+var workspaceForwardedFlags = []string{
+	commonflags.PlatformFlagName,
+	"no-watch",
+	"watch-backend",
+	"polling-interval",
+	"sync-delay",
+	"auto-restart",
+	"max-restarts",
+	"debug",
+	"build-command",
+	"run-command",
+	"ignore-localhost",
+	"forward-localhost",
+	"address",
+	"no-commands",
+	"sync-git-dir",
+	"logs",
+	"api-server",
+}
+
+// workspaceIncompatibleFlags are the flags that cannot be used with a workspace, as their values depend on each component
+var workspaceIncompatibleFlags = []string{
+	"port-forward",
+	"reverse-port-forward",
+	"api-server-port",
+	commonflags.VarFlagName,
+	commonflags.VarFileFlagName,
+}
+
+// completeWorkspace reads the workspace file, and the flags to pass to the Dev session of each component
+func (o *DevOptions) completeWorkspace(cmdline cmdline.Cmdline) error {
+	var err error
+	o.workspace, err = workspace.ParseFile(o.clientset.FS, o.workspaceFlag)
+	if err != nil {
+		return err
+	}
+
+	flags := cmdline.GetFlags()
+	o.workspaceArgs = nil
+	for _, name := range workspaceForwardedFlags {
+		if value, found := flags[name]; found {
+			o.workspaceArgs = append(o.workspaceArgs, fmt.Sprintf("--%s=%s", name, value))
+		}
+	}
+	for _, name := range workspaceIncompatibleFlags {
+		if _, found := flags[name]; found {
+			o.workspaceIncompatibleFlagsSet = append(o.workspaceIncompatibleFlagsSet, "--"+name)
+		}
+	}
+	return nil
+}
+
+func (o *DevOptions) validateWorkspace() error {
+	if len(o.workspaceIncompatibleFlagsSet) != 0 {
+		sort.Strings(o.workspaceIncompatibleFlagsSet)
+		return fmt.Errorf("--%s cannot be used with %s; set them in the args of each component in the workspace file instead",
+			workspaceFlagName, strings.Join(o.workspaceIncompatibleFlagsSet, ", "))
+	}
+	for _, component := range o.workspace.Components {
+		for _, arg := range component.Args {
+			for _, name := range []string{"port-forward", "api-server-port", "random-ports"} {
+				if arg == "--"+name || strings.HasPrefix(arg, "--"+name+"=") {
+					return fmt.Errorf("the args of component %q cannot contain --%s, the ports are assigned by odo for all the components of the workspace",
+						component.Name, name)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// runWorkspace starts the Dev sessions of all the components of the workspace, and waits for them to exit
+func (o *DevOptions) runWorkspace(ctx context.Context) error {
+	components := o.workspace.Components
+
+	// The ports, random or not, are assigned by the same allocator for all the sessions, including the ports of the API Servers,
+	// so they do not collide
+	endpoints := make([]map[string][]v1alpha2.Endpoint, len(components))
+	for i, component := range components {
+		devfileObj, err := devfile.ParseAndValidateFromFileWithVariables(
+			location.DevfileLocation(o.clientset.FS, component.Path), nil, o.clientset.PreferenceClient.GetImageRegistry(), true)
+		if err != nil {
+			return fmt.Errorf("unable to read the Devfile of component %q: %w", component.Name, err)
+		}
+		endpoints[i], err = libdevfile.GetDevfileContainerEndpointMapping(devfileObj, o.debugFlag)
+		if err != nil {
+			return err
+		}
+	}
+	allocatedArgs, err := workspace.AllocatePorts(endpoints, o.addressFlag, o.apiServerFlag, o.randomPortsFlag)
+	if err != nil {
+		return err
+	}
+
+	sessions := make([]workspace.Session, len(components))
+	var names []string
+	for i, component := range components {
+		args := append([]string{RecommendedCommandName}, o.workspaceArgs...)
+		args = append(args, allocatedArgs[i]...)
+		args = append(args, component.Args...)
+		sessions[i] = workspace.Session{
+			Component: component,
+			Args:      args,
+		}
+		names = append(names, component.Name)
+	}
+
+	log.Title(fmt.Sprintf("Developing the components of the workspace %q", o.workspaceFlag), strings.Join(names, ", "))
+	fmt.Fprintln(o.out, log.Sbold("Keyboard Commands:")+"\n"+
+		"[Ctrl+c] - Exit and delete the resources of all the components")
+
+	return workspace.Run(ctx, workspace.OdoPath(), sessions, o.out)
+}
@@ -110,17 +110,19 @@ func (lo *ListOptions) run(ctx context.Context) (api.ResourcesList, error) {
 
 		kubeClient   = lo.clientset.KubernetesClient
 		podmanClient = lo.clientset.PodmanClient
+		dockerClient = lo.clientset.DockerClient
 	)
 
 	switch fcontext.GetPlatform(ctx, "") {
 	case commonflags.PlatformCluster:
 		podmanClient = nil
-	case commonflags.PlatformPodman:
+	case commonflags.PlatformPodman, commonflags.PlatformDocker:
+		// On the docker platform, the Podman client is the Docker client
 		kubeClient = nil
 	}
 
 	allComponents, componentInDevfile, err := component.ListAllComponents(
-		kubeClient, podmanClient, lo.namespaceFilter, devfileObj, componentName)
+		kubeClient, podmanClient, dockerClient, lo.namespaceFilter, devfileObj, componentName)
 	if err != nil {
 		return api.ResourcesList{}, err
 	}
@@ -156,7 +158,7 @@ func NewCmdComponentList(ctx context.Context, name, fullName string, testClients
 	}
 	clientset.Add(listCmd, clientset.KUBERNETES_NULLABLE, clientset.FILESYSTEM)
 	if feature.IsEnabled(ctx, feature.GenericPlatformFlag) {
-		clientset.Add(listCmd, clientset.PODMAN_NULLABLE)
+		clientset.Add(listCmd, clientset.PODMAN_NULLABLE, clientset.DOCKER_NULLABLE)
 	}
 	listCmd.Flags().StringVar(&o.namespaceFlag, "namespace", "", "Namespace for odo to scan for components")
 
@@ -110,17 +110,19 @@ func (lo *ListOptions) run(ctx context.Context) (list api.ResourcesList, err err
 
 		kubeClient   = lo.clientset.KubernetesClient
 		podmanClient = lo.clientset.PodmanClient
+		dockerClient = lo.clientset.DockerClient
 	)
 
 	switch fcontext.GetPlatform(ctx, "") {
 	case commonflags.PlatformCluster:
 		podmanClient = nil
-	case commonflags.PlatformPodman:
+	case commonflags.PlatformPodman, commonflags.PlatformDocker:
+		// On the docker platform, the Podman client is the Docker client
 		kubeClient = nil
 	}
 
 	allComponents, componentInDevfile, err := component.ListAllComponents(
-		kubeClient, podmanClient, lo.namespaceFilter, devfileObj, componentName)
+		kubeClient, podmanClient, dockerClient, lo.namespaceFilter, devfileObj, componentName)
 	if err != nil {
 		return api.ResourcesList{}, err
 	}
@@ -167,7 +169,7 @@ func NewCmdList(ctx context.Context, name, fullName string, testClientset client
 	}
 	clientset.Add(listCmd, clientset.KUBERNETES_NULLABLE, clientset.BINDING, clientset.FILESYSTEM)
 	if feature.IsEnabled(ctx, feature.GenericPlatformFlag) {
-		clientset.Add(listCmd, clientset.PODMAN_NULLABLE)
+		clientset.Add(listCmd, clientset.PODMAN_NULLABLE, clientset.DOCKER_NULLABLE)
 	}
 
 	namespaceCmd := namespace.NewCmdNamespaceList(namespace.RecommendedCommandName, odoutil.GetFullName(fullName, namespace.RecommendedCommandName), testClientset)
@@ -5,9 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"regexp"
+	"time"
 
+	"github.com/redhat-developer/odo/pkg/docker"
 	"github.com/redhat-developer/odo/pkg/kclient"
 	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/logs"
 	"github.com/redhat-developer/odo/pkg/podman"
 
 	"github.com/redhat-developer/odo/pkg/log"
@@ -40,6 +44,15 @@ type LogsOptions struct {
 	devMode    bool
 	deployMode bool
 	follow     bool
+	since      time.Duration
+	tail       int64
+	timestamps bool
+	container  string
+	grep       string
+	session    string
+
+	// grepRegexp is the compiled regular expression passed with --grep
+	grepRegexp *regexp.Regexp
 }
 
 var _ genericclioptions.Runnable = (*LogsOptions)(nil)
@@ -61,6 +74,18 @@ func NewLogsOptions() *LogsOptions {
 var logsExample = ktemplates.Examples(`
 	# Show logs of all containers
 	%[1]s
+
+	# Show the last 20 lines of the logs of the runtime container, with their timestamps
+	%[1]s --container runtime --tail 20 --timestamps
+
+	# Follow the logs of the last 10 minutes containing errors
+	%[1]s --follow --since 10m --grep "(?i)error"
+
+	# Show logs as JSON objects, one per line
+	%[1]s -o json
+
+	# Show the logs recorded by a session of 'odo dev --record-logs'
+	%[1]s --session 20231018-153000-12345
 `)
 
 func (o *LogsOptions) SetClientset(clientset *clientset.Clientset) {
@@ -87,6 +112,14 @@ func (o *LogsOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, _ [
 
 func (o *LogsOptions) Validate(ctx context.Context) error {
 
+	if o.session != "" {
+		// the recorded logs are displayed without connecting to the platform
+		if o.follow || o.deployMode {
+			return errors.New("--session cannot be used with --follow or --deploy")
+		}
+		return o.validateFilters()
+	}
+
 	switch fcontext.GetPlatform(ctx, commonflags.PlatformCluster) {
 	case commonflags.PlatformCluster:
 		if o.clientset.KubernetesClient == nil {
@@ -96,11 +129,33 @@ func (o *LogsOptions) Validate(ctx context.Context) error {
 		if o.clientset.PodmanClient == nil {
 			return podman.NewPodmanNotFoundError(nil)
 		}
+	case commonflags.PlatformDocker:
+		if o.clientset.PodmanClient == nil {
+			return docker.NewDockerNotFoundError(nil)
+		}
 	}
 
 	if o.devMode && o.deployMode {
 		return errors.New("pass only one of --dev or --deploy flags; pass no flag to see logs for both modes")
 	}
+	return o.validateFilters()
+}
+
+// validateFilters validates the flags filtering the logs, and compiles the regular expression of --grep
+func (o *LogsOptions) validateFilters() error {
+	if o.since < 0 {
+		return errors.New("--since must be a positive duration")
+	}
+	if o.tail < -1 {
+		return errors.New("--tail must be a positive number of lines, or -1 to show all lines")
+	}
+	if o.grep != "" {
+		var err error
+		o.grepRegexp, err = regexp.Compile(o.grep)
+		if err != nil {
+			return fmt.Errorf("invalid regular expression for --grep: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -109,6 +164,22 @@ func (o *LogsOptions) Run(ctx context.Context) error {
 
 	componentName := odocontext.GetComponentName(ctx)
 
+	options := logs.Options{
+		Follow:     o.follow,
+		Since:      o.since,
+		Timestamps: o.timestamps,
+		Container:  o.container,
+		Grep:       o.grepRegexp,
+		JSON:       log.IsJSON(),
+	}
+	if o.tail >= 0 {
+		options.Tail = &o.tail
+	}
+
+	if o.session != "" {
+		return o.clientset.LogsClient.DisplaySessionLogs(ctx, o.session, options, o.out)
+	}
+
 	if o.devMode {
 		logMode = DevMode
 	} else if o.deployMode {
@@ -135,7 +206,7 @@ func (o *LogsOptions) Run(ctx context.Context) error {
 		mode,
 		componentName,
 		ns,
-		o.follow,
+		options,
 		o.out,
 	)
 }
@@ -151,7 +222,8 @@ func NewCmdLogs(name, fullname string, testClientset clientset.Clientset) *cobra
 		Use:   name,
 		Short: "Show logs of all containers of the component",
 		Long: `odo logs shows logs of all containers of the component. 
-By default it shows logs of all containers running in both Dev and Deploy mode. It prefixes each log message with the container name.`,
+By default it shows logs of all containers running in both Dev and Deploy mode. It prefixes each log message with the container name.
+With -o json, each log line is output as a JSON object, with the pod, container, mode, timestamp and message fields.`,
 		Example: fmt.Sprintf(logsExample, fullname),
 		Args:    cobra.MaximumNArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -161,10 +233,17 @@ By default it shows logs of all containers running in both Dev and Deploy mode.
 	logsCmd.Flags().BoolVar(&o.devMode, string(DevMode), false, "Show logs for containers running only in Dev mode")
 	logsCmd.Flags().BoolVar(&o.deployMode, string(DeployMode), false, "Show logs for containers running only in Deploy mode")
 	logsCmd.Flags().BoolVar(&o.follow, "follow", false, "Follow/tail the logs of the pods")
+	logsCmd.Flags().DurationVar(&o.since, "since", 0, "Show only logs more recent than a relative duration like 5s, 2m, or 3h; defaults to all logs")
+	logsCmd.Flags().Int64Var(&o.tail, "tail", -1, "Number of lines from the end of the logs to show for each container; defaults to -1, showing all lines")
+	logsCmd.Flags().BoolVar(&o.timestamps, "timestamps", false, "Show the timestamp of each log line")
+	logsCmd.Flags().StringVar(&o.container, "container", "", "Show logs only for the container with this name")
+	logsCmd.Flags().StringVar(&o.grep, "grep", "", "Show only the log lines matching this regular expression")
+	logsCmd.Flags().StringVar(&o.session, "session", "", "Show the logs recorded by the session with this identifier, using 'odo dev --record-logs'")
 
 	clientset.Add(logsCmd, clientset.LOGS, clientset.FILESYSTEM)
 	util.SetCommandGroup(logsCmd, util.MainGroup)
 	logsCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
 	commonflags.UsePlatformFlag(logsCmd)
+	commonflags.UseOutputFlag(logsCmd)
 	return logsCmd
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
 	"github.com/spf13/cobra"
 
+	"github.com/redhat-developer/odo/pkg/docker"
 	"github.com/redhat-developer/odo/pkg/kclient"
 	"github.com/redhat-developer/odo/pkg/odo/cli/errors"
 	"github.com/redhat-developer/odo/pkg/odo/cmdline"
@@ -88,6 +89,12 @@ func (o *RunOptions) Validate(ctx context.Context) error {
 			return podman.NewPodmanNotFoundError(nil)
 		}
 		scontext.SetPlatform(ctx, o.clientset.PodmanClient)
+
+	case commonflags.PlatformDocker:
+		if o.clientset.PodmanClient == nil {
+			return docker.NewDockerNotFoundError(nil)
+		}
+		scontext.SetPlatform(ctx, o.clientset.PodmanClient)
 	}
 	return nil
 }
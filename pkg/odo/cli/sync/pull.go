@@ -0,0 +1,178 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
+	"github.com/spf13/cobra"
+
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	devcommon "github.com/redhat-developer/odo/pkg/dev/common"
+	"github.com/redhat-developer/odo/pkg/docker"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	fcontext "github.com/redhat-developer/odo/pkg/odo/commonflags/context"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	"github.com/redhat-developer/odo/pkg/platform"
+	"github.com/redhat-developer/odo/pkg/podman"
+	scontext "github.com/redhat-developer/odo/pkg/segment/context"
+	"github.com/redhat-developer/odo/pkg/sync"
+)
+
+// PullRecommendedCommandName is the recommended pull sub-command name
+const PullRecommendedCommandName = "pull"
+
+var pullExample = ktemplates.Examples(`
+	# Pull the paths listed in the "dev.odo.pull.paths" attribute of the container component
+	%[1]s
+
+	# Pull a file and a directory from the project directory in the container
+	%[1]s package-lock.json generated/
+`)
+
+type PullOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+
+	// Args
+	paths []string
+}
+
+var _ genericclioptions.Runnable = (*PullOptions)(nil)
+
+func NewPullOptions() *PullOptions {
+	return &PullOptions{}
+}
+
+func (o *PullOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+func (o *PullOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) error {
+	o.paths = args
+	return nil
+}
+
+func (o *PullOptions) Validate(ctx context.Context) error {
+	devfileObj := odocontext.GetEffectiveDevfileObj(ctx)
+	if devfileObj == nil {
+		return genericclioptions.NewNoDevfileError(odocontext.GetWorkingDirectory(ctx))
+	}
+
+	switch fcontext.GetPlatform(ctx, commonflags.PlatformCluster) {
+
+	case commonflags.PlatformCluster:
+		if o.clientset.KubernetesClient == nil {
+			return kclient.NewNoConnectionError()
+		}
+		scontext.SetPlatform(ctx, o.clientset.KubernetesClient)
+
+	case commonflags.PlatformPodman:
+		if o.clientset.PodmanClient == nil {
+			return podman.NewPodmanNotFoundError(nil)
+		}
+		scontext.SetPlatform(ctx, o.clientset.PodmanClient)
+
+	case commonflags.PlatformDocker:
+		if o.clientset.PodmanClient == nil {
+			return docker.NewDockerNotFoundError(nil)
+		}
+		scontext.SetPlatform(ctx, o.clientset.PodmanClient)
+	}
+	return nil
+}
+
+func (o *PullOptions) Run(ctx context.Context) error {
+	var (
+		componentName = odocontext.GetComponentName(ctx)
+		devfileObj    = odocontext.GetEffectiveDevfileObj(ctx)
+		path          = filepath.Dir(odocontext.GetDevfilePath(ctx))
+	)
+
+	var platformClient platform.Client = o.clientset.KubernetesClient
+	switch fcontext.GetPlatform(ctx, commonflags.PlatformCluster) {
+	case commonflags.PlatformPodman, commonflags.PlatformDocker:
+		platformClient = o.clientset.PodmanClient
+	}
+
+	pod, err := platformClient.GetPodUsingComponentName(componentName)
+	if err != nil {
+		return fmt.Errorf("unable to get pod for component %s: %w. Please check the command 'odo dev' is running", componentName, err)
+	}
+
+	containerName, syncFolder, err := devcommon.GetFirstContainerWithSourceVolume(pod.Spec.Containers)
+	if err != nil {
+		return fmt.Errorf("error while retrieving container from pod %s with a mounted project volume: %w", pod.GetName(), err)
+	}
+
+	paths := o.paths
+	if len(paths) == 0 {
+		components, err := devfileObj.Data.GetComponents(common.DevfileOptions{
+			FilterByName: containerName,
+		})
+		if err != nil {
+			return err
+		}
+		for _, component := range components {
+			paths, err = devcommon.GetPullPathsFromAttributes(component)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if len(paths) == 0 {
+		return errors.New("no path to pull, please pass paths as arguments or list them in the \"dev.odo.pull.paths\" attribute of the container component")
+	}
+
+	s := log.Spinnerf("Pulling files from container %q", containerName)
+	defer s.End(false)
+	pulledFiles, err := o.clientset.SyncClient.PullFiles(ctx, sync.PullParameters{
+		Path:        path,
+		RemotePaths: paths,
+		CompInfo: sync.ComponentInfo{
+			ComponentName: componentName,
+			ContainerName: containerName,
+			PodName:       pod.GetName(),
+			SyncFolder:    syncFolder,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	s.End(true)
+	log.Successf("Pulled %d file(s) from the container", len(pulledFiles))
+	return nil
+}
+
+// NewCmdPull implements the odo sync pull command
+func NewCmdPull(name, fullName string, testClientset clientset.Clientset) *cobra.Command {
+	o := NewPullOptions()
+	pullCmd := &cobra.Command{
+		Use:   name + " [paths...]",
+		Short: "Copy files from the Dev container to the local directory",
+		Long: `odo sync pull copies files and directories from the project directory in the container of a running Dev session to the local directory.
+The pulled files are not synced back to the container.
+
+If no path is given, the paths listed in the "dev.odo.pull.paths" attribute of the container component are pulled.`,
+		Example: fmt.Sprintf(pullExample, fullName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, testClientset, cmd, args)
+		},
+	}
+	clientset.Add(pullCmd,
+		clientset.FILESYSTEM,
+		clientset.KUBERNETES_NULLABLE,
+		clientset.PODMAN_NULLABLE,
+		clientset.SYNC,
+	)
+	commonflags.UsePlatformFlag(pullCmd)
+	return pullCmd
+}
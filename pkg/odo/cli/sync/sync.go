@@ -0,0 +1,28 @@
+package sync
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	"github.com/redhat-developer/odo/pkg/odo/util"
+)
+
+// RecommendedCommandName is the recommended sync command name
+const RecommendedCommandName = "sync"
+
+// NewCmdSync implements the sync odo command
+func NewCmdSync(ctx context.Context, name, fullName string, testClientset clientset.Clientset) *cobra.Command {
+	var syncCmd = &cobra.Command{
+		Use:   name,
+		Short: "Synchronize files between the local directory and the Dev container",
+	}
+
+	pullCmd := NewCmdPull(PullRecommendedCommandName, util.GetFullName(fullName, PullRecommendedCommandName), testClientset)
+	syncCmd.AddCommand(pullCmd)
+	util.SetCommandGroup(syncCmd, util.MainGroup)
+	syncCmd.SetUsageTemplate(util.CmdUsageTemplate)
+
+	return syncCmd
+}
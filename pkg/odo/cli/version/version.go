@@ -44,7 +44,9 @@ type VersionOptions struct {
 	// serverInfo contains the remote server information if the user asked for it, nil otherwise
 	serverInfo *kclient.ServerInfo
 	podmanInfo podman.SystemVersionReport
-	clientset  *clientset.Clientset
+	// podmanHostInfo contains information about the host of the Podman service, nil if not available
+	podmanHostInfo *podman.HostInfo
+	clientset      *clientset.Clientset
 }
 
 var _ genericclioptions.Runnable = (*VersionOptions)(nil)
@@ -78,6 +80,13 @@ func (o *VersionOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline,
 		if err != nil {
 			klog.V(4).Info("unable to fetch the podman client version: ", err)
 		}
+		var hostInfo podman.HostInfo
+		hostInfo, err = o.clientset.PodmanClient.GetHostInfo()
+		if err != nil {
+			klog.V(4).Info("unable to fetch the podman host info: ", err)
+		} else {
+			o.podmanHostInfo = &hostInfo
+		}
 	}
 
 	if o.serverInfo == nil {
@@ -121,6 +130,15 @@ func (o *VersionOptions) run() api.OdoVersion {
 
 	if o.podmanInfo.Client != nil {
 		podmanInfo := &api.PodmanInfo{Client: &api.PodmanClientInfo{Version: o.podmanInfo.Client.Version}}
+		if o.podmanInfo.Server != nil {
+			podmanInfo.Server = &api.PodmanClientInfo{Version: o.podmanInfo.Server.Version}
+		}
+		if o.podmanHostInfo != nil {
+			podmanInfo.Connection = &api.PodmanConnectionInfo{
+				Name:     o.clientset.PodmanClient.GetConnection(),
+				Rootless: o.podmanHostInfo.Security.Rootless,
+			}
+		}
 		result.Podman = podmanInfo
 	}
 
@@ -160,6 +178,20 @@ func (o *VersionOptions) Run(ctx context.Context) (err error) {
 
 	if odoVersion.Podman != nil && odoVersion.Podman.Client != nil {
 		message += fmt.Sprintf("Podman Client: %v\n", odoVersion.Podman.Client.Version)
+		if odoVersion.Podman.Server != nil {
+			message += fmt.Sprintf("Podman Server: %v\n", odoVersion.Podman.Server.Version)
+		}
+		if connection := odoVersion.Podman.Connection; connection != nil {
+			name := connection.Name
+			if name == "" {
+				name = "default"
+			}
+			mode := "rootful"
+			if connection.Rootless {
+				mode = "rootless"
+			}
+			message += fmt.Sprintf("Podman Connection: %s (%s)\n", name, mode)
+		}
 	}
 
 	fmt.Print(message)
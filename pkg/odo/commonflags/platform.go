@@ -18,6 +18,7 @@ const (
 	PlatformFlagName = "platform"
 	PlatformCluster  = "cluster"
 	PlatformPodman   = "podman"
+	PlatformDocker   = "docker"
 	PlatformDefault  = PlatformCluster
 )
 
@@ -35,7 +36,7 @@ func UsePlatformFlag(cmd *cobra.Command) {
 // package
 func AddPlatformFlag(ctx context.Context) {
 	if feature.IsEnabled(ctx, feature.GenericPlatformFlag) {
-		flag.CommandLine.String(PlatformFlagName, "", `Specify target platform, supported platforms: "cluster" (default), "podman"`)
+		flag.CommandLine.String(PlatformFlagName, "", `Specify target platform, supported platforms: "cluster" (default), "podman", "docker"`)
 		_ = pflag.CommandLine.MarkHidden(PlatformFlagName)
 	}
 }
@@ -49,8 +50,8 @@ func CheckPlatformCommand(cmd *cobra.Command) error {
 	platform := cmd.Annotations["platform"]
 
 	// Check the valid output
-	if hasFlagChanged && platformFlag.Value.String() != PlatformPodman && platformFlag.Value.String() != PlatformCluster {
-		return fmt.Errorf(`%s is not a valid target platform for --platform, please select either "cluster" (default) or "podman"`, platformFlag.Value.String())
+	if hasFlagChanged && !isValidPlatform(platformFlag.Value.String()) {
+		return fmt.Errorf(`%s is not a valid target platform for --platform, please select either "cluster" (default), "podman" or "docker"`, platformFlag.Value.String())
 	}
 
 	// Check that if -o json has been passed, that the command actually USES json.. if not, error out.
@@ -61,6 +62,14 @@ func CheckPlatformCommand(cmd *cobra.Command) error {
 	return nil
 }
 
+func isValidPlatform(platform string) bool {
+	switch platform {
+	case PlatformCluster, PlatformPodman, PlatformDocker:
+		return true
+	}
+	return false
+}
+
 // GetPlatformValue returns value of --platform flag or default value
 func GetPlatformValue(cmd cmdline.Cmdline) string {
 	return cmd.FlagValueIfSet(PlatformFlagName)
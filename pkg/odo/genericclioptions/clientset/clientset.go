@@ -12,6 +12,7 @@
 package clientset
 
 import (
+	"context"
 	"io"
 
 	"github.com/spf13/cobra"
@@ -20,6 +21,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/configAutomount"
 	"github.com/redhat-developer/odo/pkg/dev/kubedev"
 	"github.com/redhat-developer/odo/pkg/dev/podmandev"
+	"github.com/redhat-developer/odo/pkg/docker"
 	"github.com/redhat-developer/odo/pkg/exec"
 	"github.com/redhat-developer/odo/pkg/informer"
 	"github.com/redhat-developer/odo/pkg/log"
@@ -61,6 +63,8 @@ const (
 	DEPLOY = "DEP_DEPLOY"
 	// DEV instantiates client for pkg/dev
 	DEV = "DEP_DEV"
+	// DOCKER_NULLABLE instantiates client for pkg/docker, when no platform is selected, can be nil
+	DOCKER_NULLABLE = "DEP_DOCKER_NULLABLE"
 	// EXEC instantiates client for pkg/exec
 	EXEC = "DEP_EXEC"
 	// FILESYSTEM instantiates client for pkg/testingutil/filesystem
@@ -75,9 +79,9 @@ const (
 	KUBERNETES = "DEP_KUBERNETES"
 	// LOGS instantiates client for pkg/logs
 	LOGS = "DEP_LOGS"
-	// PODMAN instantiates client for pkg/podman
+	// PODMAN instantiates client for pkg/podman, or for pkg/docker on the docker platform
 	PODMAN = "DEP_PODMAN"
-	// PODMAN_NULLABLE instantiates client for pkg/podman, can be nil
+	// PODMAN_NULLABLE instantiates client for pkg/podman, or for pkg/docker on the docker platform, can be nil
 	PODMAN_NULLABLE = "DEP_PODMAN_NULLABLE"
 	// PORT_FORWARD instantiates client for pkg/portForward
 	PORT_FORWARD = "PORT_FORWARD"
@@ -119,19 +123,26 @@ var subdeps map[string][]string = map[string][]string{
 		SYNC,
 		WATCH,
 	},
-	EXEC:         {KUBERNETES_NULLABLE, PODMAN_NULLABLE},
-	INIT:         {ALIZER, FILESYSTEM, PREFERENCE, REGISTRY},
-	LOGS:         {KUBERNETES_NULLABLE, PODMAN_NULLABLE},
-	PORT_FORWARD: {KUBERNETES_NULLABLE, EXEC, STATE},
-	PROJECT:      {KUBERNETES},
-	REGISTRY:     {FILESYSTEM, PREFERENCE, KUBERNETES_NULLABLE},
-	STATE:        {FILESYSTEM, SYSTEM},
-	SYNC:         {EXEC},
-	WATCH:        {INFORMER, KUBERNETES_NULLABLE},
-	BINDING:      {PROJECT, KUBERNETES_NULLABLE},
+	EXEC: {KUBERNETES_NULLABLE, PODMAN_NULLABLE},
+	INIT: {ALIZER, FILESYSTEM, PREFERENCE, REGISTRY},
+	LOGS: {FILESYSTEM, KUBERNETES_NULLABLE, PODMAN_NULLABLE},
+	// The Podman connection is read from the preferences
+	PODMAN:          {PREFERENCE},
+	PODMAN_NULLABLE: {PREFERENCE},
+	PORT_FORWARD:    {KUBERNETES_NULLABLE, PODMAN_NULLABLE, EXEC, STATE},
+	PROJECT:         {KUBERNETES},
+	REGISTRY:        {FILESYSTEM, PREFERENCE, KUBERNETES_NULLABLE},
+	STATE:           {FILESYSTEM, SYSTEM},
+	SYNC:            {EXEC, PREFERENCE},
+	WATCH:           {INFORMER, KUBERNETES_NULLABLE},
+	BINDING:         {PROJECT, KUBERNETES_NULLABLE},
 	/* Add sub-dependencies here, if any */
 }
 
+// Clientset contains the clients used by a command.
+// PodmanClient is the client of the podman platform, or of the docker platform when this platform is selected,
+// the Docker client emulating the pods of Podman. DockerClient is the client of the docker platform,
+// used only when no platform is selected.
 type Clientset struct {
 	Stdout io.Writer
 	Stderr io.Writer
@@ -142,6 +153,7 @@ type Clientset struct {
 	DeleteClient          _delete.Client
 	DeployClient          deploy.Client
 	DevClient             dev.Client
+	DockerClient          podman.Client
 	ExecClient            exec.Client
 	FS                    filesystem.Filesystem
 	InformerClient        *informer.InformerClient
@@ -230,11 +242,28 @@ func Fetch(command *cobra.Command, platform string, testClientset Clientset) (*C
 			}
 		}
 	}
+	if isDefined(command, PREFERENCE) {
+		dep.PreferenceClient, err = preference.NewClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
 	if isDefined(command, PODMAN) || isDefined(command, PODMAN_NULLABLE) {
 		if testClientset.PodmanClient != nil {
 			dep.PodmanClient = testClientset.PodmanClient
+		} else if platform == commonflags.PlatformDocker {
+			dockerClient, dockerErr := docker.NewDockerCli(ctx)
+			if dockerErr != nil {
+				return nil, docker.NewDockerNotFoundError(dockerErr)
+			}
+			dep.PodmanClient = dockerClient
 		} else {
-			dep.PodmanClient, err = podman.NewPodmanCli(ctx)
+			var connection string
+			connection, err = getPodmanConnection(command, dep)
+			if err != nil {
+				return nil, err
+			}
+			dep.PodmanClient, err = newPodmanClient(ctx, dep.PreferenceClient.GetPodmanClient(), connection)
 			if err != nil {
 				// send error in case the command is to run on podman platform or if PODMAN clientset is required.
 				if isDefined(command, PODMAN) || platform == commonflags.PlatformPodman {
@@ -245,10 +274,16 @@ func Fetch(command *cobra.Command, platform string, testClientset Clientset) (*C
 			}
 		}
 	}
-	if isDefined(command, PREFERENCE) {
-		dep.PreferenceClient, err = preference.NewClient(ctx)
-		if err != nil {
-			return nil, err
+	if isDefined(command, DOCKER_NULLABLE) && platform == "" {
+		if testClientset.DockerClient != nil {
+			dep.DockerClient = testClientset.DockerClient
+		} else {
+			dockerClient, dockerErr := docker.NewDockerCli(ctx)
+			if dockerErr != nil {
+				klog.V(3).Infof("no Docker client initialized: %v", dockerErr)
+			} else {
+				dep.DockerClient = dockerClient
+			}
 		}
 	}
 	if isDefined(command, REGISTRY) {
@@ -265,7 +300,7 @@ func Fetch(command *cobra.Command, platform string, testClientset Clientset) (*C
 	}
 	if isDefined(command, EXEC) {
 		switch platform {
-		case commonflags.PlatformPodman:
+		case commonflags.PlatformPodman, commonflags.PlatformDocker:
 			dep.ExecClient = exec.NewExecClient(dep.PodmanClient)
 		default:
 			dep.ExecClient = exec.NewExecClient(dep.KubernetesClient)
@@ -273,7 +308,7 @@ func Fetch(command *cobra.Command, platform string, testClientset Clientset) (*C
 	}
 	if isDefined(command, CONFIG_AUTOMOUNT) {
 		switch platform {
-		case commonflags.PlatformPodman:
+		case commonflags.PlatformPodman, commonflags.PlatformDocker:
 			dep.ConfigAutomountClient = nil // Not supported
 		default:
 			dep.ConfigAutomountClient = configAutomount.NewKubernetesClient(dep.KubernetesClient)
@@ -290,10 +325,10 @@ func Fetch(command *cobra.Command, platform string, testClientset Clientset) (*C
 	}
 	if isDefined(command, LOGS) {
 		switch platform {
-		case commonflags.PlatformPodman:
-			dep.LogsClient = logs.NewLogsClient(dep.PodmanClient)
+		case commonflags.PlatformPodman, commonflags.PlatformDocker:
+			dep.LogsClient = logs.NewLogsClient(dep.PodmanClient, dep.FS)
 		default:
-			dep.LogsClient = logs.NewLogsClient(dep.KubernetesClient)
+			dep.LogsClient = logs.NewLogsClient(dep.KubernetesClient, dep.FS)
 		}
 	}
 	if isDefined(command, PROJECT) {
@@ -304,10 +339,10 @@ func Fetch(command *cobra.Command, platform string, testClientset Clientset) (*C
 	}
 	if isDefined(command, SYNC) {
 		switch platform {
-		case commonflags.PlatformPodman:
-			dep.SyncClient = sync.NewSyncClient(dep.PodmanClient, dep.ExecClient)
+		case commonflags.PlatformPodman, commonflags.PlatformDocker:
+			dep.SyncClient = sync.NewSyncClient(dep.PodmanClient, dep.ExecClient, dep.PreferenceClient)
 		default:
-			dep.SyncClient = sync.NewSyncClient(dep.KubernetesClient, dep.ExecClient)
+			dep.SyncClient = sync.NewSyncClient(dep.KubernetesClient, dep.ExecClient, dep.PreferenceClient)
 		}
 	}
 	if isDefined(command, WATCH) {
@@ -318,15 +353,15 @@ func Fetch(command *cobra.Command, platform string, testClientset Clientset) (*C
 	}
 	if isDefined(command, PORT_FORWARD) {
 		switch platform {
-		case commonflags.PlatformPodman:
-			dep.PortForwardClient = podmanportforward.NewPFClient(dep.ExecClient)
+		case commonflags.PlatformPodman, commonflags.PlatformDocker:
+			dep.PortForwardClient = podmanportforward.NewPFClient(dep.ExecClient, dep.PodmanClient, dep.StateClient)
 		default:
 			dep.PortForwardClient = kubeportforward.NewPFClient(dep.KubernetesClient, dep.StateClient)
 		}
 	}
 	if isDefined(command, DEV) {
 		switch platform {
-		case commonflags.PlatformPodman:
+		case commonflags.PlatformPodman, commonflags.PlatformDocker:
 			dep.DevClient = podmandev.NewDevClient(
 				dep.FS,
 				dep.PodmanClient,
@@ -349,6 +384,7 @@ func Fetch(command *cobra.Command, platform string, testClientset Clientset) (*C
 				dep.ExecClient,
 				dep.DeleteClient,
 				dep.ConfigAutomountClient,
+				dep.StateClient,
 			)
 		}
 	}
@@ -356,3 +392,50 @@ func Fetch(command *cobra.Command, platform string, testClientset Clientset) (*C
 	/* Instantiate new clients here. Take care to instantiate after all sub-dependencies */
 	return &dep, nil
 }
+
+// newPodmanClient returns a client of the REST API of the Podman service if the PodmanClient preference is set to api,
+// or a client executing the podman command if the preference is set to cli, or if the REST API is not usable with the connection
+func newPodmanClient(ctx context.Context, client string, connection string) (podman.Client, error) {
+	if client == preference.PodmanClientAPI {
+		apiClient, err := podman.NewPodmanAPI(ctx, connection)
+		if err == nil {
+			return apiClient, nil
+		}
+		// The REST API has been chosen explicitly, the user needs to know it is not used
+		log.Warningf("Unable to use the REST API of the Podman service, using the podman command instead: %v", err)
+	}
+	cliClient, err := podman.NewPodmanCli(ctx, connection)
+	if err != nil {
+		return nil, err
+	}
+	return cliClient, nil
+}
+
+// getPodmanConnection returns the connection to the Podman service defined in the preferences.
+// For commands other than odo dev, the connection used by the odo dev session running on podman, if any, is returned instead,
+// so the commands target the resources created by the session.
+func getPodmanConnection(command *cobra.Command, dep Clientset) (string, error) {
+	connection := dep.PreferenceClient.GetPodmanConnection()
+	if isDefined(command, DEV) {
+		return connection, nil
+	}
+	var (
+		fs        = dep.FS
+		sysClient = dep.systemClient
+	)
+	if fs == nil {
+		fs = filesystem.DefaultFs{}
+	}
+	if sysClient == nil {
+		sysClient = system.Default{}
+	}
+	sessionConnection, found, err := state.NewStateClient(fs, sysClient).GetPodmanConnection(command.Context())
+	if err != nil {
+		return "", err
+	}
+	if found && sessionConnection != connection {
+		klog.V(2).Infof("using the Podman connection %q of the running odo dev session", sessionConnection)
+		return sessionConnection, nil
+	}
+	return connection, nil
+}
@@ -3,6 +3,7 @@ package platform
 import (
 	"context"
 	"io"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -18,7 +19,7 @@ type Client interface {
 
 	// GetPodLogs returns the logs of the specified pod container.
 	// All logs for all containers part of the pod are returned if an empty string is provided as container name.
-	GetPodLogs(podName, containerName string, followLog bool) (io.ReadCloser, error)
+	GetPodLogs(podName, containerName string, options PodLogsOptions) (io.ReadCloser, error)
 
 	// GetPodsMatchingSelector returns all pods matching the given label selector.
 	GetPodsMatchingSelector(selector string) (*corev1.PodList, error)
@@ -37,3 +38,15 @@ type Client interface {
 
 	PodWatcher(ctx context.Context, selector string) (watch.Interface, error)
 }
+
+// PodLogsOptions are the options to get the logs of a pod container
+type PodLogsOptions struct {
+	// Follow streams the logs as they are written
+	Follow bool
+	// Since returns only the logs more recent than this duration, or all the logs if zero
+	Since time.Duration
+	// TailLines returns only this number of lines from the end of the logs, or all the lines if nil
+	TailLines *int64
+	// Timestamps prefixes each line with its RFC3339 timestamp, followed by a space
+	Timestamps bool
+}
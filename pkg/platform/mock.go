@@ -83,18 +83,18 @@ func (mr *MockClientMockRecorder) GetAllResourcesFromSelector(selector, ns inter
 }
 
 // GetPodLogs mocks base method.
-func (m *MockClient) GetPodLogs(podName, containerName string, followLog bool) (io.ReadCloser, error) {
+func (m *MockClient) GetPodLogs(podName, containerName string, options PodLogsOptions) (io.ReadCloser, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPodLogs", podName, containerName, followLog)
+	ret := m.ctrl.Call(m, "GetPodLogs", podName, containerName, options)
 	ret0, _ := ret[0].(io.ReadCloser)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPodLogs indicates an expected call of GetPodLogs.
-func (mr *MockClientMockRecorder) GetPodLogs(podName, containerName, followLog interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) GetPodLogs(podName, containerName, options interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPodLogs", reflect.TypeOf((*MockClient)(nil).GetPodLogs), podName, containerName, followLog)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPodLogs", reflect.TypeOf((*MockClient)(nil).GetPodLogs), podName, containerName, options)
 }
 
 // GetPodUsingComponentName mocks base method.
@@ -0,0 +1,284 @@
+package podman
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/config"
+	envcontext "github.com/redhat-developer/odo/pkg/config/context"
+	"github.com/redhat-developer/odo/pkg/platform"
+)
+
+// apiPrefix is the prefix of the paths of the libpod REST API.
+// The version of the API is set explicitly, so the requests are not interpreted with the semantics of a later version of the service.
+const apiPrefix = "/v4.0.0/libpod"
+
+// PodmanAPI is a Podman client sending requests to the REST API of the Podman service (the libpod API),
+// instead of executing the podman command
+type PodmanAPI struct {
+	// connection is the URL of the Podman service, as defined in the preferences; empty for the default service
+	connection string
+	// serviceURL is the URL of the Podman service, using the unix or tcp scheme
+	serviceURL  *url.URL
+	client      *http.Client
+	initTimeout time.Duration
+}
+
+var _ Client = (*PodmanAPI)(nil)
+var _ platform.Client = (*PodmanAPI)(nil)
+
+// NewPodmanAPI returns a new client of the REST API of the Podman service, using the URL of the service
+// (or the service of the CONTAINER_HOST environment variable, or the socket of the current user, if empty).
+// An error is returned if the connection is not supported by the client (system connections and ssh URLs are not),
+// or if the service is not reachable.
+func NewPodmanAPI(ctx context.Context, connection string) (*PodmanAPI, error) {
+	serviceURL, err := getServiceURL(envcontext.GetEnvConfig(ctx), connection)
+	if err != nil {
+		return nil, err
+	}
+	api := &PodmanAPI{
+		connection:  connection,
+		serviceURL:  serviceURL,
+		initTimeout: envcontext.GetEnvConfig(ctx).PodmanCmdInitTimeout,
+	}
+	api.client = &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return api.dial(ctx)
+			},
+		},
+	}
+
+	// Check that the service is reachable
+	_, err = api.Version(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to reach the Podman service at %q: %w", serviceURL, err)
+	}
+	return api, nil
+}
+
+// getServiceURL returns the URL of the Podman service to use for the connection
+func getServiceURL(envConfig config.Configuration, connection string) (*url.URL, error) {
+	if connection == "" && envConfig.ContainerHost != nil {
+		connection = *envConfig.ContainerHost
+	}
+	if connection == "" {
+		return &url.URL{Scheme: "unix", Path: getDefaultSocketPath(envConfig)}, nil
+	}
+	if !IsConnectionURL(connection) {
+		return nil, fmt.Errorf("the system connection %q is not supported by the REST API client, only unix and tcp URLs are", connection)
+	}
+	u, err := url.Parse(connection)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "unix" && u.Scheme != "tcp" {
+		return nil, fmt.Errorf("the connection %q is not supported by the REST API client, only unix and tcp URLs are", connection)
+	}
+	return u, nil
+}
+
+// getDefaultSocketPath returns the path of the socket of the Podman service of the current user, either rootful or rootless
+func getDefaultSocketPath(envConfig config.Configuration) string {
+	uid := os.Getuid()
+	if uid == 0 {
+		return "/run/podman/podman.sock"
+	}
+	runtimeDir := fmt.Sprintf("/run/user/%d", uid)
+	if envConfig.XdgRuntimeDir != nil && *envConfig.XdgRuntimeDir != "" {
+		runtimeDir = *envConfig.XdgRuntimeDir
+	}
+	return filepath.Join(runtimeDir, "podman", "podman.sock")
+}
+
+// dial opens a connection to the Podman service
+func (o *PodmanAPI) dial(ctx context.Context) (net.Conn, error) {
+	var dialer net.Dialer
+	if o.serviceURL.Scheme == "unix" {
+		return dialer.DialContext(ctx, "unix", o.serviceURL.Path)
+	}
+	return dialer.DialContext(ctx, "tcp", o.serviceURL.Host)
+}
+
+// newRequest returns a request to the API path, with the query parameters and the body encoded in JSON, if not nil.
+// A body of type io.Reader is sent as is.
+func (o *PodmanAPI) newRequest(ctx context.Context, method string, path string, query url.Values, body interface{}) (*http.Request, error) {
+	var reader io.Reader
+	switch body := body.(type) {
+	case nil:
+	case io.Reader:
+		reader = body
+	default:
+		content, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(content)
+	}
+	// The host is ignored, as the connection is opened by dial
+	u := url.URL{Scheme: "http", Host: "d", Path: apiPrefix + path, RawQuery: query.Encode()}
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), reader)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		if _, ok := body.(io.Reader); !ok {
+			req.Header.Set("Content-Type", "application/json")
+		}
+	}
+	return req, nil
+}
+
+// do sends a request to the API path and returns the response, or an error if the status code of the response is not a success.
+// The body of the response must be closed by the caller.
+func (o *PodmanAPI) do(ctx context.Context, method string, path string, query url.Values, body interface{}) (*http.Response, error) {
+	req, err := o.newRequest(ctx, method, path, query, body)
+	if err != nil {
+		return nil, err
+	}
+	return o.send(req)
+}
+
+// send sends the request and returns the response, or an error if the status code of the response is not a success.
+// The body of the response must be closed by the caller.
+func (o *PodmanAPI) send(req *http.Request) (*http.Response, error) {
+	klog.V(3).Infof("sending request %s %s", req.Method, req.URL.RequestURI())
+	resp, err := o.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		defer resp.Body.Close()
+		return nil, getResponseError(resp)
+	}
+	return resp, nil
+}
+
+// getJSON sends a GET request to the API path and decodes the JSON response into result
+func (o *PodmanAPI) getJSON(path string, query url.Values, result interface{}) error {
+	resp, err := o.do(context.Background(), http.MethodGet, path, query, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return json.NewDecoder(resp.Body).Decode(result)
+}
+
+// apiError is the body of the responses of the API for errors
+type apiError struct {
+	Cause    string `json:"cause"`
+	Message  string `json:"message"`
+	Response int    `json:"response"`
+}
+
+func (o *apiError) Error() string {
+	return o.Message
+}
+
+func getResponseError(resp *http.Response) error {
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	var result apiError
+	if err = json.Unmarshal(content, &result); err != nil || result.Message == "" {
+		result.Message = strings.TrimSpace(string(content))
+	}
+	if result.Message == "" {
+		result.Message = resp.Status
+	}
+	result.Response = resp.StatusCode
+	return &result
+}
+
+// GetConnection returns the connection to the Podman service used by the client,
+// as defined in the preferences, or an empty string for the default connection
+func (o *PodmanAPI) GetConnection() string {
+	return o.connection
+}
+
+// apiVersion is the result of the version endpoint of the API
+type apiVersion struct {
+	Version    string
+	APIVersion string `json:"ApiVersion"`
+	GoVersion  string
+	GitCommit  string
+	BuildTime  string
+	Os         string
+	Arch       string
+}
+
+// Version returns the version of the Podman service. As there is no Podman client involved, the version of the service
+// is returned as the version of the client, the same way as podman does for a local service.
+func (o *PodmanAPI) Version(ctx context.Context) (SystemVersionReport, error) {
+	// As for the podman command, Version is used when resolving and injecting dependencies,
+	// and is expected to return in a timely manner
+	ctx, cancel := context.WithTimeout(ctx, o.initTimeout)
+	defer cancel()
+	resp, err := o.do(ctx, http.MethodGet, "/version", nil, nil)
+	if err != nil {
+		if ctx.Err() != nil {
+			return SystemVersionReport{}, fmt.Errorf("timeout (%s) while waiting for Podman version", o.initTimeout.Round(time.Second).String())
+		}
+		return SystemVersionReport{}, err
+	}
+	defer resp.Body.Close()
+
+	var version apiVersion
+	err = json.NewDecoder(resp.Body).Decode(&version)
+	if err != nil {
+		return SystemVersionReport{}, err
+	}
+	return SystemVersionReport{
+		Client: &Version{
+			APIVersion: version.APIVersion,
+			Version:    version.Version,
+			GoVersion:  version.GoVersion,
+			GitCommit:  version.GitCommit,
+			BuiltTime:  version.BuildTime,
+			OsArch:     version.Os + "/" + version.Arch,
+			Os:         version.Os,
+		},
+	}, nil
+}
+
+func (o *PodmanAPI) getInfo() (podmanInfo, error) {
+	var result podmanInfo
+	err := o.getJSON("/info", nil, &result)
+	return result, err
+}
+
+// GetCapabilities returns the capabilities of the system running the Podman service
+func (o *PodmanAPI) GetCapabilities() (Capabilities, error) {
+	info, err := o.getInfo()
+	if err != nil {
+		return Capabilities{}, err
+	}
+	return Capabilities{
+		Cgroupv2: info.Host != nil && info.Host.CgroupsVersion == "v2",
+	}, nil
+}
+
+// GetHostInfo returns information about the host running the Podman service
+func (o *PodmanAPI) GetHostInfo() (HostInfo, error) {
+	info, err := o.getInfo()
+	if err != nil {
+		return HostInfo{}, err
+	}
+	if info.Host == nil {
+		return HostInfo{}, nil
+	}
+	return *info.Host, nil
+}
@@ -0,0 +1,185 @@
+package podman
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+
+	"k8s.io/klog"
+)
+
+// execCreateConfig is the body of the request creating an exec session
+type execCreateConfig struct {
+	AttachStdin  bool
+	AttachStdout bool
+	AttachStderr bool
+	Cmd          []string
+	Tty          bool
+}
+
+// execCreateResponse is the result of the request creating an exec session
+type execCreateResponse struct {
+	ID string `json:"Id"`
+}
+
+// execStartConfig is the body of the request starting an exec session
+type execStartConfig struct {
+	Detach bool
+	Tty    bool
+}
+
+// execInspect contains the part of the description of an exec session used by odo
+type execInspect struct {
+	ExitCode int
+	Running  bool
+}
+
+func (o *PodmanAPI) ExecCMDInContainer(ctx context.Context, containerName, podName string, cmd []string, stdout, stderr io.Writer, stdin io.Reader, tty bool) error {
+	name := fmt.Sprintf("%s-%s", podName, containerName)
+
+	resp, err := o.do(ctx, http.MethodPost, "/containers/"+url.PathEscape(name)+"/exec", nil, execCreateConfig{
+		AttachStdin:  stdin != nil,
+		AttachStdout: true,
+		AttachStderr: true,
+		Cmd:          cmd,
+		Tty:          tty,
+	})
+	if err != nil {
+		return err
+	}
+	var session execCreateResponse
+	err = json.NewDecoder(resp.Body).Decode(&session)
+	resp.Body.Close()
+	if err != nil {
+		return err
+	}
+
+	conn, reader, err := o.hijack(ctx, "/exec/"+url.PathEscape(session.ID)+"/start", execStartConfig{Tty: tty})
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// Closing the connection stops the command when the context is cancelled
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+
+	if stdin != nil {
+		go func() {
+			if _, copyErr := io.Copy(conn, stdin); copyErr != nil {
+				klog.V(4).Infof("error sending the input of the command: %v", copyErr)
+			}
+			// Signal the end of the input to the command
+			if closer, ok := conn.(interface{ CloseWrite() error }); ok {
+				_ = closer.CloseWrite()
+			}
+		}()
+	}
+
+	if tty {
+		// The output of a command with a TTY is not multiplexed
+		_, err = io.Copy(writerOrDiscard(stdout), reader)
+	} else {
+		err = demuxStream(stdout, stderr, reader)
+	}
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return err
+	}
+
+	var inspect execInspect
+	err = o.getJSON("/exec/"+url.PathEscape(session.ID)+"/json", nil, &inspect)
+	if err != nil {
+		return err
+	}
+	if inspect.ExitCode != 0 {
+		return fmt.Errorf("exit status %d", inspect.ExitCode)
+	}
+	return nil
+}
+
+// hijack sends a request upgrading the connection to a raw stream, and returns the connection,
+// to write to the stream, and a reader of the connection, to read from the stream
+func (o *PodmanAPI) hijack(ctx context.Context, path string, body interface{}) (net.Conn, *bufio.Reader, error) {
+	req, err := o.newRequest(ctx, http.MethodPost, path, nil, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "tcp")
+
+	conn, err := o.dial(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	klog.V(3).Infof("sending request %s %s", req.Method, req.URL.RequestURI())
+	err = req.Write(conn)
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, req)
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols && resp.StatusCode != http.StatusOK {
+		defer conn.Close()
+		return nil, nil, getResponseError(resp)
+	}
+	return conn, reader, nil
+}
+
+// demuxStream copies the stream multiplexing the standard output and error of a container to stdout and stderr.
+// Each frame of the stream is written in a single call.
+func demuxStream(stdout, stderr io.Writer, src io.Reader) error {
+	stdout, stderr = writerOrDiscard(stdout), writerOrDiscard(stderr)
+	// The header of a frame contains the type of the stream on the first byte, and the size of the frame on the last 4 bytes
+	header := make([]byte, 8)
+	for {
+		_, err := io.ReadFull(src, header)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		frame := make([]byte, binary.BigEndian.Uint32(header[4:]))
+		_, err = io.ReadFull(src, frame)
+		if err != nil {
+			return err
+		}
+		w := stdout
+		if header[0] == 2 {
+			w = stderr
+		}
+		_, err = w.Write(frame)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func writerOrDiscard(w io.Writer) io.Writer {
+	if w == nil {
+		return io.Discard
+	}
+	return w
+}
@@ -0,0 +1,105 @@
+package podman
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/redhat-developer/odo/pkg/platform"
+)
+
+// GetPodLogs returns the logs of the specified pod container.
+// All logs for all containers part of the pod are returned if an empty string is provided as container name.
+func (o *PodmanAPI) GetPodLogs(podName, containerName string, options platform.PodLogsOptions) (io.ReadCloser, error) {
+	var containers []string
+	if containerName != "" {
+		containers = []string{podName + "-" + containerName}
+	} else {
+		inspect, err := o.PodInspect(podName)
+		if err != nil {
+			return nil, err
+		}
+		for _, container := range inspect.Containers {
+			if container.ID == inspect.InfraContainerID {
+				continue
+			}
+			containers = append(containers, container.Name)
+		}
+	}
+
+	query := url.Values{
+		"stdout": []string{"true"},
+		"stderr": []string{"true"},
+	}
+	if options.Follow {
+		query.Set("follow", "true")
+	}
+	if options.Since > 0 {
+		query.Set("since", strconv.FormatInt(time.Now().Add(-options.Since).Unix(), 10))
+	}
+	if options.TailLines != nil {
+		query.Set("tail", strconv.FormatInt(*options.TailLines, 10))
+	}
+	if options.Timestamps {
+		query.Set("timestamps", "true")
+	}
+
+	reader, writer := io.Pipe()
+	result := &logsReader{PipeReader: reader}
+	for _, container := range containers {
+		resp, err := o.do(context.Background(), http.MethodGet, "/containers/"+url.PathEscape(container)+"/logs", query, nil)
+		if err != nil {
+			result.Close()
+			return nil, err
+		}
+		result.bodies = append(result.bodies, resp.Body)
+	}
+
+	// As for podman pod logs, the logs of the containers are interleaved, and the standard output and error are combined
+	out := &syncWriter{w: writer}
+	var wg sync.WaitGroup
+	for _, body := range result.bodies {
+		wg.Add(1)
+		go func(body io.Reader) {
+			defer wg.Done()
+			// The containers are not started with a TTY, so their logs are multiplexed
+			if err := demuxStream(out, out, body); err != nil {
+				writer.CloseWithError(err)
+			}
+		}(body)
+	}
+	go func() {
+		wg.Wait()
+		writer.Close()
+	}()
+	return result, nil
+}
+
+// logsReader reads the logs of containers from a pipe, and closes the responses providing the logs when closed
+type logsReader struct {
+	*io.PipeReader
+	bodies []io.ReadCloser
+}
+
+func (o *logsReader) Close() error {
+	for _, body := range o.bodies {
+		body.Close()
+	}
+	return o.PipeReader.Close()
+}
+
+// syncWriter serializes the writes to the writer
+type syncWriter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (o *syncWriter) Write(p []byte) (int, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.w.Write(p)
+}
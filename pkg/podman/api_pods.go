@@ -0,0 +1,242 @@
+package podman
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	jsonserializer "k8s.io/apimachinery/pkg/runtime/serializer/json"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/klog"
+	"k8s.io/kubectl/pkg/scheme"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+)
+
+// playKubeReport contains the part of the result of the play kube endpoint used by odo
+type playKubeReport struct {
+	Pods []struct {
+		ID              string
+		ContainerErrors []string
+	}
+}
+
+// listVolumesReport contains the part of the result of the volumes list endpoint used by odo
+type listVolumesReport struct {
+	Name string
+}
+
+// listContainersReport contains the part of the result of the containers list endpoint used by odo
+type listContainersReport struct {
+	ID string `json:"Id"`
+}
+
+func newYAMLSerializer() *jsonserializer.Serializer {
+	return jsonserializer.NewSerializerWithOptions(
+		jsonserializer.SimpleMetaFactory{},
+		scheme.Scheme,
+		scheme.Scheme,
+		jsonserializer.SerializerOptions{
+			Yaml: true,
+		},
+	)
+}
+
+// PlayKube creates the Pod with Podman. The ODO_CONTAINER_RUN_ARGS arguments, specific to the podman command, are not used.
+func (o *PodmanAPI) PlayKube(pod *corev1.Pod) error {
+	var sb strings.Builder
+	err := newYAMLSerializer().Encode(pod, &sb)
+	if err != nil {
+		return err
+	}
+	if klog.V(4) {
+		klog.Infof("Pod spec to play: \n---\n%s\n---\n", sb.String())
+	}
+
+	req, err := o.newRequest(context.Background(), http.MethodPost, "/play/kube", nil, strings.NewReader(sb.String()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-yaml")
+	resp, err := o.send(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var report playKubeReport
+	err = json.NewDecoder(resp.Body).Decode(&report)
+	if err != nil {
+		return err
+	}
+	for _, p := range report.Pods {
+		if len(p.ContainerErrors) > 0 {
+			return fmt.Errorf("error starting the containers of pod %q: %s", pod.GetName(), strings.Join(p.ContainerErrors, "\n"))
+		}
+	}
+	return nil
+}
+
+// KubeGenerate returns a Kubernetes Pod definition of an existing Pod
+func (o *PodmanAPI) KubeGenerate(name string) (*corev1.Pod, error) {
+	resp, err := o.do(context.Background(), http.MethodGet, "/generate/kube", url.Values{"names": []string{name}}, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var pod corev1.Pod
+	_, _, err = newYAMLSerializer().Decode(content, nil, &pod)
+	if err != nil {
+		return nil, err
+	}
+	return &pod, nil
+}
+
+func (o *PodmanAPI) PodStop(podname string) error {
+	// The service answers with a 304 status if the pod is already stopped
+	resp, err := o.do(context.Background(), http.MethodPost, "/pods/"+url.PathEscape(podname)+"/stop", nil, nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	klog.V(4).Infof("Stopped pod %s", podname)
+	return nil
+}
+
+func (o *PodmanAPI) PodRm(podname string) error {
+	resp, err := o.do(context.Background(), http.MethodDelete, "/pods/"+url.PathEscape(podname), nil, nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	klog.V(4).Infof("Deleted pod %s", podname)
+	return nil
+}
+
+func (o *PodmanAPI) PodLs() (map[string]bool, error) {
+	var list []ListPodsReport
+	err := o.getJSON("/pods/json", nil, &list)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]bool, len(list))
+	for _, pod := range list {
+		result[pod.Name] = true
+	}
+	return result, nil
+}
+
+// PodInspect returns the description of the pod
+func (o *PodmanAPI) PodInspect(podname string) (PodInspectData, error) {
+	var result PodInspectData
+	err := o.getJSON("/pods/"+url.PathEscape(podname)+"/json", nil, &result)
+	return result, err
+}
+
+func (o *PodmanAPI) VolumeRm(volumeName string) error {
+	resp, err := o.do(context.Background(), http.MethodDelete, "/volumes/"+url.PathEscape(volumeName), nil, nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	klog.V(4).Infof("Deleted volume %s", volumeName)
+	return nil
+}
+
+func (o *PodmanAPI) VolumeLs() (map[string]bool, error) {
+	var list []listVolumesReport
+	err := o.getJSON("/volumes/json", nil, &list)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]bool, len(list))
+	for _, volume := range list {
+		result[volume.Name] = true
+	}
+	return result, nil
+}
+
+func (o *PodmanAPI) CleanupPodResources(pod *corev1.Pod, cleanupVolumes bool) error {
+	return cleanupPodResources(o, pod, cleanupVolumes)
+}
+
+func (o *PodmanAPI) ListAllComponents() ([]api.ComponentAbstract, error) {
+	list, err := o.listPods(map[string][]string{"status": {"running"}})
+	if err != nil {
+		return nil, err
+	}
+	return GetComponentsFromPodsReports(list, commonflags.PlatformPodman), nil
+}
+
+func (o *PodmanAPI) GetPodUsingComponentName(componentName string) (*corev1.Pod, error) {
+	podSelector := fmt.Sprintf("component=%s", componentName)
+	return o.GetRunningPodFromSelector(podSelector)
+}
+
+// GetPodsMatchingSelector returns all pods matching the given label selector.
+func (o *PodmanAPI) GetPodsMatchingSelector(selector string) (*corev1.PodList, error) {
+	return getPodsMatchingSelector(o, selector)
+}
+
+// GetAllResourcesFromSelector returns all resources of any kind matching the given label selector.
+func (o *PodmanAPI) GetAllResourcesFromSelector(selector string, _ string) ([]unstructured.Unstructured, error) {
+	return getAllResourcesFromSelector(o, selector)
+}
+
+// GetAllPodsInNamespaceMatchingSelector returns all pods matching the given label selector and in the specified namespace.
+func (o *PodmanAPI) GetAllPodsInNamespaceMatchingSelector(selector string, ns string) (*corev1.PodList, error) {
+	// In podman, we return the pods, as there is no resource containing PodSpec
+	return o.GetPodsMatchingSelector(selector)
+}
+
+// GetRunningPodFromSelector returns any pod matching the given label selector.
+// If multiple pods are found, implementations might have different behavior, by either returning an error or returning any element.
+func (o *PodmanAPI) GetRunningPodFromSelector(selector string) (*corev1.Pod, error) {
+	return getRunningPodFromSelector(o, selector)
+}
+
+func (o *PodmanAPI) PodWatcher(ctx context.Context, selector string) (watch.Interface, error) {
+	return newContainersWatcher(func() ([]string, error) {
+		var list []listContainersReport
+		err := o.getJSON("/containers/json", nil, &list)
+		if err != nil {
+			return nil, err
+		}
+		ids := make([]string, 0, len(list))
+		for _, container := range list {
+			ids = append(ids, container.ID)
+		}
+		return ids, nil
+	}), nil
+}
+
+func (o *PodmanAPI) getPodsFromSelector(selector string) ([]ListPodsReport, error) {
+	filters := map[string][]string{}
+	if selector != "" {
+		filters["label"] = strings.Split(selector, ",")
+	}
+	return o.listPods(filters)
+}
+
+// listPods returns the pods matching the filters
+func (o *PodmanAPI) listPods(filters map[string][]string) ([]ListPodsReport, error) {
+	jsonFilters, err := json.Marshal(filters)
+	if err != nil {
+		return nil, err
+	}
+	var list []ListPodsReport
+	err = o.getJSON("/pods/json", url.Values{"filters": []string{string(jsonFilters)}}, &list)
+	return list, err
+}
@@ -0,0 +1,367 @@
+package podman
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/pointer"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/config"
+	envcontext "github.com/redhat-developer/odo/pkg/config/context"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	"github.com/redhat-developer/odo/pkg/platform"
+)
+
+// newTestPodmanAPI returns a client of a stand-in Podman service listening on a Unix socket, and serving the requests with handler
+func newTestPodmanAPI(t *testing.T, handler http.HandlerFunc) *PodmanAPI {
+	socket := filepath.Join(t.TempDir(), "podman.sock")
+	listener, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == apiPrefix+"/version" {
+			writeJSON(w, apiVersion{Version: "4.5.0", APIVersion: "4.5.0", Os: "linux", Arch: "amd64"})
+			return
+		}
+		handler(w, r)
+	}))
+	server.Listener = listener
+	server.Start()
+	t.Cleanup(server.Close)
+
+	ctx := envcontext.WithEnvConfig(context.Background(), config.Configuration{
+		PodmanCmdInitTimeout: 10 * time.Second,
+	})
+	client, err := NewPodmanAPI(ctx, "unix://"+socket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeFrame writes a frame of a stream multiplexing the standard output (stream 1) and error (stream 2) of a container
+func writeFrame(w io.Writer, stream byte, content string) {
+	header := make([]byte, 8)
+	header[0] = stream
+	binary.BigEndian.PutUint32(header[4:], uint32(len(content)))
+	_, _ = w.Write(header)
+	_, _ = w.Write([]byte(content))
+}
+
+func Test_getServiceURL(t *testing.T) {
+	tests := []struct {
+		name       string
+		envConfig  config.Configuration
+		connection string
+		want       string
+		wantErr    bool
+	}{
+		{
+			name:       "unix URL",
+			connection: "unix:///run/podman/podman.sock",
+			want:       "unix:///run/podman/podman.sock",
+		},
+		{
+			name:       "tcp URL",
+			connection: "tcp://localhost:8080",
+			want:       "tcp://localhost:8080",
+		},
+		{
+			name:       "CONTAINER_HOST is used for the default connection",
+			envConfig:  config.Configuration{ContainerHost: pointer.String("unix:///tmp/podman.sock")},
+			connection: "",
+			want:       "unix:///tmp/podman.sock",
+		},
+		{
+			name:       "CONTAINER_HOST is ignored when a connection is set",
+			envConfig:  config.Configuration{ContainerHost: pointer.String("unix:///tmp/podman.sock")},
+			connection: "tcp://localhost:8080",
+			want:       "tcp://localhost:8080",
+		},
+		{
+			name:       "ssh URL",
+			connection: "ssh://core@localhost:45678/run/podman/podman.sock",
+			wantErr:    true,
+		},
+		{
+			name:       "system connection",
+			connection: "podman-machine-default-root",
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := getServiceURL(tt.envConfig, tt.connection)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("getServiceURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("getServiceURL() = %q, want %q", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestNewPodmanAPI_unreachable(t *testing.T) {
+	ctx := envcontext.WithEnvConfig(context.Background(), config.Configuration{
+		PodmanCmdInitTimeout: 10 * time.Second,
+	})
+	_, err := NewPodmanAPI(ctx, "unix://"+filepath.Join(t.TempDir(), "podman.sock"))
+	if err == nil {
+		t.Error("NewPodmanAPI() should fail when the service is not reachable")
+	}
+}
+
+func TestPodmanAPI_Version(t *testing.T) {
+	o := newTestPodmanAPI(t, http.NotFound)
+	got, err := o.Version(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := SystemVersionReport{
+		Client: &Version{APIVersion: "4.5.0", Version: "4.5.0", OsArch: "linux/amd64", Os: "linux"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Version() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestPodmanAPI_ListAllComponents(t *testing.T) {
+	o := newTestPodmanAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != apiPrefix+"/pods/json" {
+			http.NotFound(w, r)
+			return
+		}
+		if filters := r.URL.Query().Get("filters"); filters != `{"status":["running"]}` {
+			http.Error(w, "unexpected filters "+filters, http.StatusBadRequest)
+			return
+		}
+		writeJSON(w, []ListPodsReport{
+			{
+				Name: "mycmp-app",
+				Labels: map[string]string{
+					"app.kubernetes.io/instance":   "mycmp",
+					"app.kubernetes.io/managed-by": "odo",
+					"odo.dev/mode":                 "Dev",
+				},
+			},
+		})
+	})
+	got, err := o.ListAllComponents()
+	if err != nil {
+		t.Fatal(err)
+	}
+	runningIn := api.NewRunningModes()
+	runningIn.AddRunningMode(api.RunningModeDev)
+	want := []api.ComponentAbstract{
+		{
+			Name:      "mycmp",
+			ManagedBy: "odo",
+			Type:      api.TypeUnknown,
+			RunningIn: runningIn,
+			//lint:ignore SA1019 we need to output the deprecated value, before to remove it in a future release
+			RunningOn: commonflags.PlatformPodman,
+			Platform:  commonflags.PlatformPodman,
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ListAllComponents() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestPodmanAPI_GetRunningPodFromSelector(t *testing.T) {
+	tests := []struct {
+		name    string
+		state   string
+		wantErr bool
+	}{
+		{
+			name:  "running pod",
+			state: "Running",
+		},
+		{
+			name:    "exited pod",
+			state:   "Exited",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := newTestPodmanAPI(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case apiPrefix + "/pods/json":
+					writeJSON(w, []ListPodsReport{
+						{
+							Name:       "mycmp-app",
+							Containers: []ListPodsContainer{{Names: "abcdef-infra"}, {Names: "mycmp-app-runtime"}},
+						},
+					})
+				case apiPrefix + "/pods/mycmp-app/json":
+					writeJSON(w, PodInspectData{Name: "mycmp-app", State: tt.state})
+				default:
+					http.NotFound(w, r)
+				}
+			})
+			got, err := o.GetRunningPodFromSelector("component=mycmp")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetRunningPodFromSelector() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got.Spec.Containers) != 1 || got.Spec.Containers[0].Name != "runtime" {
+				t.Errorf("GetRunningPodFromSelector() containers = %v, want only the runtime container", got.Spec.Containers)
+			}
+		})
+	}
+}
+
+func TestPodmanAPI_PodRm(t *testing.T) {
+	o := newTestPodmanAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != apiPrefix+"/pods/mycmp-app" {
+			http.NotFound(w, r)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		writeJSON(w, apiError{Cause: "pod is running", Message: "pod mycmp-app is running", Response: 500})
+	})
+	err := o.PodRm("mycmp-app")
+	if err == nil || err.Error() != "pod mycmp-app is running" {
+		t.Errorf("PodRm() error = %v, want the message of the service", err)
+	}
+}
+
+func TestPodmanAPI_ExecCMDInContainer(t *testing.T) {
+	tests := []struct {
+		name       string
+		exitCode   int
+		wantStdout string
+		wantStderr string
+		wantErr    bool
+	}{
+		{
+			name:       "successful command",
+			exitCode:   0,
+			wantStdout: "input: hello",
+			wantStderr: "done",
+		},
+		{
+			name:       "failed command",
+			exitCode:   2,
+			wantStdout: "input: hello",
+			wantStderr: "done",
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := newTestPodmanAPI(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case apiPrefix + "/containers/mycmp-app-runtime/exec":
+					var config execCreateConfig
+					if err := json.NewDecoder(r.Body).Decode(&config); err != nil || !config.AttachStdin || len(config.Cmd) != 1 || config.Cmd[0] != "cat" {
+						http.Error(w, fmt.Sprintf("unexpected config %+v", config), http.StatusBadRequest)
+						return
+					}
+					w.WriteHeader(http.StatusCreated)
+					writeJSON(w, execCreateResponse{ID: "abc123"})
+				case apiPrefix + "/exec/abc123/start":
+					var config execStartConfig
+					if err := json.NewDecoder(r.Body).Decode(&config); err != nil {
+						http.Error(w, err.Error(), http.StatusBadRequest)
+						return
+					}
+					conn, rw, err := w.(http.Hijacker).Hijack()
+					if err != nil {
+						return
+					}
+					defer conn.Close()
+					_, _ = rw.WriteString("HTTP/1.1 101 UPGRADED\r\nContent-Type: application/vnd.docker.raw-stream\r\nConnection: Upgrade\r\nUpgrade: tcp\r\n\r\n")
+					_ = rw.Flush()
+					// Echo the input, once it is closed
+					input, _ := io.ReadAll(rw)
+					writeFrame(conn, 1, "input: "+string(input))
+					writeFrame(conn, 2, "done")
+				case apiPrefix + "/exec/abc123/json":
+					writeJSON(w, execInspect{ExitCode: tt.exitCode})
+				default:
+					http.NotFound(w, r)
+				}
+			})
+			var stdout, stderr bytes.Buffer
+			err := o.ExecCMDInContainer(context.Background(), "runtime", "mycmp-app", []string{"cat"}, &stdout, &stderr, strings.NewReader("hello"), false)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ExecCMDInContainer() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if stdout.String() != tt.wantStdout {
+				t.Errorf("stdout = %q, want %q", stdout.String(), tt.wantStdout)
+			}
+			if stderr.String() != tt.wantStderr {
+				t.Errorf("stderr = %q, want %q", stderr.String(), tt.wantStderr)
+			}
+		})
+	}
+}
+
+func TestPodmanAPI_GetPodLogs(t *testing.T) {
+	o := newTestPodmanAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case apiPrefix + "/pods/mycmp-app/json":
+			writeJSON(w, PodInspectData{
+				Name:             "mycmp-app",
+				InfraContainerID: "infra",
+				Containers: []InspectPodContainerInfo{
+					{ID: "infra", Name: "abcdef-infra"},
+					{ID: "runtime", Name: "mycmp-app-runtime"},
+				},
+			})
+		case apiPrefix + "/containers/mycmp-app-runtime/logs":
+			if r.URL.Query().Get("tail") != "10" || r.URL.Query().Get("timestamps") != "true" {
+				http.Error(w, "unexpected query "+r.URL.RawQuery, http.StatusBadRequest)
+				return
+			}
+			writeFrame(w, 1, "starting\n")
+			writeFrame(w, 2, "warning\n")
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	for _, containerName := range []string{"", "runtime"} {
+		t.Run(fmt.Sprintf("container %q", containerName), func(t *testing.T) {
+			logs, err := o.GetPodLogs("mycmp-app", containerName, platform.PodLogsOptions{TailLines: pointer.Int64(10), Timestamps: true})
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer logs.Close()
+			got, err := io.ReadAll(logs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != "starting\nwarning\n" {
+				t.Errorf("GetPodLogs() = %q, want %q", string(got), "starting\nwarning\n")
+			}
+		})
+	}
+}
@@ -41,6 +41,11 @@ func (o *PodmanCli) ListAllComponents() ([]api.ComponentAbstract, error) {
 		return nil, err
 	}
 
+	return GetComponentsFromPodsReports(list, commonflags.PlatformPodman), nil
+}
+
+// GetComponentsFromPodsReports returns the components described by the labels of the pods running on the platform
+func GetComponentsFromPodsReports(list []ListPodsReport, platform string) []api.ComponentAbstract {
 	for _, pod := range list {
 		klog.V(5).Infof("\npod name: %s", pod.Name)
 		klog.V(5).Infof("labels:")
@@ -79,8 +84,8 @@ func (o *PodmanCli) ListAllComponents() ([]api.ComponentAbstract, error) {
 			Type:             componentType,
 			ManagedByVersion: managedByVersion,
 			//lint:ignore SA1019 we need to output the deprecated value, before to remove it in a future release
-			RunningOn: commonflags.PlatformPodman,
-			Platform:  commonflags.PlatformPodman,
+			RunningOn: platform,
+			Platform:  platform,
 		}
 		mode := odolabels.GetMode(labels)
 		if mode != "" {
@@ -90,7 +95,7 @@ func (o *PodmanCli) ListAllComponents() ([]api.ComponentAbstract, error) {
 		components = append(components, component)
 	}
 
-	return components, nil
+	return components
 }
 
 func (o *PodmanCli) GetPodUsingComponentName(componentName string) (*corev1.Pod, error) {
@@ -0,0 +1,43 @@
+package podman
+
+import (
+	"strings"
+)
+
+// IsConnectionURL returns true if the connection is the URL of a Podman service (e.g. unix:///run/podman/podman.sock,
+// ssh://user@host:22/run/user/1000/podman/podman.sock or tcp://host:8080),
+// and false if it is the name of a system connection, as listed by "podman system connection list"
+func IsConnectionURL(connection string) bool {
+	return strings.Contains(connection, "://")
+}
+
+// connectionArgs returns the global arguments of the podman command to use the connection,
+// or no argument to use the default connection
+func connectionArgs(connection string) []string {
+	switch {
+	case connection == "":
+		return nil
+	case IsConnectionURL(connection):
+		return []string{"--url", connection}
+	default:
+		return []string{"--connection", connection}
+	}
+}
+
+// GetConnection returns the connection to the Podman service used by the client,
+// either the name of a system connection or the URL of the service, or an empty string for the default connection
+func (o *PodmanCli) GetConnection() string {
+	return o.connection
+}
+
+// GetHostInfo returns information about the host running the Podman service of the connection
+func (o *PodmanCli) GetHostInfo() (HostInfo, error) {
+	info, err := o.getInfo()
+	if err != nil {
+		return HostInfo{}, err
+	}
+	if info.Host == nil {
+		return HostInfo{}, nil
+	}
+	return *info.Host, nil
+}
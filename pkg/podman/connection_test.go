@@ -0,0 +1,116 @@
+package podman
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/redhat-developer/odo/pkg/config"
+	envcontext "github.com/redhat-developer/odo/pkg/config/context"
+)
+
+func Test_connectionArgs(t *testing.T) {
+	tests := []struct {
+		name       string
+		connection string
+		want       []string
+	}{
+		{
+			name: "default connection",
+		},
+		{
+			name:       "system connection",
+			connection: "podman-machine-default-root",
+			want:       []string{"--connection", "podman-machine-default-root"},
+		},
+		{
+			name:       "URL of the service",
+			connection: "unix:///run/podman/podman.sock",
+			want:       []string{"--url", "unix:///run/podman/podman.sock"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := connectionArgs(tt.connection)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("connectionArgs() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewPodmanCli(t *testing.T) {
+	script := []byte(`#!/bin/sh
+case "$*" in
+	"--connection podman-machine-default-root version --format json")
+		echo '{"Client": {"Version": "4.5.1"}, "Server": {"Version": "4.5.0"}}'
+		;;
+	"version --format json")
+		echo '{"Client": {"Version": "4.5.1"}}'
+		;;
+	*)
+		echo "Error: unable to connect to Podman socket" >&2
+		exit 125
+		;;
+esac`)
+	tests := []struct {
+		name           string
+		connection     string
+		globalArgs     []string
+		wantErr        bool
+		wantGlobalArgs []string
+	}{
+		{
+			name:       "default connection",
+			globalArgs: []string{"--log-level=debug"},
+			wantGlobalArgs: []string{
+				"--log-level=debug",
+			},
+		},
+		{
+			name:       "system connection",
+			connection: "podman-machine-default-root",
+			globalArgs: []string{"--log-level=debug"},
+			wantGlobalArgs: []string{
+				"--connection", "podman-machine-default-root", "--log-level=debug",
+			},
+		},
+		{
+			name:       "unreachable connection",
+			connection: "ssh://core@localhost:45678/run/podman/podman.sock",
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			podmanCmd := dir + "/podman.fake.sh"
+			err := os.WriteFile(podmanCmd, script, 0755)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ctx := envcontext.WithEnvConfig(context.Background(), config.Configuration{
+				PodmanCmd:                     podmanCmd,
+				PodmanCmdInitTimeout:          10 * time.Second,
+				OdoContainerBackendGlobalArgs: tt.globalArgs,
+			})
+
+			got, err := NewPodmanCli(ctx, tt.connection)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewPodmanCli() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.GetConnection() != tt.connection {
+				t.Errorf("GetConnection() = %q, want %q", got.GetConnection(), tt.connection)
+			}
+			if diff := cmp.Diff(tt.wantGlobalArgs, got.containerRunGlobalExtraArgs); diff != "" {
+				t.Errorf("global args mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
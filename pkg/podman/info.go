@@ -14,7 +14,20 @@ type podmanInfo struct {
 }
 
 type HostInfo struct {
-	CgroupsVersion string `json:"cgroupVersion"`
+	CgroupsVersion string       `json:"cgroupVersion"`
+	Security       SecurityInfo `json:"security"`
+	// RemoteSocket is the socket of the Podman service, for a remote connection
+	RemoteSocket *RemoteSocket `json:"remoteSocket,omitempty"`
+}
+
+type SecurityInfo struct {
+	// Rootless is true if the Podman service runs as a non-root user
+	Rootless bool `json:"rootless"`
+}
+
+type RemoteSocket struct {
+	Path   string `json:"path,omitempty"`
+	Exists bool   `json:"exists,omitempty"`
 }
 
 func (o *PodmanCli) getInfo() (podmanInfo, error) {
@@ -42,4 +42,11 @@ type Client interface {
 
 	// GetCapabilities returns the capabilities of the underlying system
 	GetCapabilities() (Capabilities, error)
+
+	// GetConnection returns the connection to the Podman service used by the client,
+	// either the name of a system connection or the URL of the service, or an empty string for the default connection
+	GetConnection() string
+
+	// GetHostInfo returns information about the host running the Podman service of the connection
+	GetHostInfo() (HostInfo, error)
 }
@@ -3,17 +3,29 @@ package podman
 import (
 	"io"
 	"os/exec"
+	"strconv"
 
 	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/platform"
 )
 
 // GetPodLogs returns the logs of the specified pod container.
 // All logs for all containers part of the pod are returned if an empty string is provided as container name.
-func (o *PodmanCli) GetPodLogs(podName, containerName string, followLog bool) (io.ReadCloser, error) {
+func (o *PodmanCli) GetPodLogs(podName, containerName string, options platform.PodLogsOptions) (io.ReadCloser, error) {
 	args := []string{"pod", "logs"}
-	if followLog {
+	if options.Follow {
 		args = append(args, "--follow")
 	}
+	if options.Since > 0 {
+		args = append(args, "--since", options.Since.String())
+	}
+	if options.TailLines != nil {
+		args = append(args, "--tail", strconv.FormatInt(*options.TailLines, 10))
+	}
+	if options.Timestamps {
+		args = append(args, "--timestamps")
+	}
 	if containerName != "" {
 		args = append(args, "--container", podName+"-"+containerName)
 	}
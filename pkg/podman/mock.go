@@ -11,6 +11,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	api "github.com/redhat-developer/odo/pkg/api"
+	platform "github.com/redhat-developer/odo/pkg/platform"
 	v1 "k8s.io/api/core/v1"
 	unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	watch "k8s.io/apimachinery/pkg/watch"
@@ -112,19 +113,48 @@ func (mr *MockClientMockRecorder) GetCapabilities() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCapabilities", reflect.TypeOf((*MockClient)(nil).GetCapabilities))
 }
 
+// GetConnection mocks base method.
+func (m *MockClient) GetConnection() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConnection")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetConnection indicates an expected call of GetConnection.
+func (mr *MockClientMockRecorder) GetConnection() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConnection", reflect.TypeOf((*MockClient)(nil).GetConnection))
+}
+
+// GetHostInfo mocks base method.
+func (m *MockClient) GetHostInfo() (HostInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHostInfo")
+	ret0, _ := ret[0].(HostInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHostInfo indicates an expected call of GetHostInfo.
+func (mr *MockClientMockRecorder) GetHostInfo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostInfo", reflect.TypeOf((*MockClient)(nil).GetHostInfo))
+}
+
 // GetPodLogs mocks base method.
-func (m *MockClient) GetPodLogs(podName, containerName string, followLog bool) (io.ReadCloser, error) {
+func (m *MockClient) GetPodLogs(podName, containerName string, options platform.PodLogsOptions) (io.ReadCloser, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPodLogs", podName, containerName, followLog)
+	ret := m.ctrl.Call(m, "GetPodLogs", podName, containerName, options)
 	ret0, _ := ret[0].(io.ReadCloser)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPodLogs indicates an expected call of GetPodLogs.
-func (mr *MockClientMockRecorder) GetPodLogs(podName, containerName, followLog interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) GetPodLogs(podName, containerName, options interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPodLogs", reflect.TypeOf((*MockClient)(nil).GetPodLogs), podName, containerName, followLog)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPodLogs", reflect.TypeOf((*MockClient)(nil).GetPodLogs), podName, containerName, options)
 }
 
 // GetPodUsingComponentName mocks base method.
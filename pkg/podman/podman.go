@@ -18,7 +18,9 @@ import (
 )
 
 type PodmanCli struct {
-	podmanCmd                   string
+	podmanCmd string
+	// connection is the name of a system connection, or the URL of the Podman service; empty for the default connection
+	connection                  string
 	podmanCmdInitTimeout        time.Duration
 	containerRunGlobalExtraArgs []string
 	containerRunExtraArgs       []string
@@ -27,17 +29,24 @@ type PodmanCli struct {
 var _ Client = (*PodmanCli)(nil)
 var _ platform.Client = (*PodmanCli)(nil)
 
-// NewPodmanCli returns a new podman client, or nil if the podman command is not accessible in the system
-func NewPodmanCli(ctx context.Context) (*PodmanCli, error) {
+// NewPodmanCli returns a new podman client using the connection to the Podman service, either the name of a system connection
+// or the URL of the service (or the default connection if empty), or nil if the podman command is not accessible in the system
+func NewPodmanCli(ctx context.Context, connection string) (*PodmanCli, error) {
+	globalArgs := append(connectionArgs(connection), envcontext.GetEnvConfig(ctx).OdoContainerBackendGlobalArgs...)
 	// Check if podman is available in the system
 	cli := &PodmanCli{
-		podmanCmd:                   envcontext.GetEnvConfig(ctx).PodmanCmd,
-		podmanCmdInitTimeout:        envcontext.GetEnvConfig(ctx).PodmanCmdInitTimeout,
-		containerRunGlobalExtraArgs: envcontext.GetEnvConfig(ctx).OdoContainerBackendGlobalArgs,
+		podmanCmd:            envcontext.GetEnvConfig(ctx).PodmanCmd,
+		connection:           connection,
+		podmanCmdInitTimeout: envcontext.GetEnvConfig(ctx).PodmanCmdInitTimeout,
+		// The capacity is limited, so that appending arguments always allocates a new slice
+		containerRunGlobalExtraArgs: globalArgs[:len(globalArgs):len(globalArgs)],
 		containerRunExtraArgs:       envcontext.GetEnvConfig(ctx).OdoContainerRunArgs,
 	}
 	version, err := cli.Version(ctx)
 	if err != nil {
+		if connection != "" {
+			return nil, fmt.Errorf("unable to use the Podman connection %q: %w", connection, err)
+		}
 		return nil, err
 	}
 	if version.Client == nil {
@@ -210,6 +219,11 @@ func (o *PodmanCli) VolumeLs() (map[string]bool, error) {
 }
 
 func (o *PodmanCli) CleanupPodResources(pod *corev1.Pod, cleanupVolumes bool) error {
+	return cleanupPodResources(o, pod, cleanupVolumes)
+}
+
+// cleanupPodResources stops and removes the pod, and its volumes if cleanupVolumes is true
+func cleanupPodResources(o Client, pod *corev1.Pod, cleanupVolumes bool) error {
 	err := o.PodStop(pod.GetName())
 	if err != nil {
 		return err
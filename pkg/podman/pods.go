@@ -20,6 +20,35 @@ import (
 
 // GetPodsMatchingSelector returns all pods matching the given label selector.
 func (o *PodmanCli) GetPodsMatchingSelector(selector string) (*corev1.PodList, error) {
+	return getPodsMatchingSelector(o, selector)
+}
+
+// GetAllResourcesFromSelector returns all resources of any kind matching the given label selector.
+func (o *PodmanCli) GetAllResourcesFromSelector(selector string, _ string) ([]unstructured.Unstructured, error) {
+	return getAllResourcesFromSelector(o, selector)
+}
+
+// GetAllPodsInNamespaceMatchingSelector returns all pods matching the given label selector and in the specified namespace.
+func (o *PodmanCli) GetAllPodsInNamespaceMatchingSelector(selector string, ns string) (*corev1.PodList, error) {
+	// In podman, we return the pods, as there is no resource containing PodSpec
+	return o.GetPodsMatchingSelector(selector)
+}
+
+// GetRunningPodFromSelector returns any pod matching the given label selector.
+// If multiple pods are found, implementations might have different behavior, by either returning an error or returning any element.
+func (o *PodmanCli) GetRunningPodFromSelector(selector string) (*corev1.Pod, error) {
+	return getRunningPodFromSelector(o, selector)
+}
+
+// podsGetter is implemented by the clients getting the pods from Podman, either with the podman command or with the REST API
+type podsGetter interface {
+	getPodsFromSelector(selector string) ([]ListPodsReport, error)
+	KubeGenerate(name string) (*corev1.Pod, error)
+	PodInspect(podname string) (PodInspectData, error)
+}
+
+// getPodsMatchingSelector returns all pods matching the given label selector.
+func getPodsMatchingSelector(o podsGetter, selector string) (*corev1.PodList, error) {
 	podsReport, err := o.getPodsFromSelector(selector)
 	if err != nil {
 		return nil, err
@@ -51,8 +80,8 @@ func (o *PodmanCli) GetPodsMatchingSelector(selector string) (*corev1.PodList, e
 	return &result, nil
 }
 
-// GetAllResourcesFromSelector returns all resources of any kind matching the given label selector.
-func (o *PodmanCli) GetAllResourcesFromSelector(selector string, _ string) ([]unstructured.Unstructured, error) {
+// getAllResourcesFromSelector returns all resources of any kind matching the given label selector.
+func getAllResourcesFromSelector(o podsGetter, selector string) ([]unstructured.Unstructured, error) {
 	list, err := o.getPodsFromSelector(selector)
 	if err != nil {
 		return nil, err
@@ -76,15 +105,8 @@ func (o *PodmanCli) GetAllResourcesFromSelector(selector string, _ string) ([]un
 	return result, nil
 }
 
-// GetAllPodsInNamespaceMatchingSelector returns all pods matching the given label selector and in the specified namespace.
-func (o *PodmanCli) GetAllPodsInNamespaceMatchingSelector(selector string, ns string) (*corev1.PodList, error) {
-	// In podman, we return the pods, as there is no resource containing PodSpec
-	return o.GetPodsMatchingSelector(selector)
-}
-
-// GetRunningPodFromSelector returns any pod matching the given label selector.
-// If multiple pods are found, implementations might have different behavior, by either returning an error or returning any element.
-func (o *PodmanCli) GetRunningPodFromSelector(selector string) (*corev1.Pod, error) {
+// getRunningPodFromSelector returns the pod matching the given label selector, or an error if multiple pods are found
+func getRunningPodFromSelector(o podsGetter, selector string) (*corev1.Pod, error) {
 	list, err := o.getPodsFromSelector(selector)
 	if err != nil {
 		return nil, err
@@ -150,35 +172,55 @@ type podWatcher struct {
 }
 
 func (o *PodmanCli) PodWatcher(ctx context.Context, selector string) (watch.Interface, error) {
+	return NewContainersWatcher(o.podmanCmd, o.containerRunGlobalExtraArgs), nil
+}
+
+// NewContainersWatcher returns a watcher sending an event every time a container is started or removed,
+// by regularly listing the running containers with the "ps" command of a Podman (or Docker compatible) CLI
+func NewContainersWatcher(cmd string, globalArgs []string) watch.Interface {
+	args := make([]string, 0, len(globalArgs)+2)
+	args = append(args, globalArgs...)
+	args = append(args, "ps", "--quiet")
+	return newContainersWatcher(func() ([]string, error) {
+		out, err := exec.Command(cmd, args...).Output()
+		if err != nil {
+			return nil, err
+		}
+		var ids []string
+		scanner := bufio.NewScanner(bytes.NewReader(out))
+		for scanner.Scan() {
+			ids = append(ids, scanner.Text())
+		}
+		return ids, nil
+	})
+}
 
+// newContainersWatcher returns a watcher sending an event every time a container is started or removed,
+// by regularly calling listContainers to get the IDs of the running containers
+func newContainersWatcher(listContainers func() ([]string, error)) watch.Interface {
 	watcher := podWatcher{
 		stop:   make(chan struct{}),
 		pods:   make(map[string]struct{}),
 		events: make(chan watch.Event),
 	}
-	go watcher.watch(o.podmanCmd, o.containerRunGlobalExtraArgs)
-	return watcher, nil
+	go watcher.watch(listContainers)
+	return watcher
 }
 
-func (o podWatcher) watch(podmanCmd string, containerRunGlobalExtraArgs []string) {
-	args := []string{"ps", "--quiet"}
-	args = append(containerRunGlobalExtraArgs, args...)
+func (o podWatcher) watch(listContainers func() ([]string, error)) {
 	ticker := time.NewTicker(3 * time.Second)
 	for {
 		select {
 		case <-o.stop:
 			return
 		case <-ticker.C:
-			cmd := exec.Command(podmanCmd, args...)
-			out, err := cmd.Output()
+			ids, err := listContainers()
 			if err != nil {
 				klog.V(4).Infof("error getting containers from podman: %s", err)
 				continue
 			}
-			scanner := bufio.NewScanner(bytes.NewReader(out))
 			currentPods := make(map[string]struct{})
-			for scanner.Scan() {
-				podName := scanner.Text()
+			for _, podName := range ids {
 				currentPods[podName] = struct{}{}
 				if _, ok := o.pods[podName]; !ok {
 					o.events <- watch.Event{
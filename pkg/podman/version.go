@@ -24,6 +24,8 @@ type Version struct {
 
 type SystemVersionReport struct {
 	Client *Version `json:",omitempty"`
+	// Server is the version of the Podman service, returned for a remote connection only
+	Server *Version `json:",omitempty"`
 }
 
 // Version returns the version of the Podman client.
@@ -33,7 +35,9 @@ func (o *PodmanCli) Version(ctx context.Context) (SystemVersionReport, error) {
 	// This is to avoid situations like the one described in https://github.com/redhat-developer/odo/issues/6575
 	// (where a podman CLI that takes too long to respond affects the "odo dev" command, even if the user did not intend to use the Podman platform).
 
-	cmd := exec.CommandContext(ctx, o.podmanCmd, "version", "--format", "json")
+	// The version of the service is returned, and the connection validated, when a connection is set
+	args := append(connectionArgs(o.connection), "version", "--format", "json")
+	cmd := exec.CommandContext(ctx, o.podmanCmd, args...)
 	klog.V(3).Infof("executing %v", cmd.Args)
 
 	outbuf, errbuf := new(bytes.Buffer), new(bytes.Buffer)
@@ -0,0 +1,67 @@
+package portForward
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// DefaultDynamicLocalAddress is the local address the ports forwarded dynamically listen on, if not specified
+const DefaultDynamicLocalAddress = "127.0.0.1"
+
+const (
+	dynamicStartPort = 20001
+	dynamicEndPort   = dynamicStartPort + 10000
+)
+
+// ErrPortNotForwarded is returned when removing a port which has not been forwarded dynamically
+var ErrPortNotForwarded = errors.New("no port is forwarded dynamically on this local port")
+
+// ValidateDynamicPort validates the definition of a port to forward dynamically, and sets its default values.
+// A free local port, not used by any port of usedPorts, is assigned if the port does not define a local port.
+func ValidateDynamicPort(port api.ForwardedPort, usedPorts []api.ForwardedPort) (api.ForwardedPort, error) {
+	if port.ContainerName == "" {
+		return api.ForwardedPort{}, errors.New("the container name must be specified")
+	}
+	if port.ContainerPort <= 0 || port.ContainerPort > largestPortValue {
+		return api.ForwardedPort{}, fmt.Errorf("the container port must be a number in the range [1, %d]", largestPortValue)
+	}
+	if port.LocalPort < 0 || port.LocalPort > largestPortValue {
+		return api.ForwardedPort{}, fmt.Errorf("the local port must be a number in the range [1, %d]", largestPortValue)
+	}
+	if port.LocalAddress == "" {
+		port.LocalAddress = DefaultDynamicLocalAddress
+	}
+	port.IsDynamic = true
+
+	isUsed := func(localPort int) bool {
+		for _, used := range usedPorts {
+			if used.LocalPort == localPort && !used.IsReverse {
+				return true
+			}
+		}
+		return false
+	}
+
+	if port.LocalPort != 0 {
+		if isUsed(port.LocalPort) {
+			return api.ForwardedPort{}, fmt.Errorf("local port %d is already forwarded", port.LocalPort)
+		}
+		return port, nil
+	}
+
+	start := dynamicStartPort
+	for {
+		freePort, err := util.NextFreePort(start, dynamicEndPort, nil, port.LocalAddress)
+		if err != nil {
+			return api.ForwardedPort{}, err
+		}
+		if !isUsed(freePort) {
+			port.LocalPort = freePort
+			return port, nil
+		}
+		start = freePort + 1
+	}
+}
@@ -0,0 +1,71 @@
+package portForward
+
+import (
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/redhat-developer/odo/pkg/api"
+)
+
+func TestValidateDynamicPort(t *testing.T) {
+	// A local port is kept busy, to check that it is not assigned
+	listener, err := net.Listen("tcp", "127.0.0.1:20001")
+	if err == nil {
+		defer listener.Close()
+	}
+
+	tests := []struct {
+		name      string
+		port      api.ForwardedPort
+		usedPorts []api.ForwardedPort
+		want      api.ForwardedPort
+		wantErr   bool
+	}{
+		{
+			name: "local port defined",
+			port: api.ForwardedPort{ContainerName: "runtime", ContainerPort: 9229, LocalPort: 9229},
+			want: api.ForwardedPort{IsDynamic: true, ContainerName: "runtime", ContainerPort: 9229, LocalAddress: "127.0.0.1", LocalPort: 9229},
+		},
+		{
+			name:      "free local port assigned, excluding used ports",
+			port:      api.ForwardedPort{ContainerName: "runtime", ContainerPort: 9229, LocalAddress: "127.0.0.1"},
+			usedPorts: []api.ForwardedPort{{LocalPort: 20002}, {LocalPort: 20003}, {IsReverse: true, LocalPort: 20004}},
+			want:      api.ForwardedPort{IsDynamic: true, ContainerName: "runtime", ContainerPort: 9229, LocalAddress: "127.0.0.1", LocalPort: 20004},
+		},
+		{
+			name:      "local port already forwarded",
+			port:      api.ForwardedPort{ContainerName: "runtime", ContainerPort: 9229, LocalPort: 20010},
+			usedPorts: []api.ForwardedPort{{LocalPort: 20010}},
+			wantErr:   true,
+		},
+		{
+			name:    "missing container name",
+			port:    api.ForwardedPort{ContainerPort: 9229},
+			wantErr: true,
+		},
+		{
+			name:    "container port out of range",
+			port:    api.ForwardedPort{ContainerName: "runtime", ContainerPort: 75432},
+			wantErr: true,
+		},
+		{
+			name:    "local port out of range",
+			port:    api.ForwardedPort{ContainerName: "runtime", ContainerPort: 9229, LocalPort: -1},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ValidateDynamicPort(tt.port, tt.usedPorts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateDynamicPort() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ValidateDynamicPort() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -28,9 +28,41 @@ type Client interface {
 		customAddress string,
 	) error
 
-	// StopPortForwarding stops the port forwarding for the specified component.
+	// StartReversePortForwarding makes each port of reversePorts, inside the pod of the component,
+	// tunnel back to the local address and port of the definition.
+	// It replaces the reverse port forwarding previously started for the component.
+	// errors will be written to errOut writer
+	StartReversePortForwarding(
+		ctx context.Context,
+		componentName string,
+		reversePorts []api.ForwardedPort,
+		errOut io.Writer,
+	) error
+
+	// AddPortForward forwards a local port to a port of a container of the component, in addition to the ports
+	// forwarded by StartPortForwarding, until it is removed with RemovePortForward.
+	// The container port does not need to be declared as an endpoint in the Devfile.
+	// A free local port is assigned if port does not define a local port.
+	// The forwarded port is returned, and saved into the state file.
+	// errors happening after the port forwarding is started will be written to errOut writer
+	AddPortForward(
+		ctx context.Context,
+		componentName string,
+		port api.ForwardedPort,
+		errOut io.Writer,
+	) (api.ForwardedPort, error)
+
+	// RemovePortForward stops the port forwarding on localPort started by AddPortForward, and removes it from the state file.
+	// ErrPortNotForwarded is returned if no port is forwarded dynamically on localPort.
+	RemovePortForward(ctx context.Context, localPort int) error
+
+	// StopPortForwarding stops the port forwarding, and the reverse port forwarding, for the specified component.
+	// The ports added with AddPortForward are forwarded again to the pod of the component, as soon as it is available.
 	StopPortForwarding(ctx context.Context, componentName string)
 
 	// GetForwardedPorts returns the list of ports for each container currently forwarded.
 	GetForwardedPorts() map[string][]v1alpha2.Endpoint
+
+	// GetReverseForwardedPorts returns the list of ports currently reverse forwarded.
+	GetReverseForwardedPorts() []api.ForwardedPort
 }
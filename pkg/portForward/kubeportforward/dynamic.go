@@ -0,0 +1,131 @@
+package kubeportforward
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/watch"
+)
+
+// forwardingMessage is written by the port forwarder to its output as soon as it is listening on the local port
+const forwardingMessage = "Forwarding from"
+
+// dynamicForwarder forwards a local port to a port of a container of the component.
+// The pod of the component is fetched again each time the connection is lost, or restart is called,
+// so the port keeps being forwarded when the pod is replaced.
+type dynamicForwarder struct {
+	kubernetesClient kclient.ClientInterface
+	componentName    string
+	port             api.ForwardedPort
+	errOut           io.Writer
+
+	// mu protects stopSession
+	mu sync.Mutex
+	// stopSession is closed to stop the current port forwarding session
+	stopSession chan struct{}
+}
+
+// run forwards the port until ctx is cancelled.
+// ready receives nil as soon as the port is forwarded the first time, or the error preventing the first port forwarding to start,
+// in which case run exits without retrying.
+func (o *dynamicForwarder) run(ctx context.Context, ready chan<- error) {
+	var readyOnce sync.Once
+	notifyReady := func() {
+		readyOnce.Do(func() { ready <- nil })
+	}
+
+	backo := watch.NewExpBackoff()
+	for ctx.Err() == nil {
+		err := o.session(ctx, notifyReady)
+		if ctx.Err() != nil {
+			break
+		}
+		if err == nil {
+			// the session has been stopped by restart
+			backo.Reset()
+			continue
+		}
+		var firstSession bool
+		readyOnce.Do(func() {
+			firstSession = true
+			ready <- err
+		})
+		if firstSession {
+			return
+		}
+		fmt.Fprintf(o.errOut, "Failed to setup port-forwarding for container port %d: %v\n", o.port.ContainerPort, err)
+		select {
+		case <-ctx.Done():
+		case <-time.After(backo.Delay()):
+		}
+	}
+	readyOnce.Do(func() { ready <- ctx.Err() })
+}
+
+// session forwards the port to the current pod of the component, until the connection is lost, ctx is cancelled or restart is called
+func (o *dynamicForwarder) session(ctx context.Context, notifyReady func()) error {
+	pod, err := o.kubernetesClient.GetPodUsingComponentName(o.componentName)
+	if err != nil {
+		return err
+	}
+
+	stopChan := make(chan struct{})
+	o.mu.Lock()
+	o.stopSession = stopChan
+	o.mu.Unlock()
+
+	sessionDone := make(chan struct{})
+	defer close(sessionDone)
+	go func() {
+		select {
+		case <-ctx.Done():
+			o.restart()
+		case <-sessionDone:
+		}
+	}()
+
+	out := &readyWriter{notify: notifyReady}
+	pair := fmt.Sprintf("%d:%d", o.port.LocalPort, o.port.ContainerPort)
+	return o.kubernetesClient.SetupPortForwarding(pod, []string{pair}, out, o.errOut, stopChan, o.port.LocalAddress)
+}
+
+// restart stops the current port forwarding session, if any, so a new one is started to the current pod of the component
+func (o *dynamicForwarder) restart() {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.stopSession != nil {
+		close(o.stopSession)
+		o.stopSession = nil
+	}
+}
+
+// readyWriter receives the output of the port forwarder, and calls notify when the port forwarder reports it is listening
+type readyWriter struct {
+	mu     sync.Mutex
+	buffer bytes.Buffer
+	found  bool
+	notify func()
+}
+
+var _ io.Writer = (*readyWriter)(nil)
+
+func (o *readyWriter) Write(p []byte) (int, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.found {
+		return len(p), nil
+	}
+	o.buffer.Write(p)
+	if bytes.Contains(o.buffer.Bytes(), []byte(forwardingMessage)) {
+		o.found = true
+		o.buffer.Reset()
+		o.notify()
+	}
+	return len(p), nil
+}
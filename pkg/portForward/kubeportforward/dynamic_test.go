@@ -0,0 +1,119 @@
+package kubeportforward
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/kclient"
+)
+
+func Test_dynamicForwarder_run(t *testing.T) {
+	pods := []*corev1.Pod{
+		{ObjectMeta: metav1.ObjectMeta{Name: "mypod-1"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "mypod-2"}},
+	}
+	sessions := make(chan string, 10)
+
+	ctrl := gomock.NewController(t)
+	kubeClient := kclient.NewMockClientInterface(ctrl)
+	gomock.InOrder(
+		kubeClient.EXPECT().GetPodUsingComponentName("mycomponent").Return(pods[0], nil),
+		kubeClient.EXPECT().GetPodUsingComponentName("mycomponent").Return(pods[1], nil).AnyTimes(),
+	)
+	kubeClient.EXPECT().SetupPortForwarding(gomock.Any(), []string{"20010:9229"}, gomock.Any(), gomock.Any(), gomock.Any(), "127.0.0.1").
+		DoAndReturn(func(pod *corev1.Pod, portPairs []string, out, errOut io.Writer, stopChan chan struct{}, address string) error {
+			_, _ = out.Write([]byte("Forwarding from 127.0.0.1:20010 -> 9229\n"))
+			sessions <- pod.GetName()
+			<-stopChan
+			return nil
+		}).AnyTimes()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	fwd := &dynamicForwarder{
+		kubernetesClient: kubeClient,
+		componentName:    "mycomponent",
+		port:             api.ForwardedPort{IsDynamic: true, ContainerName: "runtime", ContainerPort: 9229, LocalAddress: "127.0.0.1", LocalPort: 20010},
+		errOut:           io.Discard,
+	}
+	ready := make(chan error, 1)
+	done := make(chan struct{})
+	go func() {
+		fwd.run(ctx, ready)
+		close(done)
+	}()
+
+	waitSession := func(want string) {
+		select {
+		case got := <-sessions:
+			if got != want {
+				t.Errorf("port forwarded to pod %q, expected %q", got, want)
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatalf("timeout waiting for port forwarding to pod %q", want)
+		}
+	}
+
+	waitSession("mypod-1")
+	select {
+	case err := <-ready:
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("timeout waiting for the port forwarding to be ready")
+	}
+
+	// the port is forwarded again to the new pod of the component
+	fwd.restart()
+	waitSession("mypod-2")
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timeout waiting for the forwarder to stop")
+	}
+}
+
+func Test_dynamicForwarder_run_error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kubeClient := kclient.NewMockClientInterface(ctrl)
+	kubeClient.EXPECT().GetPodUsingComponentName("mycomponent").Return(&corev1.Pod{}, nil)
+	kubeClient.EXPECT().SetupPortForwarding(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(errors.New("address already in use"))
+
+	fwd := &dynamicForwarder{
+		kubernetesClient: kubeClient,
+		componentName:    "mycomponent",
+		port:             api.ForwardedPort{IsDynamic: true, ContainerName: "runtime", ContainerPort: 9229, LocalAddress: "127.0.0.1", LocalPort: 20010},
+		errOut:           io.Discard,
+	}
+	ready := make(chan error, 1)
+	done := make(chan struct{})
+	go func() {
+		fwd.run(context.Background(), ready)
+		close(done)
+	}()
+
+	select {
+	case err := <-ready:
+		if err == nil || err.Error() != "address already in use" {
+			t.Errorf("got error %v, expected %q", err, "address already in use")
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("timeout waiting for the error")
+	}
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("the forwarder should exit when the first port forwarding fails")
+	}
+}
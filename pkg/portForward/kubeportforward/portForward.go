@@ -7,10 +7,14 @@ import (
 	"io"
 	"reflect"
 	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/fatih/color"
 	"k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/klog"
 
@@ -41,12 +45,44 @@ type PFClient struct {
 
 	// indicates that the port forwarding is started, and not stopped
 	isRunning bool
+
+	// mu protects forwardedPorts, udpPorts and reversePorts, saved together into the state file
+	mu sync.Mutex
+	// forwardedPorts are the ports currently forwarded
+	forwardedPorts []api.ForwardedPort
+	// udpPorts are the UDP ports currently forwarded
+	udpPorts []api.ForwardedPort
+	// stopUDP stops the UDP port forwarding
+	stopUDP context.CancelFunc
+	// udpWg is done when all the UDP forwarders have exited
+	udpWg sync.WaitGroup
+	// reversePorts are the ports currently reverse forwarded
+	reversePorts []api.ForwardedPort
+	// stopReverse stops the reverse port forwarding
+	stopReverse context.CancelFunc
+	// reverseWg is done when all the reverse forwarders have exited
+	reverseWg sync.WaitGroup
+
+	// dynamicMu protects dynamicPorts
+	dynamicMu sync.Mutex
+	// dynamicPorts are the ports forwarded with AddPortForward, by local port
+	dynamicPorts map[int]*dynamicPort
+}
+
+// dynamicPort is a port forwarded with AddPortForward
+type dynamicPort struct {
+	forwarder *dynamicForwarder
+	// stop stops the forwarder
+	stop context.CancelFunc
+	// done is closed when the forwarder has exited
+	done chan struct{}
 }
 
 func NewPFClient(kubernetesClient kclient.ClientInterface, stateClient state.Client) *PFClient {
 	return &PFClient{
 		kubernetesClient: kubernetesClient,
 		stateClient:      stateClient,
+		dynamicPorts:     make(map[int]*dynamicPort),
 	}
 }
 
@@ -59,7 +95,7 @@ func (o *PFClient) StartPortForwarding(ctx context.Context, devFileObj parser.De
 		return err
 	}
 
-	if o.stopChan != nil && reflect.DeepEqual(ceMapping, o.appliedEndpoints) {
+	if (o.stopChan != nil || o.stopUDP != nil) && reflect.DeepEqual(ceMapping, o.appliedEndpoints) {
 		return nil
 	}
 
@@ -71,25 +107,35 @@ func (o *PFClient) StartPortForwarding(ctx context.Context, devFileObj parser.De
 		klog.V(4).Infof("no endpoint declared in the component, no ports are forwarded")
 		return nil
 	}
-	o.stopChan = make(chan struct{}, 1)
+	tcpMapping, udpMapping := splitEndpointsByProtocol(ceMapping)
 
-	var portPairs map[string][]string
-	if len(definedPorts) != 0 {
-		portPairs = getCustomPortPairs(definedPorts, ceMapping, customAddress)
-	} else if randomPorts {
-		portPairs = randomPortPairsFromContainerEndpoints(ceMapping)
-	} else {
-		portPairs = portPairsFromContainerEndpoints(ceMapping, customAddress)
+	pod, err := o.kubernetesClient.GetPodUsingComponentName(componentName)
+	if err != nil {
+		return err
 	}
+
+	if len(udpMapping) != 0 {
+		udpPortPairs := getPortPairs(udpMapping, definedPorts, randomPorts, customAddress)
+		err = o.startUDPPortForwarding(ctx, pod.GetName(), udpMapping, udpPortPairs, customAddress, errOut)
+		if err != nil {
+			return err
+		}
+	}
+
 	var portPairsSlice []string
-	for _, v1 := range portPairs {
+	for _, v1 := range getPortPairs(tcpMapping, definedPorts, randomPorts, customAddress) {
 		portPairsSlice = append(portPairsSlice, v1...)
 	}
-	pod, err := o.kubernetesClient.GetPodUsingComponentName(componentName)
-	if err != nil {
-		return err
+	if len(portPairsSlice) == 0 {
+		err = o.saveForwardedPorts(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to save forwarded ports to state file: %v", err)
+		}
+		return nil
 	}
 
+	o.stopChan = make(chan struct{}, 1)
+
 	o.originalErrorHandlers = append([]func(error){}, runtime.ErrorHandlers...)
 
 	runtime.ErrorHandlers = append(runtime.ErrorHandlers, func(err error) {
@@ -111,11 +157,14 @@ func (o *PFClient) StartPortForwarding(ctx context.Context, devFileObj parser.De
 		backo := watch.NewExpBackoff()
 		for {
 			o.finishedChan = make(chan struct{}, 1)
-			portsBuf := NewPortWriter(log.GetStdout(), len(portPairsSlice), ceMapping, customAddress)
+			portsBuf := NewPortWriter(log.GetStdout(), len(portPairsSlice), tcpMapping, customAddress)
 
 			go func() {
 				portsBuf.Wait()
-				err = o.stateClient.SetForwardedPorts(ctx, portsBuf.GetForwardedPorts())
+				o.mu.Lock()
+				o.forwardedPorts = portsBuf.GetForwardedPorts()
+				o.mu.Unlock()
+				err = o.saveForwardedPorts(ctx)
 				if err != nil {
 					err = fmt.Errorf("unable to save forwarded ports to state file: %v", err)
 				}
@@ -147,7 +196,238 @@ func (o *PFClient) StartPortForwarding(ctx context.Context, devFileObj parser.De
 	}
 }
 
+func (o *PFClient) StartReversePortForwarding(ctx context.Context, componentName string, reversePorts []api.ForwardedPort, errOut io.Writer) error {
+	if o.stopReverse != nil && reflect.DeepEqual(reversePorts, o.GetReverseForwardedPorts()) {
+		return nil
+	}
+
+	o.stopReversePortForwarding()
+
+	if len(reversePorts) == 0 {
+		return o.saveForwardedPorts(ctx)
+	}
+
+	pod, err := o.kubernetesClient.GetPodUsingComponentName(componentName)
+	if err != nil {
+		return err
+	}
+
+	reverseCtx, stop := context.WithCancel(ctx)
+	o.stopReverse = stop
+	applied := make([]api.ForwardedPort, 0, len(reversePorts))
+	for _, port := range reversePorts {
+		fwd := &reverseForwarder{
+			kubernetesClient: o.kubernetesClient,
+			podName:          pod.GetName(),
+			port:             port,
+			errOut:           errOut,
+		}
+		o.reverseWg.Add(1)
+		go func() {
+			defer o.reverseWg.Done()
+			fwd.run(reverseCtx)
+		}()
+		applied = append(applied, port)
+		s := fmt.Sprintf("Reverse forwarding from container port %d -> %s:%d", port.ContainerPort, port.LocalAddress, port.LocalPort)
+		fmt.Fprintf(log.GetStdout(), " -  %s", log.SboldColor(color.FgGreen, s))
+	}
+
+	o.mu.Lock()
+	o.reversePorts = applied
+	o.mu.Unlock()
+
+	err = o.saveForwardedPorts(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to save forwarded ports to state file: %v", err)
+	}
+	return nil
+}
+
+// stopReversePortForwarding stops the reverse forwarders, and waits for them to exit
+func (o *PFClient) stopReversePortForwarding() {
+	if o.stopReverse == nil {
+		return
+	}
+	o.stopReverse()
+	o.stopReverse = nil
+	o.reverseWg.Wait()
+
+	o.mu.Lock()
+	o.reversePorts = nil
+	o.mu.Unlock()
+}
+
+// startUDPPortForwarding starts a UDP forwarder for each port pair, the endpoints of the pairs being found in mapping
+func (o *PFClient) startUDPPortForwarding(ctx context.Context, podName string, mapping map[string][]v1alpha2.Endpoint, portPairs map[string][]string, address string, errOut io.Writer) error {
+	if address == "" {
+		address = "127.0.0.1"
+	}
+	var forwarders []*udpForwarder
+	for _, container := range sortedContainers(portPairs) {
+		if len(portPairs[container]) == 0 {
+			continue
+		}
+		err := checkUDPRelayRequirements(ctx, o.kubernetesClient, podName, container)
+		if err != nil {
+			for _, f := range forwarders {
+				_ = f.conn.Close()
+			}
+			return err
+		}
+		for _, pair := range portPairs[container] {
+			port, err := getUDPForwardedPort(mapping, container, pair, address)
+			if err != nil {
+				return err
+			}
+			fwd, err := newUDPForwarder(o.kubernetesClient, podName, port, errOut)
+			if err != nil {
+				for _, f := range forwarders {
+					_ = f.conn.Close()
+				}
+				return fmt.Errorf("unable to forward UDP port %d of container %q: %w", port.ContainerPort, container, err)
+			}
+			forwarders = append(forwarders, fwd)
+		}
+	}
+
+	udpCtx, stop := context.WithCancel(ctx)
+	o.stopUDP = stop
+	applied := make([]api.ForwardedPort, 0, len(forwarders))
+	for _, fwd := range forwarders {
+		fwd := fwd
+		o.udpWg.Add(1)
+		go func() {
+			defer o.udpWg.Done()
+			fwd.run(udpCtx)
+		}()
+		applied = append(applied, fwd.port)
+		s := fmt.Sprintf("Forwarding from %s:%d -> %d (UDP)\n", fwd.port.LocalAddress, fwd.port.LocalPort, fwd.port.ContainerPort)
+		fmt.Fprintf(log.GetStdout(), " -  %s", log.SboldColor(color.FgGreen, s))
+	}
+
+	o.mu.Lock()
+	o.udpPorts = applied
+	o.mu.Unlock()
+	return nil
+}
+
+// stopUDPPortForwarding stops the UDP forwarders, and waits for them to exit
+func (o *PFClient) stopUDPPortForwarding() {
+	if o.stopUDP == nil {
+		return
+	}
+	o.stopUDP()
+	o.stopUDP = nil
+	o.udpWg.Wait()
+
+	o.mu.Lock()
+	o.udpPorts = nil
+	o.mu.Unlock()
+}
+
+// saveForwardedPorts saves the forwarded and reverse forwarded ports into the state file
+func (o *PFClient) saveForwardedPorts(ctx context.Context) error {
+	o.mu.Lock()
+	ports := make([]api.ForwardedPort, 0, len(o.forwardedPorts)+len(o.udpPorts)+len(o.reversePorts))
+	ports = append(ports, o.forwardedPorts...)
+	ports = append(ports, o.udpPorts...)
+	ports = append(ports, o.reversePorts...)
+	o.mu.Unlock()
+	return o.stateClient.SetForwardedPorts(ctx, ports)
+}
+
+func (o *PFClient) AddPortForward(ctx context.Context, componentName string, port api.ForwardedPort, errOut io.Writer) (api.ForwardedPort, error) {
+	o.dynamicMu.Lock()
+	defer o.dynamicMu.Unlock()
+
+	o.mu.Lock()
+	usedPorts := append([]api.ForwardedPort(nil), o.forwardedPorts...)
+	o.mu.Unlock()
+	for _, p := range o.dynamicPorts {
+		usedPorts = append(usedPorts, p.forwarder.port)
+	}
+	port, err := portForward.ValidateDynamicPort(port, usedPorts)
+	if err != nil {
+		return api.ForwardedPort{}, err
+	}
+
+	// The port forwarding must outlive the context of the caller, and is stopped by RemovePortForward
+	forwarderCtx, stop := context.WithCancel(context.Background())
+	fwd := &dynamicForwarder{
+		kubernetesClient: o.kubernetesClient,
+		componentName:    componentName,
+		port:             port,
+		errOut:           errOut,
+	}
+	ready := make(chan error, 1)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fwd.run(forwarderCtx, ready)
+	}()
+
+	select {
+	case err = <-ready:
+	case <-ctx.Done():
+		err = ctx.Err()
+	case <-time.After(1 * time.Minute):
+		err = errors.New("timeout waiting for the port forwarding to be ready")
+	}
+	if err != nil {
+		stop()
+		<-done
+		return api.ForwardedPort{}, fmt.Errorf("unable to forward local port %d to container port %d: %w", port.LocalPort, port.ContainerPort, err)
+	}
+
+	o.dynamicPorts[port.LocalPort] = &dynamicPort{
+		forwarder: fwd,
+		stop:      stop,
+		done:      done,
+	}
+	err = o.stateClient.AddForwardedPort(ctx, port)
+	if err != nil {
+		// The port forwarding is stopped, so that the state file matches the forwarded ports and the port can be forwarded again
+		stop()
+		<-done
+		delete(o.dynamicPorts, port.LocalPort)
+		return api.ForwardedPort{}, fmt.Errorf("unable to save forwarded ports to state file: %v", err)
+	}
+	return port, nil
+}
+
+func (o *PFClient) RemovePortForward(ctx context.Context, localPort int) error {
+	o.dynamicMu.Lock()
+	defer o.dynamicMu.Unlock()
+
+	p, found := o.dynamicPorts[localPort]
+	if !found {
+		return portForward.ErrPortNotForwarded
+	}
+	p.stop()
+	<-p.done
+	delete(o.dynamicPorts, localPort)
+
+	err := o.stateClient.RemoveForwardedPort(ctx, localPort)
+	if err != nil {
+		return fmt.Errorf("unable to save forwarded ports to state file: %v", err)
+	}
+	return nil
+}
+
+// restartDynamicPortForwarding makes the ports forwarded with AddPortForward connect again to the current pod of the component
+func (o *PFClient) restartDynamicPortForwarding() {
+	o.dynamicMu.Lock()
+	defer o.dynamicMu.Unlock()
+	for _, p := range o.dynamicPorts {
+		p.forwarder.restart()
+	}
+}
+
 func (o *PFClient) StopPortForwarding(ctx context.Context, componentName string) {
+	o.stopReversePortForwarding()
+	o.stopUDPPortForwarding()
+	o.restartDynamicPortForwarding()
+
 	if o.stopChan == nil {
 		return
 	}
@@ -163,12 +443,100 @@ func (o *PFClient) StopPortForwarding(ctx context.Context, componentName string)
 	<-o.finishedChan
 	o.finishedChan = nil
 	runtime.ErrorHandlers = o.originalErrorHandlers
+
+	o.mu.Lock()
+	o.forwardedPorts = nil
+	o.mu.Unlock()
 }
 
 func (o *PFClient) GetForwardedPorts() map[string][]v1alpha2.Endpoint {
 	return o.appliedEndpoints
 }
 
+func (o *PFClient) GetReverseForwardedPorts() []api.ForwardedPort {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return append([]api.ForwardedPort(nil), o.reversePorts...)
+}
+
+// getPortPairs assigns a local port to each port in the provided containerEndpoints map,
+// using the custom ports of definedPorts if any, or random ports if randomPorts is true
+func getPortPairs(ceMapping map[string][]v1alpha2.Endpoint, definedPorts []api.ForwardedPort, randomPorts bool, address string) map[string][]string {
+	if len(definedPorts) != 0 {
+		return getCustomPortPairs(definedPorts, ceMapping, address)
+	}
+	if randomPorts {
+		return randomPortPairsFromContainerEndpoints(ceMapping)
+	}
+	return portPairsFromContainerEndpoints(ceMapping, address)
+}
+
+// splitEndpointsByProtocol splits the endpoints of the containerEndpoints map into the endpoints transported over TCP
+// (http, https, ws, wss and tcp), and the UDP endpoints
+func splitEndpointsByProtocol(ceMapping map[string][]v1alpha2.Endpoint) (tcp, udp map[string][]v1alpha2.Endpoint) {
+	tcp = make(map[string][]v1alpha2.Endpoint)
+	udp = make(map[string][]v1alpha2.Endpoint)
+	for container, endpoints := range ceMapping {
+		for _, ep := range endpoints {
+			if ep.Protocol == v1alpha2.UDPEndpointProtocol {
+				udp[container] = append(udp[container], ep)
+				continue
+			}
+			tcp[container] = append(tcp[container], ep)
+		}
+	}
+	return tcp, udp
+}
+
+// getUDPForwardedPort returns the port forwarded for the "<local-port>:<remote-port>" pair of the container,
+// the local port being empty for a random port
+func getUDPForwardedPort(mapping map[string][]v1alpha2.Endpoint, container string, pair string, address string) (api.ForwardedPort, error) {
+	local, remote, found := strings.Cut(pair, ":")
+	if !found {
+		return api.ForwardedPort{}, fmt.Errorf("invalid port pair %q", pair)
+	}
+	var (
+		localPort int
+		err       error
+	)
+	if local != "" {
+		localPort, err = strconv.Atoi(local)
+		if err != nil {
+			return api.ForwardedPort{}, fmt.Errorf("invalid port pair %q: %w", pair, err)
+		}
+	}
+	remotePort, err := strconv.Atoi(remote)
+	if err != nil {
+		return api.ForwardedPort{}, fmt.Errorf("invalid port pair %q: %w", pair, err)
+	}
+	fp := api.ForwardedPort{
+		ContainerName: container,
+		LocalAddress:  address,
+		LocalPort:     localPort,
+		ContainerPort: remotePort,
+		Protocol:      string(v1alpha2.UDPEndpointProtocol),
+	}
+	for _, ep := range mapping[container] {
+		if ep.TargetPort == remotePort {
+			fp.PortName = ep.Name
+			fp.Exposure = string(ep.Exposure)
+			fp.IsDebug = libdevfile.IsDebugPort(ep.Name)
+			break
+		}
+	}
+	return fp, nil
+}
+
+// sortedContainers returns the names of the containers of the map, sorted
+func sortedContainers[T any](m map[string][]T) []string {
+	containers := make([]string, 0, len(m))
+	for container := range m {
+		containers = append(containers, container)
+	}
+	sort.Strings(containers)
+	return containers
+}
+
 // getCustomPortPairs assigns custom port on localhost to a container port if provided by the definedPorts config,
 // if not, it assigns a port starting from 20001 as done in portPairsFromContainerEndpoints
 func getCustomPortPairs(definedPorts []api.ForwardedPort, ceMapping map[string][]v1alpha2.Endpoint, address string) map[string][]string {
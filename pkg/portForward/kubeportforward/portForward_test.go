@@ -79,3 +79,97 @@ func Test_getCompleteCustomPortPairs(t *testing.T) {
 		})
 	}
 }
+
+func Test_splitEndpointsByProtocol(t *testing.T) {
+	ceMapping := map[string][]v1alpha2.Endpoint{
+		"runtime": {
+			{Name: "http", TargetPort: 8080, Protocol: v1alpha2.HTTPEndpointProtocol},
+			{Name: "dns", TargetPort: 53, Protocol: v1alpha2.UDPEndpointProtocol},
+			{Name: "dns-tcp", TargetPort: 53, Protocol: v1alpha2.TCPEndpointProtocol},
+		},
+		"tools": {
+			{Name: "debug", TargetPort: 5858},
+		},
+	}
+	wantTCP := map[string][]v1alpha2.Endpoint{
+		"runtime": {
+			{Name: "http", TargetPort: 8080, Protocol: v1alpha2.HTTPEndpointProtocol},
+			{Name: "dns-tcp", TargetPort: 53, Protocol: v1alpha2.TCPEndpointProtocol},
+		},
+		"tools": {
+			{Name: "debug", TargetPort: 5858},
+		},
+	}
+	wantUDP := map[string][]v1alpha2.Endpoint{
+		"runtime": {
+			{Name: "dns", TargetPort: 53, Protocol: v1alpha2.UDPEndpointProtocol},
+		},
+	}
+	gotTCP, gotUDP := splitEndpointsByProtocol(ceMapping)
+	if diff := cmp.Diff(wantTCP, gotTCP); diff != "" {
+		t.Errorf("splitEndpointsByProtocol() tcp mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(wantUDP, gotUDP); diff != "" {
+		t.Errorf("splitEndpointsByProtocol() udp mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_getUDPForwardedPort(t *testing.T) {
+	mapping := map[string][]v1alpha2.Endpoint{
+		"runtime": {{Name: "dns", TargetPort: 53, Protocol: v1alpha2.UDPEndpointProtocol, Exposure: v1alpha2.PublicEndpointExposure}},
+	}
+	tests := []struct {
+		name    string
+		pair    string
+		want    api.ForwardedPort
+		wantErr bool
+	}{
+		{
+			name: "local port",
+			pair: "20001:53",
+			want: api.ForwardedPort{
+				ContainerName: "runtime",
+				PortName:      "dns",
+				LocalAddress:  "127.0.0.1",
+				LocalPort:     20001,
+				ContainerPort: 53,
+				Exposure:      "public",
+				Protocol:      "udp",
+			},
+		},
+		{
+			name: "random local port",
+			pair: ":53",
+			want: api.ForwardedPort{
+				ContainerName: "runtime",
+				PortName:      "dns",
+				LocalAddress:  "127.0.0.1",
+				ContainerPort: 53,
+				Exposure:      "public",
+				Protocol:      "udp",
+			},
+		},
+		{
+			name:    "invalid pair",
+			pair:    "20001",
+			wantErr: true,
+		},
+		{
+			name:    "invalid local port",
+			pair:    "abc:53",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := getUDPForwardedPort(mapping, "runtime", tt.pair, "127.0.0.1")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("getUDPForwardedPort() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("getUDPForwardedPort() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
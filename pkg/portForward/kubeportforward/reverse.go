@@ -0,0 +1,147 @@
+package kubeportforward
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/util"
+	"github.com/redhat-developer/odo/pkg/watch"
+)
+
+// connectionMessage is written to stderr by the listener in the container for each connection it accepts,
+// followed by the path of the Unix socket on which the connection can be reached
+const connectionMessage = "odo-reverse-connection"
+
+// connectionScript is run by the listener in the container for each connection it accepts, with the connection as standard input and output.
+// It exposes the connection on a new Unix socket, and reports the path of the socket once it is listening.
+// The script is passed to socat through an environment variable, as the addresses of socat cannot contain colons or commas,
+// and is evaluated after being split into words: it must be written on a single line.
+const connectionScript = `d=$(mktemp -d) || exit 1; ` +
+	`socat UNIX-LISTEN:$d/sock STDIO <&0 & ` +
+	`while [ ! -S $d/sock ] && kill -0 $! 2>/dev/null; do sleep 0.1; done; ` +
+	`echo ` + connectionMessage + ` $d/sock >&2; ` +
+	`wait; rm -rf $d`
+
+// reverseForwarder tunnels the connections accepted on a port inside a container back to a local address.
+// A listener runs socat in the container over an exec session, and forks a process for each connection it accepts,
+// which exposes the connection on a Unix socket. Each connection is then tunneled over its own exec session,
+// connecting to this socket, so several connections can be tunneled at the same time.
+type reverseForwarder struct {
+	kubernetesClient kclient.ClientInterface
+	podName          string
+	port             api.ForwardedPort
+	errOut           io.Writer
+}
+
+// run tunnels the connections until ctx is cancelled
+func (o *reverseForwarder) run(ctx context.Context) {
+	backo := watch.NewExpBackoff()
+	for ctx.Err() == nil {
+		started := time.Now()
+		err := o.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if err == nil {
+			err = errors.New("socat exited")
+		}
+		fmt.Fprintf(o.errOut, "Failed to setup reverse port-forwarding for container port %d: %v\n", o.port.ContainerPort, err)
+		if time.Since(started) > time.Minute {
+			// the listener has been running for a while, this is a new failure
+			backo.Reset()
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backo.Delay()):
+		}
+	}
+}
+
+// listen runs the listener in the container until it exits or ctx is cancelled,
+// and tunnels each connection it accepts to the local address
+func (o *reverseForwarder) listen(ctx context.Context) error {
+	var (
+		wg  sync.WaitGroup
+		cmd = []string{
+			"sh", "-c",
+			fmt.Sprintf("export ODO_REVERSE_CONNECTION=%s; exec socat TCP-LISTEN:%d,reuseaddr,fork 'SYSTEM:eval $ODO_REVERSE_CONNECTION'",
+				util.ShellQuote(connectionScript), o.port.ContainerPort),
+		}
+	)
+	stderr := &connectionWriter{
+		onConnection: func(socketPath string) {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				o.forward(ctx, socketPath)
+			}()
+		},
+	}
+	err := o.kubernetesClient.ExecCMDInContainer(ctx, o.port.ContainerName, o.podName, cmd, io.Discard, stderr, nil, false)
+	wg.Wait()
+	return err
+}
+
+// forward tunnels the connection exposed on the Unix socket in the container to the local address
+func (o *reverseForwarder) forward(ctx context.Context, socketPath string) {
+	cmd := []string{"socat", "UNIX-CONNECT:" + socketPath, "STDIO"}
+	address := net.JoinHostPort(o.port.LocalAddress, strconv.Itoa(o.port.LocalPort))
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", address)
+	if err != nil {
+		fmt.Fprintf(o.errOut, "Failed to reverse forward connection from container port %d: %v\n", o.port.ContainerPort, err)
+		// Connecting with an empty input makes the connection close in the container
+		err = o.kubernetesClient.ExecCMDInContainer(ctx, o.port.ContainerName, o.podName, cmd, io.Discard, io.Discard, strings.NewReader(""), false)
+		if err != nil {
+			klog.V(4).Infof("unable to close connection from container port %d: %v", o.port.ContainerPort, err)
+		}
+		return
+	}
+	defer conn.Close()
+	// Closing the local connection closes stdin, which makes socat close the connection in the container
+	err = o.kubernetesClient.ExecCMDInContainer(ctx, o.port.ContainerName, o.podName, cmd, conn, io.Discard, conn, false)
+	if err != nil {
+		klog.V(4).Infof("error while reverse forwarding connection from container port %d: %v", o.port.ContainerPort, err)
+	}
+}
+
+// connectionWriter receives the stderr of the listener, and calls onConnection with the path of the socket of each connection accepted
+type connectionWriter struct {
+	mu           sync.Mutex
+	buffer       bytes.Buffer
+	onConnection func(socketPath string)
+}
+
+var _ io.Writer = (*connectionWriter)(nil)
+
+func (o *connectionWriter) Write(p []byte) (int, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.buffer.Write(p)
+	for {
+		line, err := o.buffer.ReadString('\n')
+		if err != nil {
+			// incomplete line, wait for the next writes
+			o.buffer.Reset()
+			o.buffer.WriteString(line)
+			return len(p), nil
+		}
+		line = strings.TrimSpace(line)
+		klog.V(4).Infof("reverse port forwarding: %s", line)
+		if strings.HasPrefix(line, connectionMessage+" ") {
+			o.onConnection(strings.TrimPrefix(line, connectionMessage+" "))
+		}
+	}
+}
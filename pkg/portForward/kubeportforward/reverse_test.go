@@ -0,0 +1,179 @@
+package kubeportforward
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/kclient"
+)
+
+// chanWriter sends to a channel each write
+type chanWriter chan string
+
+func (o chanWriter) Write(p []byte) (int, error) {
+	o <- string(p)
+	return len(p), nil
+}
+
+func Test_reverseForwarder_run(t *testing.T) {
+	// the local service answers "pong" to "ping"
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				buf := make([]byte, 4)
+				if _, err = io.ReadFull(conn, buf); err == nil && string(buf) == "ping" {
+					_, _ = conn.Write([]byte("pong"))
+				}
+			}()
+		}
+	}()
+
+	const connections = 2
+	received := make(chan string, connections)
+	// connected is closed when all the connections are tunneled at the same time
+	connected := make(chan struct{})
+	var listeners, tunnels int32
+	ctrl := gomock.NewController(t)
+	kubeClient := kclient.NewMockClientInterface(ctrl)
+	kubeClient.EXPECT().ExecCMDInContainer(gomock.Any(), "runtime", "mypod", gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), false).
+		DoAndReturn(func(ctx context.Context, containerName, podName string, cmd []string, stdout, stderr io.Writer, stdin io.Reader, tty bool) error {
+			if cmd[0] == "sh" {
+				if !strings.Contains(cmd[2], "exec socat TCP-LISTEN:5432,reuseaddr,fork 'SYSTEM:eval $ODO_REVERSE_CONNECTION'") {
+					t.Errorf("unexpected listener command %q", cmd[2])
+				}
+				atomic.AddInt32(&listeners, 1)
+				// simulate clients connecting to the port in the container, the listener reports the socket of each connection
+				for i := 0; i < connections; i++ {
+					_, _ = stderr.Write([]byte(fmt.Sprintf("odo-reverse-connection /tmp/tmp.%d/sock\n", i)))
+				}
+				<-ctx.Done()
+				return ctx.Err()
+			}
+			if got := strings.Join(cmd, " "); !strings.HasPrefix(got, "socat UNIX-CONNECT:/tmp/tmp.") || !strings.HasSuffix(got, "/sock STDIO") {
+				t.Errorf("unexpected command %q", got)
+			}
+			if atomic.AddInt32(&tunnels, 1) == connections {
+				close(connected)
+			}
+			select {
+			case <-connected:
+			case <-time.After(5 * time.Second):
+				t.Error("the connections should be tunneled at the same time")
+			}
+			// the client in the container sends "ping"
+			_, _ = stdout.Write([]byte("ping"))
+			buf := make([]byte, 4)
+			_, _ = io.ReadFull(stdin, buf)
+			received <- string(buf)
+			return nil
+		}).AnyTimes()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	fwd := &reverseForwarder{
+		kubernetesClient: kubeClient,
+		podName:          "mypod",
+		port: api.ForwardedPort{
+			IsReverse:     true,
+			ContainerName: "runtime",
+			ContainerPort: 5432,
+			LocalAddress:  "127.0.0.1",
+			LocalPort:     listener.Addr().(*net.TCPAddr).Port,
+		},
+		errOut: io.Discard,
+	}
+	done := make(chan struct{})
+	go func() {
+		fwd.run(ctx)
+		close(done)
+	}()
+
+	for i := 0; i < connections; i++ {
+		select {
+		case got := <-received:
+			if got != "pong" {
+				t.Errorf("container client received %q, expected %q", got, "pong")
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatal("timeout waiting for the response of the local service")
+		}
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timeout waiting for the forwarder to stop")
+	}
+	if got := atomic.LoadInt32(&listeners); got != 1 {
+		t.Errorf("a single listener should be started, got %d", got)
+	}
+}
+
+func Test_connectionWriter(t *testing.T) {
+	var got []string
+	w := &connectionWriter{
+		onConnection: func(socketPath string) {
+			got = append(got, socketPath)
+		},
+	}
+	for _, p := range []string{
+		"2023/04/01 10:00:00 socat[42] E some error\nodo-reverse-",
+		"connection /tmp/tmp.1/sock\n",
+		"odo-reverse-connection /tmp/tmp.2/sock\nodo-reverse-connection /tmp/tmp.3/sock",
+	} {
+		_, _ = w.Write([]byte(p))
+	}
+	want := []string{"/tmp/tmp.1/sock", "/tmp/tmp.2/sock"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("connectionWriter mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_reverseForwarder_run_error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kubeClient := kclient.NewMockClientInterface(ctrl)
+	kubeClient.EXPECT().ExecCMDInContainer(gomock.Any(), "runtime", "mypod", gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), false).
+		Return(errors.New("socat: command not found")).AnyTimes()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errOut := make(chanWriter, 10)
+	fwd := &reverseForwarder{
+		kubernetesClient: kubeClient,
+		podName:          "mypod",
+		port:             api.ForwardedPort{IsReverse: true, ContainerName: "runtime", ContainerPort: 5432, LocalAddress: "127.0.0.1", LocalPort: 5432},
+		errOut:           errOut,
+	}
+	go fwd.run(ctx)
+
+	select {
+	case got := <-errOut:
+		want := "Failed to setup reverse port-forwarding for container port 5432: socat: command not found\n"
+		if got != want {
+			t.Errorf("got error output %q, expected %q", got, want)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("timeout waiting for the error")
+	}
+}
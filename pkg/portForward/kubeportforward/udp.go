@@ -0,0 +1,290 @@
+package kubeportforward
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/kclient"
+)
+
+const (
+	// udpIdleTimeout is the duration after which the relay of a local peer which has not sent any datagram is stopped
+	udpIdleTimeout = 2 * time.Minute
+	// udpPeerQueueSize is the number of datagrams from a local peer waiting to be relayed, before the next ones are dropped
+	udpPeerQueueSize = 64
+	// maxDatagramSize is the maximum size of a UDP datagram
+	maxDatagramSize = 65535
+)
+
+// udpForwarder forwards the datagrams received on a local UDP port to a UDP port in a container.
+// As the port forwarding of Kubernetes only transports TCP streams, a relay is executed in the container
+// for each local peer (see udpRelayScript), exchanging the datagrams with odo over the streams of the exec session.
+// Each datagram is framed on the streams, preceded by its length on its own line, to preserve the datagram boundaries.
+type udpForwarder struct {
+	kubernetesClient kclient.ClientInterface
+	podName          string
+	port             api.ForwardedPort
+	errOut           io.Writer
+	conn             net.PacketConn
+
+	// mu protects peers
+	mu sync.Mutex
+	// peers are the relays of the local peers, by address of the peer
+	peers map[string]*udpPeer
+}
+
+// udpPeer is the relay of a local peer
+type udpPeer struct {
+	datagrams chan []byte
+	stop      context.CancelFunc
+	// lastSeen is the last time a datagram has been received from the peer, protected by the mutex of the forwarder
+	lastSeen time.Time
+}
+
+// newUDPForwarder listens on the local address and port of port.
+// If the local port is 0, a random port is chosen, and the local port of the forwarder is updated.
+func newUDPForwarder(kubernetesClient kclient.ClientInterface, podName string, port api.ForwardedPort, errOut io.Writer) (*udpForwarder, error) {
+	conn, err := net.ListenPacket("udp", net.JoinHostPort(port.LocalAddress, strconv.Itoa(port.LocalPort)))
+	if err != nil {
+		return nil, err
+	}
+	port.LocalPort = conn.LocalAddr().(*net.UDPAddr).Port
+	return &udpForwarder{
+		kubernetesClient: kubernetesClient,
+		podName:          podName,
+		port:             port,
+		errOut:           errOut,
+		conn:             conn,
+		peers:            make(map[string]*udpPeer),
+	}, nil
+}
+
+// run forwards the datagrams until ctx is cancelled, and closes the local port
+func (o *udpForwarder) run(ctx context.Context) {
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	go func() {
+		<-ctx.Done()
+		_ = o.conn.Close()
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		o.expirePeers(ctx)
+	}()
+
+	buf := make([]byte, maxDatagramSize)
+	for {
+		n, addr, err := o.conn.ReadFrom(buf)
+		if err != nil {
+			if ctx.Err() == nil {
+				fmt.Fprintf(o.errOut, "Failed to forward UDP port %d: %v\n", o.port.LocalPort, err)
+			}
+			break
+		}
+		datagram := append([]byte(nil), buf[:n]...)
+		peer := o.getPeer(ctx, addr, &wg)
+		select {
+		case peer.datagrams <- datagram:
+		default:
+			klog.V(4).Infof("dropping datagram from %s to container port %d", addr, o.port.ContainerPort)
+		}
+	}
+
+	o.mu.Lock()
+	for _, peer := range o.peers {
+		peer.stop()
+	}
+	o.mu.Unlock()
+}
+
+// getPeer returns the relay of the local peer, starting it if necessary
+func (o *udpForwarder) getPeer(ctx context.Context, addr net.Addr, wg *sync.WaitGroup) *udpPeer {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	key := addr.String()
+	if peer, found := o.peers[key]; found {
+		peer.lastSeen = time.Now()
+		return peer
+	}
+
+	peerCtx, stop := context.WithCancel(ctx)
+	peer := &udpPeer{
+		datagrams: make(chan []byte, udpPeerQueueSize),
+		stop:      stop,
+		lastSeen:  time.Now(),
+	}
+	o.peers[key] = peer
+
+	stdinR, stdinW := io.Pipe()
+	stdoutR, stdoutW := io.Pipe()
+	cmd := []string{"sh", "-c", udpRelayScript(o.port.ContainerPort)}
+
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		err := o.kubernetesClient.ExecCMDInContainer(peerCtx, o.port.ContainerName, o.podName, cmd, stdoutW, io.Discard, stdinR, false)
+		if err != nil && peerCtx.Err() == nil {
+			fmt.Fprintf(o.errOut, "Failed to forward UDP datagrams to container port %d: %v (socat must be installed in container %q to forward UDP ports on the cluster)\n",
+				o.port.ContainerPort, err, o.port.ContainerName)
+		}
+		stop()
+		_ = stdinR.Close()
+		_ = stdoutW.Close()
+		o.mu.Lock()
+		if o.peers[key] == peer {
+			delete(o.peers, key)
+		}
+		o.mu.Unlock()
+	}()
+
+	go func() {
+		defer wg.Done()
+		// Closing stdin makes the relay exit
+		defer stdinW.Close()
+		for {
+			select {
+			case datagram := <-peer.datagrams:
+				if err := writeFrame(stdinW, datagram); err != nil {
+					return
+				}
+			case <-peerCtx.Done():
+				return
+			}
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		err := readFrames(stdoutR, func(datagram []byte) {
+			if _, err := o.conn.WriteTo(datagram, addr); err != nil {
+				klog.V(4).Infof("unable to send datagram from container port %d to %s: %v", o.port.ContainerPort, addr, err)
+			}
+		})
+		if err != nil && peerCtx.Err() == nil {
+			klog.V(4).Infof("invalid datagram from container port %d: %v", o.port.ContainerPort, err)
+			stop()
+		}
+		_ = stdoutR.Close()
+	}()
+
+	return peer
+}
+
+// udpRelayRequirementsScript writes the requirements of the relay (see udpRelayScript) which are not met in the container, one per line.
+// The head command of GNU coreutils is required, as other implementations (e.g. BusyBox) may read beyond the datagram
+// they extract from the standard input, corrupting the next frame.
+const udpRelayRequirementsScript = `for c in mktemp mkfifo; do command -v $c >/dev/null 2>&1 || echo $c; done
+v=$(socat -V 2>/dev/null)
+echo "$v" | grep -q 'WITH_UDP 1' && echo "$v" | grep -q 'WITH_UNIX 1' || echo 'socat with UDP and Unix sockets support'
+head --version 2>/dev/null | grep -q coreutils || echo 'GNU coreutils'
+true`
+
+// checkUDPRelayRequirements returns an error listing the requirements of the relay which are not met in the container
+func checkUDPRelayRequirements(ctx context.Context, kubernetesClient kclient.ClientInterface, podName, containerName string) error {
+	var stdout, stderr bytes.Buffer
+	cmd := []string{"sh", "-c", udpRelayRequirementsScript}
+	err := kubernetesClient.ExecCMDInContainer(ctx, containerName, podName, cmd, &stdout, &stderr, nil, false)
+	if err != nil {
+		return fmt.Errorf("unable to check the requirements to forward UDP ports in container %q: %w (stderr: %s)", containerName, err, stderr.String())
+	}
+	missing := strings.TrimSpace(stdout.String())
+	if missing == "" {
+		return nil
+	}
+	return fmt.Errorf("forwarding UDP ports on the cluster requires the following commands in container %q: %s",
+		containerName, strings.Join(strings.Split(missing, "\n"), ", "))
+}
+
+// udpRelayScript returns the script of the relay executed in the container, exchanging framed datagrams
+// on its standard input and output with the UDP port of the application.
+// A socat process bridges a UDP socket connected to the port and a Unix datagram socket, preserving the datagram boundaries:
+// each frame read from stdin is sent by a short-lived socat to the bridge, and each datagram coming from the bridge
+// is received by a child of a socat process, which writes it as a frame to a FIFO copied to stdout,
+// holding a lock so the frames are not interleaved. The requirements of the script are checked by checkUDPRelayRequirements.
+func udpRelayScript(containerPort int) string {
+	return `d=$(mktemp -d) && mkfifo $d/frames || exit 1
+trap 'kill $writer $receiver $bridge 2>/dev/null; rm -rf $d' EXIT
+cat $d/frames &
+writer=$!
+exec 3>$d/frames
+export ODO_UDP_DIR=$d
+export ODO_UDP_FRAME='f=$(mktemp -p $ODO_UDP_DIR); cat >$f; until mkdir $ODO_UDP_DIR/lock 2>/dev/null; do sleep 0.01; done; wc -c <$f >$ODO_UDP_DIR/frames; cat $f >$ODO_UDP_DIR/frames; rmdir $ODO_UDP_DIR/lock; rm -f $f'
+socat -u -b65535 UNIX-RECVFROM:$d/out,fork 'SYSTEM:eval $ODO_UDP_FRAME' &
+receiver=$!
+while [ ! -S $d/out ]; do kill -0 $receiver || exit 1; sleep 0.1; done
+socat -b65535 UDP:127.0.0.1:` + strconv.Itoa(containerPort) + ` UNIX-DATAGRAM:$d/out,bind=$d/in &
+bridge=$!
+while [ ! -S $d/in ]; do kill -0 $bridge || exit 1; sleep 0.1; done
+while read -r n; do head -c $n >$d/datagram && socat -u -b65535 OPEN:$d/datagram UNIX-SENDTO:$d/in; done`
+}
+
+// writeFrame writes the datagram to w, preceded by its length on its own line
+func writeFrame(w io.Writer, datagram []byte) error {
+	frame := make([]byte, 0, len(datagram)+8)
+	frame = append(frame, strconv.Itoa(len(datagram))...)
+	frame = append(frame, '\n')
+	frame = append(frame, datagram...)
+	_, err := w.Write(frame)
+	return err
+}
+
+// readFrames reads the datagrams framed by their length from r, and calls handle for each datagram, until r is closed
+func readFrames(r io.Reader, handle func(datagram []byte)) error {
+	reader := bufio.NewReader(r)
+	for {
+		header, err := reader.ReadString('\n')
+		if err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrClosedPipe) {
+				return nil
+			}
+			return err
+		}
+		length, err := strconv.Atoi(strings.TrimSpace(header))
+		if err != nil || length < 0 || length > maxDatagramSize {
+			return fmt.Errorf("invalid frame header %q", header)
+		}
+		datagram := make([]byte, length)
+		_, err = io.ReadFull(reader, datagram)
+		if err != nil {
+			return err
+		}
+		handle(datagram)
+	}
+}
+
+// expirePeers stops the relays of the peers which have not sent any datagram during udpIdleTimeout, until ctx is cancelled
+func (o *udpForwarder) expirePeers(ctx context.Context) {
+	ticker := time.NewTicker(udpIdleTimeout / 4)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			o.mu.Lock()
+			for key, peer := range o.peers {
+				if now.Sub(peer.lastSeen) > udpIdleTimeout {
+					peer.stop()
+					delete(o.peers, key)
+				}
+			}
+			o.mu.Unlock()
+		}
+	}
+}
@@ -0,0 +1,187 @@
+package kubeportforward
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/kclient"
+)
+
+func Test_udpForwarder_run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kubeClient := kclient.NewMockClientInterface(ctrl)
+	kubeClient.EXPECT().ExecCMDInContainer(gomock.Any(), "runtime", "mypod", gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), false).
+		DoAndReturn(func(ctx context.Context, containerName, podName string, cmd []string, stdout, stderr io.Writer, stdin io.Reader, tty bool) error {
+			if len(cmd) != 3 || cmd[0] != "sh" || !strings.Contains(cmd[2], "UDP:127.0.0.1:53 ") {
+				t.Errorf("unexpected command %q", strings.Join(cmd, " "))
+			}
+			// simulate the application answering two datagrams to "ping"
+			_ = readFrames(stdin, func(datagram []byte) {
+				if string(datagram) == "ping" {
+					_ = writeFrame(stdout, []byte("pong"))
+					_ = writeFrame(stdout, []byte("\npong\n"))
+				}
+			})
+			<-ctx.Done()
+			return ctx.Err()
+		})
+
+	fwd, err := newUDPForwarder(kubeClient, "mypod", api.ForwardedPort{
+		ContainerName: "runtime",
+		ContainerPort: 53,
+		LocalAddress:  "127.0.0.1",
+		Protocol:      "udp",
+	}, io.Discard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fwd.port.LocalPort == 0 {
+		t.Fatal("a random local port should have been chosen")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		fwd.run(ctx)
+		close(done)
+	}()
+
+	conn, err := net.Dial("udp", fwd.conn.LocalAddr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	if _, err = conn.Write([]byte("ping")); err != nil {
+		t.Fatal(err)
+	}
+	_ = conn.SetReadDeadline(time.Now().Add(10 * time.Second))
+	for _, want := range []string{"pong", "\npong\n"} {
+		buf := make([]byte, 16)
+		n, err := conn.Read(buf)
+		if err != nil {
+			t.Fatalf("error waiting for the response of the application: %v", err)
+		}
+		if got := string(buf[:n]); got != want {
+			t.Errorf("local client received %q, expected %q", got, want)
+		}
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timeout waiting for the forwarder to stop")
+	}
+}
+
+func Test_udpForwarder_run_error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kubeClient := kclient.NewMockClientInterface(ctrl)
+	kubeClient.EXPECT().ExecCMDInContainer(gomock.Any(), "runtime", "mypod", gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), false).
+		Return(errors.New("command terminated with exit code 127")).AnyTimes()
+
+	errOut := make(chanWriter, 10)
+	fwd, err := newUDPForwarder(kubeClient, "mypod", api.ForwardedPort{ContainerName: "runtime", ContainerPort: 53, LocalAddress: "127.0.0.1"}, errOut)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go fwd.run(ctx)
+
+	conn, err := net.Dial("udp", fwd.conn.LocalAddr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	if _, err = conn.Write([]byte("ping")); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case got := <-errOut:
+		want := "Failed to forward UDP datagrams to container port 53: command terminated with exit code 127 (socat must be installed in container \"runtime\" to forward UDP ports on the cluster)\n"
+		if got != want {
+			t.Errorf("got error output %q, expected %q", got, want)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("timeout waiting for the error")
+	}
+}
+
+func Test_readFrames(t *testing.T) {
+	var input bytes.Buffer
+	for _, datagram := range []string{"ping", "", "two\nlines"} {
+		if err := writeFrame(&input, []byte(datagram)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the relay in the container may write the length with leading spaces
+	input.WriteString("  3\n\x00\x01\x02")
+
+	var got []string
+	err := readFrames(&input, func(datagram []byte) {
+		got = append(got, string(datagram))
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"ping", "", "two\nlines", "\x00\x01\x02"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("readFrames() mismatch (-want +got):\n%s", diff)
+	}
+
+	err = readFrames(strings.NewReader("ping\n"), func([]byte) {})
+	if err == nil {
+		t.Error("an invalid header should return an error")
+	}
+}
+
+func Test_checkUDPRelayRequirements(t *testing.T) {
+	tests := []struct {
+		name    string
+		stdout  string
+		execErr error
+		wantErr string
+	}{
+		{
+			name: "all requirements met",
+		},
+		{
+			name:    "missing requirements",
+			stdout:  "socat with UDP and Unix sockets support\nGNU coreutils\n",
+			wantErr: `forwarding UDP ports on the cluster requires the following commands in container "runtime": socat with UDP and Unix sockets support, GNU coreutils`,
+		},
+		{
+			name:    "check failing",
+			execErr: errors.New("command terminated with exit code 127"),
+			wantErr: `unable to check the requirements to forward UDP ports in container "runtime": command terminated with exit code 127 (stderr: )`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			kubeClient := kclient.NewMockClientInterface(ctrl)
+			kubeClient.EXPECT().ExecCMDInContainer(gomock.Any(), "runtime", "mypod", []string{"sh", "-c", udpRelayRequirementsScript}, gomock.Any(), gomock.Any(), nil, false).
+				DoAndReturn(func(_ context.Context, _, _ string, _ []string, stdout, _ io.Writer, _ io.Reader, _ bool) error {
+					_, _ = stdout.Write([]byte(tt.stdout))
+					return tt.execErr
+				})
+			err := checkUDPRelayRequirements(context.Background(), kubeClient, "mypod", "runtime")
+			if gotErr := fmt.Sprint(err); (err != nil || tt.wantErr != "") && gotErr != tt.wantErr {
+				t.Errorf("checkUDPRelayRequirements() error = %q, want %q", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package podmanportforward
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/podman"
+)
+
+// dynamicForwarder forwards a local port to a port of a container of the component.
+// Ports cannot be published once the pod is running, so the forwarder listens on the local port,
+// and tunnels each accepted connection over the streams of an exec session running socat in the container.
+type dynamicForwarder struct {
+	podmanClient podman.Client
+	podName      string
+	port         api.ForwardedPort
+	errOut       io.Writer
+
+	listener net.Listener
+	// wg is done when all the connections have been closed
+	wg sync.WaitGroup
+}
+
+// listen starts listening on the local port
+func (o *dynamicForwarder) listen() error {
+	address := net.JoinHostPort(o.port.LocalAddress, strconv.Itoa(o.port.LocalPort))
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+	o.listener = listener
+	return nil
+}
+
+// run accepts the connections on the local port until ctx is cancelled
+func (o *dynamicForwarder) run(ctx context.Context) {
+	go func() {
+		<-ctx.Done()
+		_ = o.listener.Close()
+	}()
+	for {
+		conn, err := o.listener.Accept()
+		if err != nil {
+			if ctx.Err() == nil {
+				fmt.Fprintf(o.errOut, "Failed to accept connection on local port %d: %v\n", o.port.LocalPort, err)
+			}
+			break
+		}
+		o.wg.Add(1)
+		go func() {
+			defer o.wg.Done()
+			o.tunnel(ctx, conn)
+		}()
+	}
+	o.wg.Wait()
+}
+
+// tunnel transports the connection to the container port, until one of the sides closes it
+func (o *dynamicForwarder) tunnel(ctx context.Context, conn net.Conn) {
+	defer conn.Close()
+
+	// A pipe is used for stdin, instead of the connection, so the exec session ends as soon as socat exits,
+	// without waiting for the local side to write again to the connection
+	stdinR, stdinW, err := os.Pipe()
+	if err != nil {
+		fmt.Fprintf(o.errOut, "Failed to forward connection to container port %d: %v\n", o.port.ContainerPort, err)
+		return
+	}
+	defer stdinR.Close()
+	go func() {
+		_, _ = io.Copy(stdinW, conn)
+		_ = stdinW.Close()
+	}()
+
+	var stderr bytes.Buffer
+	cmd := []string{"socat", "-", fmt.Sprintf("TCP:127.0.0.1:%d", o.port.ContainerPort)}
+	err = o.podmanClient.ExecCMDInContainer(ctx, o.port.ContainerName, o.podName, cmd, conn, &stderr, stdinR, false)
+	if err != nil && ctx.Err() == nil {
+		fmt.Fprintf(o.errOut, "Failed to forward connection to container port %d: %v: %s\n", o.port.ContainerPort, err, strings.TrimSpace(stderr.String()))
+		return
+	}
+	klog.V(4).Infof("connection forwarded to container port %d closed", o.port.ContainerPort)
+}
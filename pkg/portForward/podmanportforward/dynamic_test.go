@@ -0,0 +1,121 @@
+package podmanportforward
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/podman"
+	"github.com/redhat-developer/odo/pkg/state"
+)
+
+func Test_dynamicForwarder_run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	podmanClient := podman.NewMockClient(ctrl)
+	podmanClient.EXPECT().ExecCMDInContainer(gomock.Any(), "runtime", "mycomponent-app", gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), false).
+		DoAndReturn(func(ctx context.Context, containerName, podName string, cmd []string, stdout, stderr io.Writer, stdin io.Reader, tty bool) error {
+			if got := strings.Join(cmd, " "); got != "socat - TCP:127.0.0.1:9229" {
+				t.Errorf("unexpected command %q", got)
+			}
+			// simulate an application in the container answering "pong" to "ping", then closing the connection
+			buf := make([]byte, 4)
+			if _, err := io.ReadFull(stdin, buf); err != nil || string(buf) != "ping" {
+				t.Errorf("application received %q, expected %q", string(buf), "ping")
+			}
+			_, _ = stdout.Write([]byte("pong"))
+			return nil
+		})
+
+	fwd := &dynamicForwarder{
+		podmanClient: podmanClient,
+		podName:      "mycomponent-app",
+		port:         api.ForwardedPort{IsDynamic: true, ContainerName: "runtime", ContainerPort: 9229, LocalAddress: "127.0.0.1"},
+		errOut:       io.Discard,
+	}
+	// listen on a free port
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fwd.port.LocalPort = listener.Addr().(*net.TCPAddr).Port
+	listener.Close()
+	err = fwd.listen()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		fwd.run(ctx)
+		close(done)
+	}()
+
+	conn, err := net.Dial("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(fwd.port.LocalPort)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = conn.SetDeadline(time.Now().Add(10 * time.Second))
+	_, err = conn.Write([]byte("ping"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := io.ReadAll(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "pong" {
+		t.Errorf("local client received %q, expected %q", string(got), "pong")
+	}
+	conn.Close()
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timeout waiting for the forwarder to stop")
+	}
+}
+
+// failingStateClient fails to save the forwarded ports into the state file
+type failingStateClient struct {
+	state.Client
+}
+
+func (failingStateClient) AddForwardedPort(context.Context, api.ForwardedPort) error {
+	return errors.New("unable to write the state file")
+}
+
+func TestPFClient_AddPortForward_stateError(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	localPort := listener.Addr().(*net.TCPAddr).Port
+	_ = listener.Close()
+
+	o := NewPFClient(nil, nil, failingStateClient{})
+	port := api.ForwardedPort{ContainerName: "runtime", ContainerPort: 9229, LocalAddress: "127.0.0.1", LocalPort: localPort}
+	_, err = o.AddPortForward(context.Background(), "mycomponent", port, io.Discard)
+	if err == nil {
+		t.Fatal("AddPortForward() expected an error")
+	}
+
+	// The port forwarding must be stopped, and the port free to be forwarded again
+	if len(o.dynamicPorts) != 0 {
+		t.Errorf("the port should not be registered, got %v", o.dynamicPorts)
+	}
+	listener, err = net.Listen("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(localPort)))
+	if err != nil {
+		t.Fatalf("the local port should not be listened on anymore: %v", err)
+	}
+	_ = listener.Close()
+}
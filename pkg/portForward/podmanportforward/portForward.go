@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"reflect"
+	"sort"
 	"strings"
 	"sync"
 
@@ -15,24 +16,49 @@ import (
 
 	"github.com/redhat-developer/odo/pkg/api"
 	"github.com/redhat-developer/odo/pkg/exec"
+	"github.com/redhat-developer/odo/pkg/podman"
 	"github.com/redhat-developer/odo/pkg/portForward"
 	"github.com/redhat-developer/odo/pkg/remotecmd"
+	"github.com/redhat-developer/odo/pkg/state"
 )
 
 const pfHelperContainer = "odo-helper-port-forwarding"
 
 type PFClient struct {
 	remoteProcessHandler remotecmd.RemoteProcessHandler
+	podmanClient         podman.Client
+	stateClient          state.Client
 
-	appliedPorts map[api.ForwardedPort]struct{}
+	// mu protects appliedPorts and appliedReversePorts, updated by the handlers of the socat processes
+	mu                  sync.Mutex
+	appliedPorts        map[api.ForwardedPort]struct{}
+	appliedReversePorts map[api.ForwardedPort]struct{}
+
+	// dynamicMu protects dynamicPorts
+	dynamicMu sync.Mutex
+	// dynamicPorts are the ports forwarded with AddPortForward, by local port
+	dynamicPorts map[int]*dynamicPort
+}
+
+// dynamicPort is a port forwarded with AddPortForward
+type dynamicPort struct {
+	forwarder *dynamicForwarder
+	// stop stops the forwarder
+	stop context.CancelFunc
+	// done is closed when the forwarder has exited
+	done chan struct{}
 }
 
 var _ portForward.Client = (*PFClient)(nil)
 
-func NewPFClient(execClient exec.Client) *PFClient {
+func NewPFClient(execClient exec.Client, podmanClient podman.Client, stateClient state.Client) *PFClient {
 	return &PFClient{
 		remoteProcessHandler: remotecmd.NewKubeExecProcessHandler(execClient),
+		podmanClient:         podmanClient,
+		stateClient:          stateClient,
 		appliedPorts:         make(map[api.ForwardedPort]struct{}),
+		appliedReversePorts:  make(map[api.ForwardedPort]struct{}),
+		dynamicPorts:         make(map[int]*dynamicPort),
 	}
 }
 
@@ -47,52 +73,165 @@ func (o *PFClient) StartPortForwarding(
 	definedPorts []api.ForwardedPort,
 	customAddress string,
 ) error {
-	var appliedPorts []api.ForwardedPort
-	for port := range o.appliedPorts {
-		appliedPorts = append(appliedPorts, port)
-	}
+	appliedPorts := o.getPorts(o.appliedPorts)
 	if reflect.DeepEqual(appliedPorts, definedPorts) {
 		klog.V(3).Infof("Port forwarding should already be running for defined ports: %v", definedPorts)
 		return nil
 	}
 
-	o.StopPortForwarding(ctx, componentName)
+	o.stopPorts(ctx, componentName, o.appliedPorts)
+
+	return o.startPorts(ctx, componentName, definedPorts, o.appliedPorts, out)
+}
+
+func (o *PFClient) StartReversePortForwarding(
+	ctx context.Context,
+	componentName string,
+	reversePorts []api.ForwardedPort,
+	errOut io.Writer,
+) error {
+	appliedPorts := o.getPorts(o.appliedReversePorts)
+	if sameElements(appliedPorts, reversePorts) {
+		klog.V(3).Infof("Reverse port forwarding should already be running for ports: %v", reversePorts)
+		return nil
+	}
+
+	o.stopPorts(ctx, componentName, o.appliedReversePorts)
+
+	return o.startPorts(ctx, componentName, reversePorts, o.appliedReversePorts, errOut)
+}
 
+// startPorts starts a socat process in the helper container for each port, and records the running ports into applied
+func (o *PFClient) startPorts(ctx context.Context, componentName string, ports []api.ForwardedPort, applied map[api.ForwardedPort]struct{}, out io.Writer) error {
 	outputHandler := func(fwPort api.ForwardedPort) remotecmd.CommandOutputHandler {
 		return func(status remotecmd.RemoteProcessStatus, stdout []string, stderr []string, err error) {
-			klog.V(4).Infof("Status for port-forwarding (from %s:%d -> %d): %s", fwPort.LocalAddress, fwPort.LocalPort, fwPort.ContainerPort, status)
+			klog.V(4).Infof("Status for port-forwarding (%s): %s", describePort(fwPort), status)
 			klog.V(4).Info(strings.Join(stdout, "\n"))
 			klog.V(4).Info(strings.Join(stderr, "\n"))
 			switch status {
 			case remotecmd.Running:
-				o.appliedPorts[fwPort] = struct{}{}
+				o.mu.Lock()
+				applied[fwPort] = struct{}{}
+				o.mu.Unlock()
 			case remotecmd.Stopped, remotecmd.Errored:
-				delete(o.appliedPorts, fwPort)
+				o.mu.Lock()
+				delete(applied, fwPort)
+				o.mu.Unlock()
 				if status == remotecmd.Stopped {
-					fmt.Fprintf(out, "Stopped port-forwarding from %s:%d -> %d", fwPort.LocalAddress, fwPort.LocalPort, fwPort.ContainerPort)
+					fmt.Fprintf(out, "Stopped port-forwarding %s", describePort(fwPort))
 				}
 			}
 		}
 	}
 
-	for _, port := range definedPorts {
+	for _, port := range ports {
 		err := o.remoteProcessHandler.StartProcessForCommand(ctx, getCommandDefinition(port), getPodName(componentName), pfHelperContainer, outputHandler(port))
 		if err != nil {
+			if port.IsReverse {
+				return fmt.Errorf("error while creating reverse port-forwarding for container port %d: %w", port.ContainerPort, err)
+			}
 			return fmt.Errorf("error while creating port-forwarding for container port %d: %w", port.ContainerPort, err)
 		}
-		o.appliedPorts[port] = struct{}{}
+		o.mu.Lock()
+		applied[port] = struct{}{}
+		o.mu.Unlock()
+	}
+	return nil
+}
+
+// getPorts returns a snapshot of the applied ports
+func (o *PFClient) getPorts(applied map[api.ForwardedPort]struct{}) []api.ForwardedPort {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	var result []api.ForwardedPort
+	for port := range applied {
+		result = append(result, port)
+	}
+	return result
+}
+
+func (o *PFClient) AddPortForward(ctx context.Context, componentName string, port api.ForwardedPort, errOut io.Writer) (api.ForwardedPort, error) {
+	o.dynamicMu.Lock()
+	defer o.dynamicMu.Unlock()
+
+	usedPorts := o.getPorts(o.appliedPorts)
+	for _, p := range o.dynamicPorts {
+		usedPorts = append(usedPorts, p.forwarder.port)
+	}
+	port, err := portForward.ValidateDynamicPort(port, usedPorts)
+	if err != nil {
+		return api.ForwardedPort{}, err
+	}
+
+	fwd := &dynamicForwarder{
+		podmanClient: o.podmanClient,
+		podName:      getPodName(componentName),
+		port:         port,
+		errOut:       errOut,
+	}
+	err = fwd.listen()
+	if err != nil {
+		return api.ForwardedPort{}, fmt.Errorf("unable to forward local port %d to container port %d: %w", port.LocalPort, port.ContainerPort, err)
+	}
+
+	// The port forwarding must outlive the context of the caller, and is stopped by RemovePortForward
+	forwarderCtx, stop := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fwd.run(forwarderCtx)
+	}()
+	o.dynamicPorts[port.LocalPort] = &dynamicPort{
+		forwarder: fwd,
+		stop:      stop,
+		done:      done,
+	}
+
+	err = o.stateClient.AddForwardedPort(ctx, port)
+	if err != nil {
+		// The port forwarding is stopped, so that the state file matches the forwarded ports and the port can be forwarded again
+		stop()
+		<-done
+		delete(o.dynamicPorts, port.LocalPort)
+		return api.ForwardedPort{}, fmt.Errorf("unable to save forwarded ports to state file: %v", err)
+	}
+	return port, nil
+}
+
+func (o *PFClient) RemovePortForward(ctx context.Context, localPort int) error {
+	o.dynamicMu.Lock()
+	defer o.dynamicMu.Unlock()
+
+	p, found := o.dynamicPorts[localPort]
+	if !found {
+		return portForward.ErrPortNotForwarded
+	}
+	p.stop()
+	<-p.done
+	delete(o.dynamicPorts, localPort)
+
+	err := o.stateClient.RemoveForwardedPort(ctx, localPort)
+	if err != nil {
+		return fmt.Errorf("unable to save forwarded ports to state file: %v", err)
 	}
 	return nil
 }
 
 func (o *PFClient) StopPortForwarding(ctx context.Context, componentName string) {
-	if len(o.appliedPorts) == 0 {
+	o.stopPorts(ctx, componentName, o.appliedPorts)
+	o.stopPorts(ctx, componentName, o.appliedReversePorts)
+}
+
+// stopPorts stops the socat processes of the applied ports, and empties applied
+func (o *PFClient) stopPorts(ctx context.Context, componentName string, applied map[api.ForwardedPort]struct{}) {
+	ports := o.getPorts(applied)
+	if len(ports) == 0 {
 		return
 	}
 
 	var wg sync.WaitGroup
-	wg.Add(len(o.appliedPorts))
-	for port := range o.appliedPorts {
+	wg.Add(len(ports))
+	for _, port := range ports {
 		port := port
 		go func() {
 			defer wg.Done()
@@ -104,12 +243,16 @@ func (o *PFClient) StopPortForwarding(ctx context.Context, componentName string)
 	}
 	wg.Wait()
 
-	o.appliedPorts = make(map[api.ForwardedPort]struct{})
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for port := range applied {
+		delete(applied, port)
+	}
 }
 
 func (o *PFClient) GetForwardedPorts() map[string][]v1alpha2.Endpoint {
 	result := make(map[string][]v1alpha2.Endpoint)
-	for port := range o.appliedPorts {
+	for _, port := range o.getPorts(o.appliedPorts) {
 		result[port.ContainerName] = append(result[port.ContainerName], v1alpha2.Endpoint{
 			Name:       port.PortName,
 			TargetPort: port.ContainerPort,
@@ -119,6 +262,35 @@ func (o *PFClient) GetForwardedPorts() map[string][]v1alpha2.Endpoint {
 	return result
 }
 
+func (o *PFClient) GetReverseForwardedPorts() []api.ForwardedPort {
+	result := o.getPorts(o.appliedReversePorts)
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ContainerPort < result[j].ContainerPort
+	})
+	return result
+}
+
+// sameElements returns true if a and b contain the same ports, in any order
+func sameElements(a, b []api.ForwardedPort) bool {
+	elements := make(map[api.ForwardedPort]struct{}, len(a))
+	for _, port := range a {
+		elements[port] = struct{}{}
+	}
+	for _, port := range b {
+		if _, found := elements[port]; !found {
+			return false
+		}
+	}
+	return len(elements) == len(b)
+}
+
+func describePort(port api.ForwardedPort) string {
+	if port.IsReverse {
+		return fmt.Sprintf("from container port %d -> %s:%d", port.ContainerPort, port.LocalAddress, port.LocalPort)
+	}
+	return fmt.Sprintf("from %s:%d -> %d", port.LocalAddress, port.LocalPort, port.ContainerPort)
+}
+
 func getPodName(componentName string) string {
 	return fmt.Sprintf("%s-app", componentName)
 }
@@ -131,6 +303,15 @@ func getCommandDefinition(port api.ForwardedPort) remotecmd.CommandDefinition {
 	case strings.EqualFold(port.Protocol, string(corev1.ProtocolSCTP)):
 		proto = "sctp"
 	}
+	if port.IsReverse {
+		return remotecmd.CommandDefinition{
+			Id: fmt.Sprintf("rpf-%d", port.ContainerPort),
+			// PidDirectory needs to be writable
+			PidDirectory: "/projects/",
+			CmdLine: fmt.Sprintf("socat -d %[1]s-listen:%[2]d,reuseaddr,fork %[1]s:%[3]s:%[4]d",
+				proto, port.ContainerPort, getReverseTargetHost(port.LocalAddress), port.LocalPort),
+		}
+	}
 	return remotecmd.CommandDefinition{
 		Id: fmt.Sprintf("pf-%s", port.PortName),
 		// PidDirectory needs to be writable
@@ -138,3 +319,13 @@ func getCommandDefinition(port api.ForwardedPort) remotecmd.CommandDefinition {
 		CmdLine:      fmt.Sprintf("socat -d %[1]s-listen:%[2]d,reuseaddr,fork %[1]s:localhost:%[3]d", proto, port.LocalPort, port.ContainerPort),
 	}
 }
+
+// getReverseTargetHost returns the host the helper container connects to, to reach the local address.
+// The loopback interface of the host is reachable from the containers via the host.containers.internal name.
+func getReverseTargetHost(localAddress string) string {
+	switch localAddress {
+	case "", "localhost", "127.0.0.1", "::1":
+		return "host.containers.internal"
+	}
+	return localAddress
+}
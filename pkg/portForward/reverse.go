@@ -0,0 +1,123 @@
+package portForward
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
+
+	"github.com/redhat-developer/odo/pkg/api"
+)
+
+// ReversePortForwardAttribute is the attribute of a container component listing the ports, inside the container,
+// tunneling back to a local address
+const ReversePortForwardAttribute = "dev.odo.reverse-port-forward"
+
+// DefaultReverseLocalAddress is the local address the reverse forwarded ports tunnel to, if not specified
+const DefaultReverseLocalAddress = "127.0.0.1"
+
+const largestPortValue = 65535
+
+// ParseReversePort parses the definition of a reverse forwarded port; acceptable formats are:
+// <localPort>:<containerPort>, <localPort>:<containerName>:<containerPort>, <localAddress>:<localPort>:<containerPort>
+// and <localAddress>:<localPort>:<containerName>:<containerPort>
+func ParseReversePort(definition string) (api.ForwardedPort, error) {
+	invalidErr := fmt.Errorf("%q is invalid; acceptable formats are: [<localAddress>:]<localPort>:[<containerName>:]<containerPort>; where ports must be numbers in the range [1, %d]", definition, largestPortValue)
+
+	result := api.ForwardedPort{
+		IsReverse:    true,
+		LocalAddress: DefaultReverseLocalAddress,
+	}
+	var localPort, containerPort string
+	parts := strings.Split(definition, ":")
+	switch len(parts) {
+	case 2:
+		localPort, containerPort = parts[0], parts[1]
+	case 3:
+		if _, err := strconv.Atoi(parts[0]); err == nil {
+			localPort, result.ContainerName, containerPort = parts[0], parts[1], parts[2]
+			if result.ContainerName == "" {
+				return api.ForwardedPort{}, invalidErr
+			}
+		} else {
+			result.LocalAddress, localPort, containerPort = parts[0], parts[1], parts[2]
+		}
+	case 4:
+		result.LocalAddress, localPort, result.ContainerName, containerPort = parts[0], parts[1], parts[2], parts[3]
+	default:
+		return api.ForwardedPort{}, invalidErr
+	}
+	if result.LocalAddress == "" || (len(parts) == 4 && result.ContainerName == "") {
+		return api.ForwardedPort{}, invalidErr
+	}
+
+	var err error
+	result.LocalPort, err = strconv.Atoi(localPort)
+	if err != nil || result.LocalPort <= 0 || result.LocalPort > largestPortValue {
+		return api.ForwardedPort{}, invalidErr
+	}
+	result.ContainerPort, err = strconv.Atoi(containerPort)
+	if err != nil || result.ContainerPort <= 0 || result.ContainerPort > largestPortValue {
+		return api.ForwardedPort{}, invalidErr
+	}
+	return result, nil
+}
+
+// GetReverseForwardedPorts returns the reverse forwarded ports defined in the attributes of the container components
+// of the Devfile, and by definedPorts. A port of definedPorts replaces the port of the Devfile with the same container port,
+// and is reverse forwarded from the first container if it does not define a container.
+// The returned ports are sorted by container port.
+func GetReverseForwardedPorts(devfileObj parser.DevfileObj, definedPorts []api.ForwardedPort) ([]api.ForwardedPort, error) {
+	containers, err := devfileObj.Data.GetComponents(common.DevfileOptions{
+		ComponentOptions: common.ComponentOptions{ComponentType: v1alpha2.ContainerComponentType},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	byContainerPort := make(map[int]api.ForwardedPort)
+	for _, container := range containers {
+		if !container.Attributes.Exists(ReversePortForwardAttribute) {
+			continue
+		}
+		var definitions []string
+		err = container.Attributes.GetInto(ReversePortForwardAttribute, &definitions)
+		if err != nil {
+			return nil, fmt.Errorf("attribute %q of component %q must be a list of ports: %w", ReversePortForwardAttribute, container.Name, err)
+		}
+		for _, definition := range definitions {
+			port, err := ParseReversePort(definition)
+			if err != nil {
+				return nil, fmt.Errorf("invalid attribute %q of component %q: %w", ReversePortForwardAttribute, container.Name, err)
+			}
+			if port.ContainerName != "" && port.ContainerName != container.Name {
+				return nil, fmt.Errorf("invalid attribute %q of component %q: %q references another container", ReversePortForwardAttribute, container.Name, definition)
+			}
+			port.ContainerName = container.Name
+			if _, found := byContainerPort[port.ContainerPort]; found {
+				return nil, fmt.Errorf("container port %d is reverse forwarded more than once in the Devfile", port.ContainerPort)
+			}
+			byContainerPort[port.ContainerPort] = port
+		}
+	}
+	for _, port := range definedPorts {
+		port.IsReverse = true
+		if port.ContainerName == "" && len(containers) != 0 {
+			port.ContainerName = containers[0].Name
+		}
+		byContainerPort[port.ContainerPort] = port
+	}
+
+	result := make([]api.ForwardedPort, 0, len(byContainerPort))
+	for _, port := range byContainerPort {
+		result = append(result, port)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ContainerPort < result[j].ContainerPort
+	})
+	return result, nil
+}
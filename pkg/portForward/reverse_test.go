@@ -0,0 +1,180 @@
+package portForward
+
+import (
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/attributes"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/redhat-developer/odo/pkg/api"
+)
+
+func TestParseReversePort(t *testing.T) {
+	tests := []struct {
+		name       string
+		definition string
+		want       api.ForwardedPort
+		wantErr    bool
+	}{
+		{
+			name:       "<localPort>:<containerPort>",
+			definition: "5432:15432",
+			want:       api.ForwardedPort{IsReverse: true, LocalAddress: "127.0.0.1", LocalPort: 5432, ContainerPort: 15432},
+		},
+		{
+			name:       "<localPort>:<containerName>:<containerPort>",
+			definition: "5432:runtime:15432",
+			want:       api.ForwardedPort{IsReverse: true, LocalAddress: "127.0.0.1", LocalPort: 5432, ContainerName: "runtime", ContainerPort: 15432},
+		},
+		{
+			name:       "<localAddress>:<localPort>:<containerPort>",
+			definition: "localhost:5432:15432",
+			want:       api.ForwardedPort{IsReverse: true, LocalAddress: "localhost", LocalPort: 5432, ContainerPort: 15432},
+		},
+		{
+			name:       "<localAddress>:<localPort>:<containerName>:<containerPort>",
+			definition: "192.168.1.10:8081:runtime:8081",
+			want:       api.ForwardedPort{IsReverse: true, LocalAddress: "192.168.1.10", LocalPort: 8081, ContainerName: "runtime", ContainerPort: 8081},
+		},
+		{
+			name:       "single port",
+			definition: "5432",
+			wantErr:    true,
+		},
+		{
+			name:       "empty container name",
+			definition: "5432::15432",
+			wantErr:    true,
+		},
+		{
+			name:       "empty local address",
+			definition: ":5432:runtime:15432",
+			wantErr:    true,
+		},
+		{
+			name:       "port out of range",
+			definition: "5432:75432",
+			wantErr:    true,
+		},
+		{
+			name:       "non numeric port",
+			definition: "5432:runtime:db",
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseReversePort(tt.definition)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseReversePort() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ParseReversePort() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetReverseForwardedPorts(t *testing.T) {
+	container := func(name string, reversePorts ...interface{}) v1alpha2.Component {
+		cmp := v1alpha2.Component{
+			Name: name,
+			ComponentUnion: v1alpha2.ComponentUnion{
+				Container: &v1alpha2.ContainerComponent{
+					Container: v1alpha2.Container{Image: "image"},
+				},
+			},
+		}
+		if len(reversePorts) != 0 {
+			cmp.Attributes = attributes.Attributes{}.Put(ReversePortForwardAttribute, reversePorts[0], nil)
+		}
+		return cmp
+	}
+	tests := []struct {
+		name         string
+		components   []v1alpha2.Component
+		definedPorts []api.ForwardedPort
+		want         []api.ForwardedPort
+		wantErr      bool
+	}{
+		{
+			name:       "no reverse forwarded ports",
+			components: []v1alpha2.Component{container("runtime")},
+			want:       []api.ForwardedPort{},
+		},
+		{
+			name: "ports defined in the attributes of several containers",
+			components: []v1alpha2.Component{
+				container("runtime", []string{"8081:18081", "localhost:5432:5432"}),
+				container("tools", []string{"6379:tools:6379"}),
+			},
+			want: []api.ForwardedPort{
+				{IsReverse: true, ContainerName: "runtime", LocalAddress: "localhost", LocalPort: 5432, ContainerPort: 5432},
+				{IsReverse: true, ContainerName: "tools", LocalAddress: "127.0.0.1", LocalPort: 6379, ContainerPort: 6379},
+				{IsReverse: true, ContainerName: "runtime", LocalAddress: "127.0.0.1", LocalPort: 8081, ContainerPort: 18081},
+			},
+		},
+		{
+			name:       "defined ports replace the ports of the Devfile, and default to the first container",
+			components: []v1alpha2.Component{container("runtime", []string{"5432:5432"}), container("tools")},
+			definedPorts: []api.ForwardedPort{
+				{LocalAddress: "127.0.0.1", LocalPort: 15432, ContainerPort: 5432},
+				{LocalAddress: "127.0.0.1", LocalPort: 8081, ContainerName: "tools", ContainerPort: 8081},
+			},
+			want: []api.ForwardedPort{
+				{IsReverse: true, ContainerName: "runtime", LocalAddress: "127.0.0.1", LocalPort: 15432, ContainerPort: 5432},
+				{IsReverse: true, ContainerName: "tools", LocalAddress: "127.0.0.1", LocalPort: 8081, ContainerPort: 8081},
+			},
+		},
+		{
+			name:       "attribute is not a list",
+			components: []v1alpha2.Component{container("runtime", "5432:5432")},
+			wantErr:    true,
+		},
+		{
+			name:       "invalid port in attribute",
+			components: []v1alpha2.Component{container("runtime", []string{"5432"})},
+			wantErr:    true,
+		},
+		{
+			name:       "attribute references another container",
+			components: []v1alpha2.Component{container("runtime", []string{"5432:tools:5432"}), container("tools")},
+			wantErr:    true,
+		},
+		{
+			name: "container port reverse forwarded twice",
+			components: []v1alpha2.Component{
+				container("runtime", []string{"5432:5432"}),
+				container("tools", []string{"15432:5432"}),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			devfileData, err := data.NewDevfileData(string(data.APISchemaVersion200))
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = devfileData.AddComponents(tt.components)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := GetReverseForwardedPorts(parser.DevfileObj{Data: devfileData}, tt.definedPorts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetReverseForwardedPorts() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("GetReverseForwardedPorts() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -2,7 +2,9 @@ package preference
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/url"
 	"os"
 	"os/user"
 	"path/filepath"
@@ -50,6 +52,27 @@ type odoSettings struct {
 	// ImageRegistry is the image registry to which relative image names in Devfile Image Components will be pushed to.
 	// This will also serve as the base path for replacing matching images in other components like Container and Kubernetes/OpenShift ones.
 	ImageRegistry *string `yaml:"ImageRegistry,omitempty"`
+
+	// SyncCompression is the compression used to transfer files to the containers
+	SyncCompression *string `yaml:"SyncCompression,omitempty"`
+
+	// WatchBackend is the mechanism used to detect changes in the source files
+	WatchBackend *string `yaml:"WatchBackend,omitempty"`
+
+	// WatchPollingInterval is the interval between two scans of the source files when polling
+	WatchPollingInterval *time.Duration `yaml:"WatchPollingInterval,omitempty"`
+
+	// SyncDelay is the period without file changes after which the changes are synced
+	SyncDelay *time.Duration `yaml:"SyncDelay,omitempty"`
+
+	// MaxRestarts is the maximum number of times odo dev restarts a crashed run command
+	MaxRestarts *int `yaml:"MaxRestarts,omitempty"`
+
+	// PodmanConnection is the connection to the Podman service, either the name of a system connection or the URL of the service
+	PodmanConnection *string `yaml:"PodmanConnection,omitempty"`
+
+	// PodmanClient is the client used to communicate with Podman, either the podman command or the REST API of the service
+	PodmanClient *string `yaml:"PodmanClient,omitempty"`
 }
 
 // Registry includes the registry metadata
@@ -153,6 +176,9 @@ func newPreferenceInfo(ctx context.Context) (*preferenceInfo, error) {
 	if c.OdoSettings.RegistryCacheTime != nil && *c.OdoSettings.RegistryCacheTime < minimumDurationValue {
 		requiresChange = append(requiresChange, RegistryCacheTimeSetting)
 	}
+	if c.OdoSettings.WatchPollingInterval != nil && *c.OdoSettings.WatchPollingInterval < minimumDurationValue {
+		requiresChange = append(requiresChange, WatchPollingIntervalSetting)
+	}
 	if len(requiresChange) != 0 {
 		log.Warningf("Please change the preference value for %s, the value does not comply with the minimum value of %s; e.g. of acceptable formats: 4s, 5m, 1h", strings.Join(requiresChange, ", "), minimumDurationValue)
 	}
@@ -317,6 +343,55 @@ func (c *preferenceInfo) SetConfiguration(parameter string, value string) error
 
 		case "imageregistry":
 			c.OdoSettings.ImageRegistry = &value
+
+		case "synccompression":
+			val := strings.ToLower(value)
+			if !dfutil.In(SyncCompressionValues, val) {
+				return fmt.Errorf("unable to set %q to %q, value must be one of %s", parameter, value, strings.Join(SyncCompressionValues, ", "))
+			}
+			c.OdoSettings.SyncCompression = &val
+
+		case "watchbackend":
+			val := strings.ToLower(value)
+			if !dfutil.In(WatchBackendValues, val) {
+				return fmt.Errorf("unable to set %q to %q, value must be one of %s", parameter, value, strings.Join(WatchBackendValues, ", "))
+			}
+			c.OdoSettings.WatchBackend = &val
+
+		case "watchpollinginterval":
+			typedval, err := parseDuration(value, parameter)
+			if err != nil {
+				return err
+			}
+			c.OdoSettings.WatchPollingInterval = &typedval
+
+		case "syncdelay":
+			// The minimum duration does not apply, as the delay is usually less than one second
+			typedval, err := time.ParseDuration(value)
+			if err != nil || typedval < 0 {
+				return fmt.Errorf("unable to set %q to %q, value must be a positive duration; e.g. of acceptable formats: 100ms, 2s", parameter, value)
+			}
+			c.OdoSettings.SyncDelay = &typedval
+
+		case "maxrestarts":
+			val, err := strconv.Atoi(value)
+			if err != nil || val < 0 {
+				return fmt.Errorf("unable to set %q to %q, value must be a positive integer", parameter, value)
+			}
+			c.OdoSettings.MaxRestarts = &val
+
+		case "podmanconnection":
+			if err := validatePodmanConnection(value); err != nil {
+				return fmt.Errorf("unable to set %q to %q, %w", parameter, value, err)
+			}
+			c.OdoSettings.PodmanConnection = &value
+
+		case "podmanclient":
+			val := strings.ToLower(value)
+			if !dfutil.In(PodmanClientValues, val) {
+				return fmt.Errorf("unable to set %q to %q, value must be one of %s", parameter, value, strings.Join(PodmanClientValues, ", "))
+			}
+			c.OdoSettings.PodmanClient = &val
 		}
 	} else {
 		return fmt.Errorf("unknown parameter : %q is not a parameter in odo preference, run `odo preference -h` to see list of available parameters", parameter)
@@ -329,6 +404,25 @@ func (c *preferenceInfo) SetConfiguration(parameter string, value string) error
 	return nil
 }
 
+// validatePodmanConnection checks that the value is either the URL of a Podman service, using one of the schemes supported by Podman,
+// or the name of a system connection
+func validatePodmanConnection(value string) error {
+	if !strings.Contains(value, "://") {
+		if value == "" || strings.ContainsAny(value, " \t\n") {
+			return errors.New("value must be the name of a system connection, or the URL of the Podman service")
+		}
+		return nil
+	}
+	u, err := url.Parse(value)
+	if err != nil {
+		return fmt.Errorf("value must be a valid URL: %w", err)
+	}
+	if !dfutil.In(PodmanConnectionSchemes, u.Scheme) {
+		return fmt.Errorf("the scheme of the URL must be one of %s", strings.Join(PodmanConnectionSchemes, ", "))
+	}
+	return nil
+}
+
 // parseDuration parses the value set for a parameter;
 // if the value is for e.g. "4m", it is parsed by the time pkg and converted to an appropriate time.Duration
 // it returns an error if one occurred, or if the parsed value is less than minimumDurationValue
@@ -391,6 +485,44 @@ func (c *preferenceInfo) GetImageRegistry() string {
 	return kpointer.StringDeref(c.OdoSettings.ImageRegistry, "")
 }
 
+// GetSyncCompression returns the value of SyncCompression from the preferences
+// and, if absent, then returns default
+func (c *preferenceInfo) GetSyncCompression() string {
+	return kpointer.StringDeref(c.OdoSettings.SyncCompression, DefaultSyncCompressionSetting)
+}
+
+// GetWatchBackend returns the value of WatchBackend from the preferences
+// and, if absent, then returns default
+func (c *preferenceInfo) GetWatchBackend() string {
+	return kpointer.StringDeref(c.OdoSettings.WatchBackend, DefaultWatchBackendSetting)
+}
+
+// GetWatchPollingInterval gets the value set by WatchPollingInterval
+func (c *preferenceInfo) GetWatchPollingInterval() time.Duration {
+	return kpointer.DurationDeref(c.OdoSettings.WatchPollingInterval, DefaultWatchPollingInterval)
+}
+
+// GetSyncDelay gets the value set by SyncDelay
+func (c *preferenceInfo) GetSyncDelay() time.Duration {
+	return kpointer.DurationDeref(c.OdoSettings.SyncDelay, DefaultSyncDelay)
+}
+
+// GetMaxRestarts gets the value set by MaxRestarts
+func (c *preferenceInfo) GetMaxRestarts() int {
+	return kpointer.IntDeref(c.OdoSettings.MaxRestarts, DefaultMaxRestarts)
+}
+
+// GetPodmanConnection gets the value set by PodmanConnection, or an empty string to use the default connection
+func (c *preferenceInfo) GetPodmanConnection() string {
+	return kpointer.StringDeref(c.OdoSettings.PodmanConnection, "")
+}
+
+// GetPodmanClient returns the value of PodmanClient from the preferences
+// and, if absent, then returns default
+func (c *preferenceInfo) GetPodmanClient() string {
+	return kpointer.StringDeref(c.OdoSettings.PodmanClient, DefaultPodmanClientSetting)
+}
+
 // GetUpdateNotification returns the value of UpdateNotification from preferences
 // and if absent then returns default
 func (c *preferenceInfo) GetUpdateNotification() bool {
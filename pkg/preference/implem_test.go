@@ -347,6 +347,125 @@ func TestSetConfiguration(t *testing.T) {
 			wantErr: false,
 			want:    false,
 		},
+		{
+			name:           fmt.Sprintf("set %s from nil to gzip", SyncCompressionSetting),
+			parameter:      SyncCompressionSetting,
+			value:          "GZip",
+			existingConfig: Preference{},
+			wantErr:        false,
+			want:           SyncCompressionGzip,
+		},
+		{
+			name:           fmt.Sprintf("set %s to an invalid value", SyncCompressionSetting),
+			parameter:      SyncCompressionSetting,
+			value:          "bzip2",
+			existingConfig: Preference{},
+			wantErr:        true,
+		},
+		{
+			name:           fmt.Sprintf("set %s from nil to polling", WatchBackendSetting),
+			parameter:      WatchBackendSetting,
+			value:          "Polling",
+			existingConfig: Preference{},
+			wantErr:        false,
+			want:           WatchBackendPolling,
+		},
+		{
+			name:           fmt.Sprintf("set %s to an invalid value", WatchBackendSetting),
+			parameter:      WatchBackendSetting,
+			value:          "inotify",
+			existingConfig: Preference{},
+			wantErr:        true,
+		},
+		{
+			name:           fmt.Sprintf("set %s to the name of a system connection", PodmanConnectionSetting),
+			parameter:      PodmanConnectionSetting,
+			value:          "podman-machine-default-root",
+			existingConfig: Preference{},
+			wantErr:        false,
+			want:           "podman-machine-default-root",
+		},
+		{
+			name:           fmt.Sprintf("set %s to the URL of a service", PodmanConnectionSetting),
+			parameter:      PodmanConnectionSetting,
+			value:          "ssh://core@localhost:45678/run/podman/podman.sock",
+			existingConfig: Preference{},
+			wantErr:        false,
+			want:           "ssh://core@localhost:45678/run/podman/podman.sock",
+		},
+		{
+			name:           fmt.Sprintf("set %s to a URL with an unsupported scheme", PodmanConnectionSetting),
+			parameter:      PodmanConnectionSetting,
+			value:          "https://localhost:8080",
+			existingConfig: Preference{},
+			wantErr:        true,
+		},
+		{
+			name:           fmt.Sprintf("set %s to an invalid connection name", PodmanConnectionSetting),
+			parameter:      PodmanConnectionSetting,
+			value:          "my connection",
+			existingConfig: Preference{},
+			wantErr:        true,
+		},
+		{
+			name:           fmt.Sprintf("set %s from nil to api", PodmanClientSetting),
+			parameter:      PodmanClientSetting,
+			value:          "API",
+			existingConfig: Preference{},
+			wantErr:        false,
+			want:           PodmanClientAPI,
+		},
+		{
+			name:           fmt.Sprintf("set %s to an invalid value", PodmanClientSetting),
+			parameter:      PodmanClientSetting,
+			value:          "varlink",
+			existingConfig: Preference{},
+			wantErr:        true,
+		},
+		{
+			name:           fmt.Sprintf("set %s to a value lower than the minimum", WatchPollingIntervalSetting),
+			parameter:      WatchPollingIntervalSetting,
+			value:          "500ms",
+			existingConfig: Preference{},
+			wantErr:        true,
+		},
+		{
+			name:           fmt.Sprintf("set %s to a value lower than one second", SyncDelaySetting),
+			parameter:      SyncDelaySetting,
+			value:          "500ms",
+			existingConfig: Preference{},
+			wantErr:        false,
+			want:           500 * time.Millisecond,
+		},
+		{
+			name:           fmt.Sprintf("set %s to a negative value", SyncDelaySetting),
+			parameter:      SyncDelaySetting,
+			value:          "-1s",
+			existingConfig: Preference{},
+			wantErr:        true,
+		},
+		{
+			name:           fmt.Sprintf("set %s to a valid value", MaxRestartsSetting),
+			parameter:      MaxRestartsSetting,
+			value:          "3",
+			existingConfig: Preference{},
+			wantErr:        false,
+			want:           3,
+		},
+		{
+			name:           fmt.Sprintf("set %s to a negative value", MaxRestartsSetting),
+			parameter:      MaxRestartsSetting,
+			value:          "-1",
+			existingConfig: Preference{},
+			wantErr:        true,
+		},
+		{
+			name:           fmt.Sprintf("set %s to an invalid value", MaxRestartsSetting),
+			parameter:      MaxRestartsSetting,
+			value:          "many",
+			existingConfig: Preference{},
+			wantErr:        true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -376,6 +495,30 @@ func TestSetConfiguration(t *testing.T) {
 					if *cfg.OdoSettings.RegistryCacheTime != tt.want {
 						t.Errorf("unexpected value after execution of SetConfiguration\ngot: %v \nexpected: %d\n", *cfg.OdoSettings.RegistryCacheTime, tt.want)
 					}
+				case "SyncCompression":
+					if *cfg.OdoSettings.SyncCompression != tt.want {
+						t.Errorf("unexpected value after execution of SetConfiguration\ngot: %v \nexpected: %v\n", *cfg.OdoSettings.SyncCompression, tt.want)
+					}
+				case "SyncDelay":
+					if *cfg.OdoSettings.SyncDelay != tt.want {
+						t.Errorf("unexpected value after execution of SetConfiguration\ngot: %v \nexpected: %v\n", *cfg.OdoSettings.SyncDelay, tt.want)
+					}
+				case "MaxRestarts":
+					if *cfg.OdoSettings.MaxRestarts != tt.want {
+						t.Errorf("unexpected value after execution of SetConfiguration\ngot: %v \nexpected: %v\n", *cfg.OdoSettings.MaxRestarts, tt.want)
+					}
+				case "WatchBackend":
+					if *cfg.OdoSettings.WatchBackend != tt.want {
+						t.Errorf("unexpected value after execution of SetConfiguration\ngot: %v \nexpected: %v\n", *cfg.OdoSettings.WatchBackend, tt.want)
+					}
+				case "PodmanConnection":
+					if *cfg.OdoSettings.PodmanConnection != tt.want {
+						t.Errorf("unexpected value after execution of SetConfiguration\ngot: %v \nexpected: %v\n", *cfg.OdoSettings.PodmanConnection, tt.want)
+					}
+				case "PodmanClient":
+					if *cfg.OdoSettings.PodmanClient != tt.want {
+						t.Errorf("unexpected value after execution of SetConfiguration\ngot: %v \nexpected: %v\n", *cfg.OdoSettings.PodmanClient, tt.want)
+					}
 				}
 			} else if tt.wantErr && err != nil {
 				// negative cases
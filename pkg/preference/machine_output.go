@@ -64,6 +64,55 @@ func toPreferenceItems(prefInfo preferenceInfo) []api.PreferenceItem {
 			Type:        getType(prefInfo.GetImageRegistry()),
 			Description: ImageRegistrySettingDescription,
 		},
+		{
+			Name:        SyncCompressionSetting,
+			Value:       settings.SyncCompression,
+			Default:     DefaultSyncCompressionSetting,
+			Type:        getType(prefInfo.GetSyncCompression()),
+			Description: SyncCompressionSettingDescription,
+		},
+		{
+			Name:        WatchBackendSetting,
+			Value:       settings.WatchBackend,
+			Default:     DefaultWatchBackendSetting,
+			Type:        getType(prefInfo.GetWatchBackend()),
+			Description: WatchBackendSettingDescription,
+		},
+		{
+			Name:        WatchPollingIntervalSetting,
+			Value:       settings.WatchPollingInterval,
+			Default:     DefaultWatchPollingInterval,
+			Type:        getType(prefInfo.GetWatchPollingInterval()),
+			Description: WatchPollingIntervalSettingDescription,
+		},
+		{
+			Name:        SyncDelaySetting,
+			Value:       settings.SyncDelay,
+			Default:     DefaultSyncDelay,
+			Type:        getType(prefInfo.GetSyncDelay()),
+			Description: SyncDelaySettingDescription,
+		},
+		{
+			Name:        MaxRestartsSetting,
+			Value:       settings.MaxRestarts,
+			Default:     DefaultMaxRestarts,
+			Type:        getType(prefInfo.GetMaxRestarts()),
+			Description: MaxRestartsSettingDescription,
+		},
+		{
+			Name:        PodmanConnectionSetting,
+			Value:       settings.PodmanConnection,
+			Default:     "",
+			Type:        getType(prefInfo.GetPodmanConnection()),
+			Description: PodmanConnectionSettingDescription,
+		},
+		{
+			Name:        PodmanClientSetting,
+			Value:       settings.PodmanClient,
+			Default:     DefaultPodmanClientSetting,
+			Type:        getType(prefInfo.GetPodmanClient()),
+			Description: PodmanClientSettingDescription,
+		},
 	}
 }
 
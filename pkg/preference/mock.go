@@ -119,6 +119,48 @@ func (mr *MockClientMockRecorder) GetImageRegistry() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImageRegistry", reflect.TypeOf((*MockClient)(nil).GetImageRegistry))
 }
 
+// GetMaxRestarts mocks base method.
+func (m *MockClient) GetMaxRestarts() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaxRestarts")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// GetMaxRestarts indicates an expected call of GetMaxRestarts.
+func (mr *MockClientMockRecorder) GetMaxRestarts() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaxRestarts", reflect.TypeOf((*MockClient)(nil).GetMaxRestarts))
+}
+
+// GetPodmanClient mocks base method.
+func (m *MockClient) GetPodmanClient() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPodmanClient")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetPodmanClient indicates an expected call of GetPodmanClient.
+func (mr *MockClientMockRecorder) GetPodmanClient() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPodmanClient", reflect.TypeOf((*MockClient)(nil).GetPodmanClient))
+}
+
+// GetPodmanConnection mocks base method.
+func (m *MockClient) GetPodmanConnection() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPodmanConnection")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetPodmanConnection indicates an expected call of GetPodmanConnection.
+func (mr *MockClientMockRecorder) GetPodmanConnection() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPodmanConnection", reflect.TypeOf((*MockClient)(nil).GetPodmanConnection))
+}
+
 // GetPushTimeout mocks base method.
 func (m *MockClient) GetPushTimeout() time.Duration {
 	m.ctrl.T.Helper()
@@ -147,6 +189,34 @@ func (mr *MockClientMockRecorder) GetRegistryCacheTime() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistryCacheTime", reflect.TypeOf((*MockClient)(nil).GetRegistryCacheTime))
 }
 
+// GetSyncCompression mocks base method.
+func (m *MockClient) GetSyncCompression() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSyncCompression")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetSyncCompression indicates an expected call of GetSyncCompression.
+func (mr *MockClientMockRecorder) GetSyncCompression() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSyncCompression", reflect.TypeOf((*MockClient)(nil).GetSyncCompression))
+}
+
+// GetSyncDelay mocks base method.
+func (m *MockClient) GetSyncDelay() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSyncDelay")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// GetSyncDelay indicates an expected call of GetSyncDelay.
+func (mr *MockClientMockRecorder) GetSyncDelay() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSyncDelay", reflect.TypeOf((*MockClient)(nil).GetSyncDelay))
+}
+
 // GetTimeout mocks base method.
 func (m *MockClient) GetTimeout() time.Duration {
 	m.ctrl.T.Helper()
@@ -175,6 +245,34 @@ func (mr *MockClientMockRecorder) GetUpdateNotification() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpdateNotification", reflect.TypeOf((*MockClient)(nil).GetUpdateNotification))
 }
 
+// GetWatchBackend mocks base method.
+func (m *MockClient) GetWatchBackend() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWatchBackend")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetWatchBackend indicates an expected call of GetWatchBackend.
+func (mr *MockClientMockRecorder) GetWatchBackend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWatchBackend", reflect.TypeOf((*MockClient)(nil).GetWatchBackend))
+}
+
+// GetWatchPollingInterval mocks base method.
+func (m *MockClient) GetWatchPollingInterval() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWatchPollingInterval")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// GetWatchPollingInterval indicates an expected call of GetWatchPollingInterval.
+func (mr *MockClientMockRecorder) GetWatchPollingInterval() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWatchPollingInterval", reflect.TypeOf((*MockClient)(nil).GetWatchPollingInterval))
+}
+
 // IsSet mocks base method.
 func (m *MockClient) IsSet(parameter string) bool {
 	m.ctrl.T.Helper()
@@ -18,6 +18,13 @@ type Client interface {
 	GetConsentTelemetry() bool
 	GetRegistryCacheTime() time.Duration
 	GetImageRegistry() string
+	GetSyncCompression() string
+	GetWatchBackend() string
+	GetWatchPollingInterval() time.Duration
+	GetSyncDelay() time.Duration
+	GetMaxRestarts() int
+	GetPodmanConnection() string
+	GetPodmanClient() string
 	RegistryHandler(operation string, registryName string, registryURL string, forceFlag bool, isSecure bool) error
 
 	UpdateNotification() *bool
@@ -3,6 +3,7 @@ package preference
 import (
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/redhat-developer/odo/pkg/util"
@@ -64,6 +65,65 @@ const (
 
 	// DefaultConsentTelemetry is a default value for ConsentTelemetry preference
 	DefaultConsentTelemetrySetting = false
+
+	// SyncCompressionSetting specifies the compression used to transfer files to the containers
+	SyncCompressionSetting = "SyncCompression"
+
+	// SyncCompressionAuto uses the best compression supported by the container
+	SyncCompressionAuto = "auto"
+	// SyncCompressionZstd compresses the files with zstd
+	SyncCompressionZstd = "zstd"
+	// SyncCompressionGzip compresses the files with gzip
+	SyncCompressionGzip = "gzip"
+	// SyncCompressionNone transfers the files without compression
+	SyncCompressionNone = "none"
+
+	// DefaultSyncCompressionSetting is a default value for SyncCompression preference
+	DefaultSyncCompressionSetting = SyncCompressionAuto
+
+	// WatchBackendSetting specifies the mechanism used to detect changes in the source files
+	WatchBackendSetting = "WatchBackend"
+
+	// WatchBackendFsnotify uses filesystem notifications, and falls back to polling when the system limits are reached
+	WatchBackendFsnotify = "fsnotify"
+	// WatchBackendPolling regularly scans the source files for changes
+	WatchBackendPolling = "polling"
+
+	// DefaultWatchBackendSetting is a default value for WatchBackend preference
+	DefaultWatchBackendSetting = WatchBackendFsnotify
+
+	// WatchPollingIntervalSetting is the name of the setting controlling WatchPollingInterval
+	WatchPollingIntervalSetting = "WatchPollingInterval"
+
+	// DefaultWatchPollingInterval is the default interval between two scans of the source files when polling
+	DefaultWatchPollingInterval = 1 * time.Second
+
+	// SyncDelaySetting is the name of the setting controlling SyncDelay
+	SyncDelaySetting = "SyncDelay"
+
+	// DefaultSyncDelay is the default period without file changes after which the changes are synced
+	DefaultSyncDelay = 100 * time.Millisecond
+
+	// MaxRestartsSetting is the name of the setting controlling MaxRestarts
+	MaxRestartsSetting = "MaxRestarts"
+
+	// DefaultMaxRestarts is the default maximum number of times odo dev restarts a crashed run command
+	DefaultMaxRestarts = 5
+
+	// PodmanConnectionSetting is the name of the setting controlling PodmanConnection
+	PodmanConnectionSetting = "PodmanConnection"
+
+	// PodmanClientSetting specifies how odo communicates with Podman
+	PodmanClientSetting = "PodmanClient"
+
+	// PodmanClientCLI executes the podman command
+	PodmanClientCLI = "cli"
+	// PodmanClientAPI sends requests to the REST API of the Podman service, and falls back to the podman command
+	// when the service is not reachable
+	PodmanClientAPI = "api"
+
+	// DefaultPodmanClientSetting is a default value for PodmanClient preference
+	DefaultPodmanClientSetting = PodmanClientCLI
 )
 
 // TimeoutSettingDescription is human-readable description for the timeout setting
@@ -81,6 +141,39 @@ var EphemeralSettingDescription = fmt.Sprintf("If true, odo will create an empty
 // ConsentTelemetrySettingDescription adds a description for TelemetryConsentSetting
 var ConsentTelemetrySettingDescription = fmt.Sprintf("If true, odo will collect telemetry for the user's odo usage (Default: %t)\n\t\t    For more information: https://developers.redhat.com/article/tool-data-collection", DefaultConsentTelemetrySetting)
 
+// SyncCompressionSettingDescription adds a description for SyncCompression
+var SyncCompressionSettingDescription = fmt.Sprintf("Compression used to sync files to the containers, one of %s (Default: %s)", strings.Join(SyncCompressionValues, ", "), DefaultSyncCompressionSetting)
+
+// SyncCompressionValues are the accepted values for the SyncCompression preference
+var SyncCompressionValues = []string{SyncCompressionAuto, SyncCompressionZstd, SyncCompressionGzip, SyncCompressionNone}
+
+// WatchBackendSettingDescription adds a description for WatchBackend
+var WatchBackendSettingDescription = fmt.Sprintf("Mechanism used to detect changes in the source files, one of %s (Default: %s)", strings.Join(WatchBackendValues, ", "), DefaultWatchBackendSetting)
+
+// WatchBackendValues are the accepted values for the WatchBackend preference
+var WatchBackendValues = []string{WatchBackendFsnotify, WatchBackendPolling}
+
+// SyncDelaySettingDescription adds a description for SyncDelay
+var SyncDelaySettingDescription = fmt.Sprintf("Period (in Duration) without file changes after which odo dev syncs the changed files (Default: %s)", DefaultSyncDelay)
+
+// MaxRestartsSettingDescription adds a description for MaxRestarts
+var MaxRestartsSettingDescription = fmt.Sprintf("Maximum number of times odo dev --auto-restart restarts the run command after it stopped (Default: %d)", DefaultMaxRestarts)
+
+// WatchPollingIntervalSettingDescription adds a description for WatchPollingInterval
+var WatchPollingIntervalSettingDescription = fmt.Sprintf("Interval (in Duration) between two scans of the source files, when the polling watch backend is used (Default: %s)", DefaultWatchPollingInterval)
+
+// PodmanConnectionSettingDescription adds a description for PodmanConnection
+var PodmanConnectionSettingDescription = fmt.Sprintf("Connection to the Podman service, either the name of a system connection or the URL of the service, using one of the schemes %s (Default: the default connection of podman)", strings.Join(PodmanConnectionSchemes, ", "))
+
+// PodmanConnectionSchemes are the schemes accepted for the URL of the Podman service
+var PodmanConnectionSchemes = []string{"unix", "ssh", "tcp"}
+
+// PodmanClientSettingDescription adds a description for PodmanClient
+var PodmanClientSettingDescription = fmt.Sprintf("Client used to communicate with Podman, either the podman command (cli) or the REST API of the Podman service (api), one of %s (Default: %s)", strings.Join(PodmanClientValues, ", "), DefaultPodmanClientSetting)
+
+// PodmanClientValues are the accepted values for the PodmanClient preference
+var PodmanClientValues = []string{PodmanClientCLI, PodmanClientAPI}
+
 const ImageRegistrySettingDescription = "Image Registry to which relative image names in Devfile Image Components will be pushed to (Example: quay.io/my-user/)"
 
 // This value can be provided to set a seperate directory for users 'homedir' resolution
@@ -90,13 +183,20 @@ var customHomeDir = os.Getenv("CUSTOM_HOMEDIR")
 var (
 	// records information on supported parameters
 	supportedParameterDescriptions = map[string]string{
-		UpdateNotificationSetting: UpdateNotificationSettingDescription,
-		TimeoutSetting:            TimeoutSettingDescription,
-		PushTimeoutSetting:        PushTimeoutSettingDescription,
-		RegistryCacheTimeSetting:  RegistryCacheTimeSettingDescription,
-		EphemeralSetting:          EphemeralSettingDescription,
-		ConsentTelemetrySetting:   ConsentTelemetrySettingDescription,
-		ImageRegistrySetting:      ImageRegistrySettingDescription,
+		UpdateNotificationSetting:   UpdateNotificationSettingDescription,
+		TimeoutSetting:              TimeoutSettingDescription,
+		PushTimeoutSetting:          PushTimeoutSettingDescription,
+		RegistryCacheTimeSetting:    RegistryCacheTimeSettingDescription,
+		EphemeralSetting:            EphemeralSettingDescription,
+		ConsentTelemetrySetting:     ConsentTelemetrySettingDescription,
+		ImageRegistrySetting:        ImageRegistrySettingDescription,
+		SyncCompressionSetting:      SyncCompressionSettingDescription,
+		WatchBackendSetting:         WatchBackendSettingDescription,
+		WatchPollingIntervalSetting: WatchPollingIntervalSettingDescription,
+		SyncDelaySetting:            SyncDelaySettingDescription,
+		MaxRestartsSetting:          MaxRestartsSettingDescription,
+		PodmanConnectionSetting:     PodmanConnectionSettingDescription,
+		PodmanClientSetting:         PodmanClientSettingDescription,
 	}
 
 	// set-like map to quickly check if a parameter is supported
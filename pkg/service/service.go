@@ -237,6 +237,29 @@ func PushKubernetesResource(client kclient.ClientInterface, u unstructured.Unstr
 	return err
 }
 
+// DryRunResult contains a resource currently deployed on the cluster, or nil if the resource does not exist,
+// and the resource as it would be after it is pushed
+type DryRunResult struct {
+	Current *unstructured.Unstructured
+	Applied *unstructured.Unstructured
+}
+
+// DryRunKubernetesResource pushes a Kubernetes resource (u) to the cluster using client in dry-run mode,
+// adding labels and annotations to the resource the same way as PushKubernetesResource does
+func DryRunKubernetesResource(client kclient.ClientInterface, u unstructured.Unstructured, labels map[string]string, annotations map[string]string) (DryRunResult, error) {
+	u.SetLabels(mergeMaps(u.GetLabels(), labels))
+	u.SetAnnotations(mergeMaps(u.GetAnnotations(), annotations))
+
+	current, applied, err := client.DryRunPatchDynamicResource(u)
+	if err != nil {
+		return DryRunResult{}, err
+	}
+	return DryRunResult{
+		Current: current,
+		Applied: applied,
+	}, nil
+}
+
 func mergeMaps(maps ...map[string]string) map[string]string {
 	mergedMaps := map[string]string{}
 
@@ -10,9 +10,16 @@ type Client interface {
 	// Init creates a devstate file for the process
 	Init(ctx context.Context) error
 
-	// SetForwardedPorts sets the forwarded ports in the state file and saves it to the file, updating the metadata
+	// SetForwardedPorts sets the forwarded ports in the state file and saves it to the file, updating the metadata.
+	// The ports added dynamically with AddForwardedPort are kept
 	SetForwardedPorts(ctx context.Context, fwPorts []api.ForwardedPort) error
 
+	// AddForwardedPort adds a port forwarded dynamically during the session to the state file and saves it to the file, updating the metadata
+	AddForwardedPort(ctx context.Context, fwPort api.ForwardedPort) error
+
+	// RemoveForwardedPort removes the port forwarded dynamically on localPort from the state file and saves it to the file, updating the metadata
+	RemoveForwardedPort(ctx context.Context, localPort int) error
+
 	// GetForwardedPorts returns the ports forwarded by the current odo dev session
 	GetForwardedPorts(ctx context.Context) ([]api.ForwardedPort, error)
 
@@ -25,5 +32,29 @@ type Client interface {
 	// GetAPIServerPorts returns the port where the API servers are listening, possibly per platform.
 	GetAPIServerPorts(ctx context.Context) ([]api.DevControlPlane, error)
 
+	// SetSession sets the state of the component managed by the session in the state file and saves it to the file, updating the metadata
+	SetSession(ctx context.Context, session Session) error
+
+	// SetApplicationStatus sets the status of the application in the state file and saves it to the file, updating the metadata
+	SetApplicationStatus(ctx context.Context, status api.ApplicationStatus) error
+
+	// GetApplicationStatus returns the status of the application started by the current odo dev sessions, possibly per platform
+	GetApplicationStatus(ctx context.Context) ([]api.ApplicationStatus, error)
+
+	// SetPodmanConnection sets the connection to the Podman service used by the session in the state file and saves it to the file, updating the metadata
+	SetPodmanConnection(ctx context.Context, connection string) error
+
+	// GetPodmanConnection returns the connection to the Podman service used by the current odo dev session on podman,
+	// and false if no session is running on podman. The sessions of the odo processes not running anymore are ignored
+	GetPodmanConnection(ctx context.Context) (string, bool, error)
+
+	// GetPreviousSession returns the state saved by a previous odo dev session on the same platform
+	// whose process is not running anymore, or nil if no such session with a component state is found
+	GetPreviousSession(ctx context.Context) (*Content, error)
+
+	// DeletePreviousSession removes the state file left by the previous odo dev session of the process pid,
+	// once its session has been restored. The file is kept if the process is still running
+	DeletePreviousSession(ctx context.Context, pid int) error
+
 	GetOrphanFiles(ctx context.Context) ([]string, error)
 }
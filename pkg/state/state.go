@@ -9,6 +9,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"sync"
 
 	"github.com/mitchellh/go-ps"
 	"k8s.io/klog"
@@ -26,6 +27,9 @@ type State struct {
 	content Content
 	fs      filesystem.Filesystem
 	system  system.System
+
+	// mu protects content from concurrent read-modify-write operations
+	mu sync.Mutex
 }
 
 var _ Client = (*State)(nil)
@@ -49,15 +53,39 @@ func (o *State) Init(ctx context.Context) error {
 }
 
 func (o *State) SetForwardedPorts(ctx context.Context, fwPorts []api.ForwardedPort) error {
-	var (
-		pid      = odocontext.GetPID(ctx)
-		platform = fcontext.GetPlatform(ctx, commonflags.PlatformCluster)
-	)
-	// TODO(feloy) When other data is persisted into the state file, it will be needed to read the file first
-	o.content.ForwardedPorts = fwPorts
-	o.content.PID = pid
-	o.content.Platform = platform
-	return o.save(ctx, pid)
+	return o.update(ctx, func(content *Content) {
+		var dynamicPorts []api.ForwardedPort
+		for _, fwPort := range content.ForwardedPorts {
+			if fwPort.IsDynamic {
+				dynamicPorts = append(dynamicPorts, fwPort)
+			}
+		}
+		if len(dynamicPorts) == 0 {
+			content.ForwardedPorts = fwPorts
+			return
+		}
+		content.ForwardedPorts = append(append([]api.ForwardedPort{}, fwPorts...), dynamicPorts...)
+	})
+}
+
+func (o *State) AddForwardedPort(ctx context.Context, fwPort api.ForwardedPort) error {
+	fwPort.IsDynamic = true
+	return o.update(ctx, func(content *Content) {
+		content.ForwardedPorts = append(content.ForwardedPorts, fwPort)
+	})
+}
+
+func (o *State) RemoveForwardedPort(ctx context.Context, localPort int) error {
+	return o.update(ctx, func(content *Content) {
+		fwPorts := make([]api.ForwardedPort, 0, len(content.ForwardedPorts))
+		for _, fwPort := range content.ForwardedPorts {
+			if fwPort.IsDynamic && fwPort.LocalPort == localPort {
+				continue
+			}
+			fwPorts = append(fwPorts, fwPort)
+		}
+		content.ForwardedPorts = fwPorts
+	})
 }
 
 func (o *State) GetForwardedPorts(ctx context.Context) ([]api.ForwardedPort, error) {
@@ -67,7 +95,7 @@ func (o *State) GetForwardedPorts(ctx context.Context) ([]api.ForwardedPort, err
 		platform  = fcontext.GetPlatform(ctx, "")
 	)
 	if platform == "" {
-		platforms = []string{commonflags.PlatformCluster, commonflags.PlatformPodman}
+		platforms = []string{commonflags.PlatformCluster, commonflags.PlatformPodman, commonflags.PlatformDocker}
 	} else {
 		platforms = []string{platform}
 	}
@@ -89,10 +117,16 @@ func (o *State) SaveExit(ctx context.Context) error {
 	var (
 		pid = odocontext.GetPID(ctx)
 	)
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
 	o.content.ForwardedPorts = nil
 	o.content.PID = 0
 	o.content.Platform = ""
 	o.content.APIServerPort = 0
+	o.content.Session = nil
+	o.content.ApplicationStatus = nil
+	o.content.PodmanConnection = ""
 	err := o.delete(pid)
 	if err != nil {
 		return err
@@ -101,12 +135,175 @@ func (o *State) SaveExit(ctx context.Context) error {
 }
 
 func (o *State) SetAPIServerPort(ctx context.Context, port int) error {
+	return o.update(ctx, func(content *Content) {
+		content.APIServerPort = port
+	})
+}
+
+func (o *State) SetSession(ctx context.Context, session Session) error {
+	return o.update(ctx, func(content *Content) {
+		content.Session = &session
+	})
+}
+
+func (o *State) SetApplicationStatus(ctx context.Context, status api.ApplicationStatus) error {
+	return o.update(ctx, func(content *Content) {
+		content.ApplicationStatus = &status
+	})
+}
+
+func (o *State) GetApplicationStatus(ctx context.Context) ([]api.ApplicationStatus, error) {
+	var (
+		result    []api.ApplicationStatus
+		platforms []string
+		platform  = fcontext.GetPlatform(ctx, "")
+	)
+	if platform == "" {
+		platforms = []string{commonflags.PlatformCluster, commonflags.PlatformPodman, commonflags.PlatformDocker}
+	} else {
+		platforms = []string{platform}
+	}
+
+	for _, platform = range platforms {
+		content, err := o.read(platform)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue // if the state file does not exist, no application is running
+			}
+			return nil, err
+		}
+		if content.ApplicationStatus == nil {
+			continue
+		}
+		status := *content.ApplicationStatus
+		status.Platform = platform
+		result = append(result, status)
+	}
+	return result, nil
+}
+
+func (o *State) SetPodmanConnection(ctx context.Context, connection string) error {
+	return o.update(ctx, func(content *Content) {
+		content.PodmanConnection = connection
+	})
+}
+
+func (o *State) GetPodmanConnection(ctx context.Context) (string, bool, error) {
+	re := regexp.MustCompile(`^devstate\.[0-9]*\.json$`)
+	entries, err := o.fs.ReadDir(_dirpath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			// No file found => no session running on podman
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	for _, entry := range entries {
+		if !re.MatchString(entry.Name()) {
+			continue
+		}
+		jsonContent, err := o.fs.ReadFile(filepath.Join(_dirpath, entry.Name()))
+		if err != nil {
+			return "", false, err
+		}
+		var content Content
+		// Ignore error, to handle empty file
+		_ = json.Unmarshal(jsonContent, &content)
+		if content.Platform != commonflags.PlatformPodman {
+			continue
+		}
+		running, err := o.isOdoProcessRunning(content.PID)
+		if err != nil {
+			return "", false, err
+		}
+		if !running {
+			klog.V(4).Infof("session of process %d is not running anymore, ignoring its podman connection", content.PID)
+			continue
+		}
+		return content.PodmanConnection, true, nil
+	}
+	return "", false, nil
+}
+
+func (o *State) GetPreviousSession(ctx context.Context) (*Content, error) {
 	var (
 		pid      = odocontext.GetPID(ctx)
 		platform = fcontext.GetPlatform(ctx, commonflags.PlatformCluster)
 	)
+	re := regexp.MustCompile(`^devstate\.[0-9]*\.json$`)
+	entries, err := o.fs.ReadDir(_dirpath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			// No file found => no previous session
+			return nil, nil
+		}
+		return nil, err
+	}
+	for _, entry := range entries {
+		if !re.MatchString(entry.Name()) {
+			continue
+		}
+		jsonContent, err := o.fs.ReadFile(filepath.Join(_dirpath, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		var content Content
+		// Ignore error, to handle empty file
+		_ = json.Unmarshal(jsonContent, &content)
+		if content.Platform != platform || content.PID == pid || content.Session == nil {
+			continue
+		}
+		running, err := o.isOdoProcessRunning(content.PID)
+		if err != nil {
+			return nil, err
+		}
+		if running {
+			klog.V(4).Infof("session of process %d is still active, ignoring", content.PID)
+			continue
+		}
+		return &content, nil
+	}
+	return nil, nil
+}
 
-	o.content.APIServerPort = port
+func (o *State) DeletePreviousSession(ctx context.Context, pid int) error {
+	if pid == odocontext.GetPID(ctx) {
+		return fmt.Errorf("cannot delete the state file of the current process %d", pid)
+	}
+	running, err := o.isOdoProcessRunning(pid)
+	if err != nil {
+		return err
+	}
+	if running {
+		return fmt.Errorf("process %d is still running", pid)
+	}
+	err = o.delete(pid)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// update reads the content of the state file of the current process (if it exists),
+// applies the change on it, and saves it back to the file
+func (o *State) update(ctx context.Context, change func(content *Content)) error {
+	var (
+		pid      = odocontext.GetPID(ctx)
+		platform = fcontext.GetPlatform(ctx, commonflags.PlatformCluster)
+	)
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	content, err := o.readPid(pid)
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	} else {
+		o.content = content
+	}
+	change(&o.content)
+	o.content.PID = pid
 	o.content.Platform = platform
 	return o.save(ctx, pid)
 }
@@ -118,7 +315,7 @@ func (o *State) GetAPIServerPorts(ctx context.Context) ([]api.DevControlPlane, e
 		platform  = fcontext.GetPlatform(ctx, "")
 	)
 	if platform == "" {
-		platforms = []string{commonflags.PlatformCluster, commonflags.PlatformPodman}
+		platforms = []string{commonflags.PlatformCluster, commonflags.PlatformPodman, commonflags.PlatformDocker}
 	} else {
 		platforms = []string{platform}
 	}
@@ -210,6 +407,32 @@ func (o *State) read(platform string) (Content, error) {
 	return content, nil
 }
 
+// readPid returns the content of the devstate.${PID}.json file for the given pid
+func (o *State) readPid(pid int) (Content, error) {
+	var content Content
+	jsonContent, err := o.fs.ReadFile(getFilename(pid))
+	if err != nil {
+		return Content{}, err
+	}
+	// Ignore error, to handle empty file
+	_ = json.Unmarshal(jsonContent, &content)
+	return content, nil
+}
+
+// isOdoProcessRunning returns true if a process with the given pid exists and is an odo process
+func (o *State) isOdoProcessRunning(pid int) (bool, error) {
+	exists, err := o.system.PidExists(pid)
+	if err != nil || !exists {
+		return false, err
+	}
+	process, err := o.system.FindProcess(pid)
+	if err != nil || process == nil {
+		klog.V(4).Infof("process %d exists but is not accessible", pid)
+		return false, nil
+	}
+	return process.Executable() == "odo" || process.Executable() == "odo.exe", nil
+}
+
 func (o *State) delete(pid int) error {
 	return o.fs.Remove(getFilename(pid))
 }
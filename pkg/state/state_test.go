@@ -9,8 +9,11 @@ import (
 	"github.com/google/go-cmp/cmp"
 
 	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	fcontext "github.com/redhat-developer/odo/pkg/odo/commonflags/context"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
 	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+	"github.com/redhat-developer/odo/pkg/testingutil/system"
 )
 
 func TestState_SetForwardedPorts(t *testing.T) {
@@ -91,6 +94,111 @@ func TestState_SetForwardedPorts(t *testing.T) {
 	}
 }
 
+func TestState_DynamicForwardedPorts(t *testing.T) {
+	startupPort := api.ForwardedPort{
+		ContainerName: "acontainer",
+		LocalAddress:  "127.0.0.1",
+		LocalPort:     20001,
+		ContainerPort: 3000,
+	}
+	newStartupPort := api.ForwardedPort{
+		ContainerName: "acontainer",
+		LocalAddress:  "127.0.0.1",
+		LocalPort:     20002,
+		ContainerPort: 3000,
+	}
+	dynamicPort1 := api.ForwardedPort{
+		ContainerName: "acontainer",
+		LocalAddress:  "127.0.0.1",
+		LocalPort:     20010,
+		ContainerPort: 9229,
+	}
+	dynamicPort2 := api.ForwardedPort{
+		ContainerName: "tools",
+		LocalAddress:  "127.0.0.1",
+		LocalPort:     20011,
+		ContainerPort: 5432,
+	}
+	dynamic := func(fwPort api.ForwardedPort) api.ForwardedPort {
+		fwPort.IsDynamic = true
+		return fwPort
+	}
+	tests := []struct {
+		name   string
+		change func(ctx context.Context, o *State) error
+		want   []api.ForwardedPort
+	}{
+		{
+			name: "add dynamic ports",
+			change: func(ctx context.Context, o *State) error {
+				if err := o.AddForwardedPort(ctx, dynamicPort1); err != nil {
+					return err
+				}
+				return o.AddForwardedPort(ctx, dynamicPort2)
+			},
+			want: []api.ForwardedPort{startupPort, dynamic(dynamicPort1), dynamic(dynamicPort2)},
+		},
+		{
+			name: "setting forwarded ports keeps dynamic ports",
+			change: func(ctx context.Context, o *State) error {
+				if err := o.AddForwardedPort(ctx, dynamicPort1); err != nil {
+					return err
+				}
+				return o.SetForwardedPorts(ctx, []api.ForwardedPort{newStartupPort})
+			},
+			want: []api.ForwardedPort{newStartupPort, dynamic(dynamicPort1)},
+		},
+		{
+			name: "remove dynamic port",
+			change: func(ctx context.Context, o *State) error {
+				if err := o.AddForwardedPort(ctx, dynamicPort1); err != nil {
+					return err
+				}
+				if err := o.AddForwardedPort(ctx, dynamicPort2); err != nil {
+					return err
+				}
+				return o.RemoveForwardedPort(ctx, dynamicPort1.LocalPort)
+			},
+			want: []api.ForwardedPort{startupPort, dynamic(dynamicPort2)},
+		},
+		{
+			name: "remove does not remove ports forwarded at startup",
+			change: func(ctx context.Context, o *State) error {
+				return o.RemoveForwardedPort(ctx, startupPort.LocalPort)
+			},
+			want: []api.ForwardedPort{startupPort},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := filesystem.NewFakeFs()
+			o := State{
+				fs: fs,
+			}
+			ctx := context.Background()
+			ctx = odocontext.WithPID(ctx, 1)
+			if err := o.SetForwardedPorts(ctx, []api.ForwardedPort{startupPort}); err != nil {
+				t.Fatal(err)
+			}
+			if err := tt.change(ctx, &o); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			jsonContent, err := fs.ReadFile(_filepath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var content Content
+			err = json.Unmarshal(jsonContent, &content)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.want, content.ForwardedPorts); diff != "" {
+				t.Errorf("forwarded ports mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestState_SaveExit(t *testing.T) {
 	type fields struct {
 		fs                  func() filesystem.Filesystem
@@ -281,3 +389,265 @@ func TestState_GetForwardedPorts(t *testing.T) {
 		})
 	}
 }
+
+func TestState_SetSession(t *testing.T) {
+	forwardedPort1 := api.ForwardedPort{
+		ContainerName: "acontainer",
+		LocalAddress:  "localhost",
+		LocalPort:     20001,
+		ContainerPort: 3000,
+	}
+	session := Session{
+		DevfileHash:         "abcdef",
+		RunCommand:          "run",
+		PostStartEventsDone: true,
+		RunExecuted:         true,
+		PodName:             "apod",
+		SyncIndexDigest:     "123456",
+	}
+
+	fs := filesystem.NewFakeFs()
+	ctx := context.Background()
+	ctx = odocontext.WithPID(ctx, 1)
+
+	// Ports and session are set from different instances of the client, to check that the content is merged
+	err := NewStateClient(fs, nil).SetForwardedPorts(ctx, []api.ForwardedPort{forwardedPort1})
+	if err != nil {
+		t.Fatalf("State.SetForwardedPorts() error = %v", err)
+	}
+	err = NewStateClient(fs, nil).SetSession(ctx, session)
+	if err != nil {
+		t.Fatalf("State.SetSession() error = %v", err)
+	}
+
+	jsonContent, err := fs.ReadFile(getFilename(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var content Content
+	err = json.Unmarshal(jsonContent, &content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]api.ForwardedPort{forwardedPort1}, content.ForwardedPorts); diff != "" {
+		t.Errorf("forwarded ports mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(&session, content.Session); diff != "" {
+		t.Errorf("session mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestState_SetApplicationStatus(t *testing.T) {
+	status := api.ApplicationStatus{
+		Ready: true,
+		Errors: []api.ContainerLogLine{
+			{
+				Container: "runtime",
+				Mode:      "Dev",
+				Message:   "ERROR connection refused",
+			},
+		},
+	}
+
+	fs := filesystem.NewFakeFs()
+	ctx := context.Background()
+	ctx = odocontext.WithPID(ctx, 1)
+
+	err := NewStateClient(fs, nil).SetApplicationStatus(ctx, status)
+	if err != nil {
+		t.Fatalf("State.SetApplicationStatus() error = %v", err)
+	}
+
+	got, err := NewStateClient(fs, nil).GetApplicationStatus(ctx)
+	if err != nil {
+		t.Fatalf("State.GetApplicationStatus() error = %v", err)
+	}
+	want := status
+	want.Platform = "cluster"
+	if diff := cmp.Diff([]api.ApplicationStatus{want}, got); diff != "" {
+		t.Errorf("State.GetApplicationStatus() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestState_SetPodmanConnection(t *testing.T) {
+	fs := filesystem.NewFakeFs()
+	ctx := context.Background()
+	ctx = odocontext.WithPID(ctx, 1)
+	sys := system.Fake{PidTable: map[int]string{1: "odo"}}
+
+	_, found, err := NewStateClient(fs, sys).GetPodmanConnection(ctx)
+	if err != nil {
+		t.Fatalf("State.GetPodmanConnection() error = %v", err)
+	}
+	if found {
+		t.Errorf("State.GetPodmanConnection() should not find a session before the connection is set")
+	}
+
+	podmanCtx := fcontext.WithPlatform(ctx, commonflags.PlatformPodman)
+	err = NewStateClient(fs, sys).SetPodmanConnection(podmanCtx, "podman-machine-default-root")
+	if err != nil {
+		t.Fatalf("State.SetPodmanConnection() error = %v", err)
+	}
+
+	got, found, err := NewStateClient(fs, sys).GetPodmanConnection(ctx)
+	if err != nil {
+		t.Fatalf("State.GetPodmanConnection() error = %v", err)
+	}
+	if !found || got != "podman-machine-default-root" {
+		t.Errorf("State.GetPodmanConnection() = %q, %v, want %q, true", got, found, "podman-machine-default-root")
+	}
+
+	// the connection of a session whose process is not running anymore is ignored
+	sys.PidTable = nil
+	_, found, err = NewStateClient(fs, sys).GetPodmanConnection(ctx)
+	if err != nil {
+		t.Fatalf("State.GetPodmanConnection() error = %v", err)
+	}
+	if found {
+		t.Errorf("State.GetPodmanConnection() should not find the session of a terminated process")
+	}
+}
+
+func TestState_GetPreviousSession(t *testing.T) {
+	session := Session{
+		DevfileHash: "abcdef",
+		PodName:     "apod",
+	}
+	writeContent := func(t *testing.T, fs filesystem.Filesystem, pid int, content Content) {
+		jsonContent, err := json.Marshal(content)
+		if err != nil {
+			t.Errorf("Error marshaling data")
+		}
+		err = fs.WriteFile(getFilename(pid), jsonContent, 0644)
+		if err != nil {
+			t.Errorf("Error saving content to file")
+		}
+	}
+
+	tests := []struct {
+		name     string
+		fs       func(t *testing.T) filesystem.Filesystem
+		pidTable map[int]string
+		want     *Content
+	}{
+		{
+			name: "no state file",
+			fs: func(t *testing.T) filesystem.Filesystem {
+				return filesystem.NewFakeFs()
+			},
+			want: nil,
+		},
+		{
+			name: "session of a terminated process on the same platform",
+			fs: func(t *testing.T) filesystem.Filesystem {
+				fs := filesystem.NewFakeFs()
+				writeContent(t, fs, 2, Content{PID: 2, Platform: "cluster", Session: &session})
+				return fs
+			},
+			want: &Content{PID: 2, Platform: "cluster", Session: &session},
+		},
+		{
+			name: "session of a running odo process on the same platform",
+			fs: func(t *testing.T) filesystem.Filesystem {
+				fs := filesystem.NewFakeFs()
+				writeContent(t, fs, 2, Content{PID: 2, Platform: "cluster", Session: &session})
+				return fs
+			},
+			pidTable: map[int]string{2: "odo"},
+			want:     nil,
+		},
+		{
+			name: "session of a terminated process on another platform",
+			fs: func(t *testing.T) filesystem.Filesystem {
+				fs := filesystem.NewFakeFs()
+				writeContent(t, fs, 2, Content{PID: 2, Platform: "podman", Session: &session})
+				return fs
+			},
+			want: nil,
+		},
+		{
+			name: "state of a terminated process without session",
+			fs: func(t *testing.T) filesystem.Filesystem {
+				fs := filesystem.NewFakeFs()
+				writeContent(t, fs, 2, Content{PID: 2, Platform: "cluster"})
+				return fs
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := NewStateClient(tt.fs(t), system.Fake{PidTable: tt.pidTable})
+			ctx := context.Background()
+			ctx = odocontext.WithPID(ctx, 1)
+			got, err := o.GetPreviousSession(ctx)
+			if err != nil {
+				t.Errorf("State.GetPreviousSession() error = %v", err)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("State.GetPreviousSession() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestState_DeletePreviousSession(t *testing.T) {
+	tests := []struct {
+		name     string
+		pid      int
+		pidTable map[int]string
+		wantErr  bool
+		// wantExists indicates if the state file of the terminated process 2 is expected to exist
+		wantExists bool
+	}{
+		{
+			name:       "state of a terminated process is removed",
+			pid:        2,
+			wantExists: false,
+		},
+		{
+			name:       "state of a running odo process is kept",
+			pid:        2,
+			pidTable:   map[int]string{2: "odo"},
+			wantErr:    true,
+			wantExists: true,
+		},
+		{
+			name:       "state of the current process is kept",
+			pid:        1,
+			wantErr:    true,
+			wantExists: true,
+		},
+		{
+			name:       "no state file for the process",
+			pid:        3,
+			wantExists: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := filesystem.NewFakeFs()
+			for _, pid := range []int{1, 2} {
+				err := fs.WriteFile(getFilename(pid), []byte("{}"), 0644)
+				if err != nil {
+					t.Fatalf("Error saving content to file")
+				}
+			}
+			o := NewStateClient(fs, system.Fake{PidTable: tt.pidTable})
+			ctx := context.Background()
+			ctx = odocontext.WithPID(ctx, 1)
+			err := o.DeletePreviousSession(ctx, tt.pid)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("State.DeletePreviousSession() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			_, err = fs.Stat(getFilename(2))
+			if exists := err == nil; exists != tt.wantExists {
+				t.Errorf("state file of process 2 exists = %v, want %v", exists, tt.wantExists)
+			}
+			if _, err = fs.Stat(getFilename(1)); err != nil {
+				t.Errorf("state file of the current process should be kept: %v", err)
+			}
+		})
+	}
+}
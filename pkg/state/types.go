@@ -1,6 +1,8 @@
 package state
 
 import (
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+
 	"github.com/redhat-developer/odo/pkg/api"
 )
 
@@ -12,4 +14,32 @@ type Content struct {
 	// ForwardedPorts are the ports forwarded during odo dev session
 	ForwardedPorts []api.ForwardedPort `json:"forwardedPorts"`
 	APIServerPort  int                 `json:"apiServerPort,omitempty"`
+	// Session is the state of the component managed by the odo dev session
+	Session *Session `json:"session,omitempty"`
+	// ApplicationStatus is the status of the application, as reported by its logs
+	ApplicationStatus *api.ApplicationStatus `json:"applicationStatus,omitempty"`
+	// PodmanConnection is the connection to the Podman service used by the session on the podman platform,
+	// empty for the default connection
+	PodmanConnection string `json:"podmanConnection,omitempty"`
+}
+
+// Session describes the state of a component managed by an odo dev session,
+// used to reattach to a still running component after the session is interrupted
+type Session struct {
+	// DevfileHash is the hash of the effective Devfile used to deploy the component
+	DevfileHash string `json:"devfileHash"`
+	// BuildCommand is the name of the build command executed, if any
+	BuildCommand string `json:"buildCommand,omitempty"`
+	// RunCommand is the name of the run or debug command executed, if any
+	RunCommand string `json:"runCommand,omitempty"`
+	// PostStartEventsDone is true when the postStart events have been executed
+	PostStartEventsDone bool `json:"postStartEventsDone"`
+	// RunExecuted is true when the run command has been executed
+	RunExecuted bool `json:"runExecuted"`
+	// ImageComponentsAutoApplied are the image components already applied by the session
+	ImageComponentsAutoApplied map[string]v1alpha2.ImageComponent `json:"imageComponentsAutoApplied,omitempty"`
+	// PodName is the name of the pod running the component
+	PodName string `json:"podName"`
+	// SyncIndexDigest is the digest of the file index after the last sync of files into the component
+	SyncIndexDigest string `json:"syncIndexDigest,omitempty"`
 }
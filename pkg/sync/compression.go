@@ -0,0 +1,151 @@
+package sync
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	gosync "sync"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/machineoutput"
+	"github.com/redhat-developer/odo/pkg/preference"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// compressionCache stores the compression negotiated with each container
+type compressionCache struct {
+	mu gosync.Mutex
+	// compressions is indexed by the pod and container names
+	compressions map[string]string
+}
+
+func newCompressionCache() *compressionCache {
+	return &compressionCache{
+		compressions: make(map[string]string),
+	}
+}
+
+// getCompressionCandidates returns the compressions to probe in the container for the preference value
+func getCompressionCandidates(pref string) []string {
+	switch pref {
+	case preference.SyncCompressionAuto:
+		return []string{preference.SyncCompressionZstd, preference.SyncCompressionGzip}
+	case preference.SyncCompressionZstd, preference.SyncCompressionGzip:
+		return []string{pref}
+	default:
+		return nil
+	}
+}
+
+// getCmdToProbeDecompressors returns the command used to list the decompressors available in the container,
+// among the candidates
+func getCmdToProbeDecompressors(candidates []string) []string {
+	return []string{"sh", "-c", fmt.Sprintf("for c in %s; do command -v $c >/dev/null 2>&1 && echo $c; done; true", strings.Join(candidates, " "))}
+}
+
+// getCmdToExtract returns the command used to extract an archive, compressed with compression, to targetPath in the container
+func getCmdToExtract(compression string, targetPath string) []string {
+	if compression == preference.SyncCompressionNone {
+		return []string{"tar", "xf", "-", "-C", targetPath, "--no-same-owner"}
+	}
+	return []string{"sh", "-c", fmt.Sprintf("%s -dc | tar xf - -C %s --no-same-owner", compression, util.ShellQuote(targetPath))}
+}
+
+// negotiateCompression returns the compression to use to transfer files to the container,
+// depending on the preference and on the decompressors available in the container
+func (a SyncClient) negotiateCompression(ctx context.Context, containerName, podName string) string {
+	if a.preferenceClient == nil || a.compressions == nil {
+		return preference.SyncCompressionNone
+	}
+
+	key := podName + "/" + containerName
+	a.compressions.mu.Lock()
+	defer a.compressions.mu.Unlock()
+	if compression, ok := a.compressions.compressions[key]; ok {
+		return compression
+	}
+
+	compression := preference.SyncCompressionNone
+	candidates := getCompressionCandidates(a.preferenceClient.GetSyncCompression())
+	if len(candidates) > 0 {
+		var stdout, stderr bytes.Buffer
+		cmdArr := getCmdToProbeDecompressors(candidates)
+		err := a.platformClient.ExecCMDInContainer(ctx, containerName, podName, cmdArr, &stdout, &stderr, nil, false)
+		if err != nil {
+			klog.V(2).Infof("unable to probe decompressors in container %q, syncing files without compression: %v", containerName, err)
+		} else {
+			available := strings.Fields(stdout.String())
+			if len(available) > 0 {
+				compression = available[0]
+			} else {
+				klog.V(2).Infof("none of %v is available in container %q, syncing files without compression", candidates, containerName)
+			}
+		}
+	}
+	a.compressions.compressions[key] = compression
+	return compression
+}
+
+// disableCompression disables the compression for next transfers to the container
+func (a SyncClient) disableCompression(containerName, podName string) {
+	if a.compressions == nil {
+		return
+	}
+	a.compressions.mu.Lock()
+	defer a.compressions.mu.Unlock()
+	a.compressions.compressions[podName+"/"+containerName] = preference.SyncCompressionNone
+}
+
+// countingReader counts the bytes read from the underlying reader
+type countingReader struct {
+	reader io.Reader
+	count  int64
+}
+
+func (o *countingReader) Read(p []byte) (int, error) {
+	n, err := o.reader.Read(p)
+	o.count += int64(n)
+	return n, err
+}
+
+// newCompressWriter returns a writer compressing with compression the data written to w
+func newCompressWriter(compression string, w io.Writer) (io.WriteCloser, error) {
+	switch compression {
+	case preference.SyncCompressionGzip:
+		return gzip.NewWriterLevel(w, gzip.BestSpeed)
+	case preference.SyncCompressionZstd:
+		return zstd.NewWriter(w, zstd.WithEncoderLevel(zstd.SpeedFastest))
+	default:
+		return nil, fmt.Errorf("unsupported compression %q", compression)
+	}
+}
+
+// compressStream returns a reader of the content of stdin compressed with compression
+func compressStream(compression string, stdin io.Reader) *io.PipeReader {
+	reader, writer := io.Pipe()
+	go func() {
+		compressWriter, err := newCompressWriter(compression, writer)
+		if err != nil {
+			_ = writer.CloseWithError(err)
+			return
+		}
+		_, err = io.Copy(compressWriter, stdin)
+		if closeErr := compressWriter.Close(); err == nil {
+			err = closeErr
+		}
+		_ = writer.CloseWithError(err)
+	}()
+	return reader
+}
+
+// reportSyncStatistics displays the statistics of a transfer of files in the verbose output and the machine-readable events
+func reportSyncStatistics(compression string, uncompressed, transferred int64, duration time.Duration) {
+	klog.V(2).Infof("Synced %d bytes (%d bytes transferred, compression: %s) in %s", uncompressed, transferred, compression, duration)
+	machineoutput.NewMachineEventLoggingClient().SyncStatistics(compression, uncompressed, transferred, duration, machineoutput.TimestampNow())
+}
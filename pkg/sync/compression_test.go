@@ -0,0 +1,209 @@
+package sync
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/redhat-developer/odo/pkg/platform"
+	"github.com/redhat-developer/odo/pkg/preference"
+)
+
+func Test_getCmdToExtract(t *testing.T) {
+	tests := []struct {
+		name        string
+		compression string
+		want        []string
+	}{
+		{
+			name:        "no compression",
+			compression: preference.SyncCompressionNone,
+			want:        []string{"tar", "xf", "-", "-C", "/projects", "--no-same-owner"},
+		},
+		{
+			name:        "gzip compression",
+			compression: preference.SyncCompressionGzip,
+			want:        []string{"sh", "-c", "gzip -dc | tar xf - -C '/projects' --no-same-owner"},
+		},
+		{
+			name:        "zstd compression",
+			compression: preference.SyncCompressionZstd,
+			want:        []string{"sh", "-c", "zstd -dc | tar xf - -C '/projects' --no-same-owner"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := getCmdToExtract(tt.compression, "/projects")
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("getCmdToExtract() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSyncClient_negotiateCompression(t *testing.T) {
+	tests := []struct {
+		name           string
+		pref           string
+		probeOutput    string
+		probeErr       error
+		wantCandidates string
+		want           string
+	}{
+		{
+			name:           "auto with zstd and gzip available",
+			pref:           preference.SyncCompressionAuto,
+			probeOutput:    "zstd\ngzip\n",
+			wantCandidates: "zstd gzip",
+			want:           preference.SyncCompressionZstd,
+		},
+		{
+			name:           "auto with only gzip available",
+			pref:           preference.SyncCompressionAuto,
+			probeOutput:    "gzip\n",
+			wantCandidates: "zstd gzip",
+			want:           preference.SyncCompressionGzip,
+		},
+		{
+			name:           "zstd not available",
+			pref:           preference.SyncCompressionZstd,
+			probeOutput:    "",
+			wantCandidates: "zstd",
+			want:           preference.SyncCompressionNone,
+		},
+		{
+			name:           "probe failing",
+			pref:           preference.SyncCompressionAuto,
+			probeErr:       errors.New("sh not found"),
+			wantCandidates: "zstd gzip",
+			want:           preference.SyncCompressionNone,
+		},
+		{
+			name: "compression disabled",
+			pref: preference.SyncCompressionNone,
+			want: preference.SyncCompressionNone,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			prefClient := preference.NewMockClient(ctrl)
+			prefClient.EXPECT().GetSyncCompression().Return(tt.pref).Times(1)
+			platformClient := platform.NewMockClient(ctrl)
+			if tt.wantCandidates != "" {
+				// The probe is executed only once for the container
+				platformClient.EXPECT().ExecCMDInContainer(gomock.Any(), "runtime", "pod", getCmdToProbeDecompressors(strings.Fields(tt.wantCandidates)), gomock.Any(), gomock.Any(), nil, false).
+					DoAndReturn(func(_ context.Context, _, _ string, _ []string, stdout, _ io.Writer, _ io.Reader, _ bool) error {
+						_, _ = stdout.Write([]byte(tt.probeOutput))
+						return tt.probeErr
+					}).Times(1)
+			}
+
+			a := NewSyncClient(platformClient, nil, prefClient)
+			for i := 0; i < 2; i++ {
+				got := a.negotiateCompression(context.Background(), "runtime", "pod")
+				if got != tt.want {
+					t.Errorf("negotiateCompression() = %q, want %q", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func Test_compressStream(t *testing.T) {
+	content := strings.Repeat("some text content\n", 1000)
+	tests := []struct {
+		name       string
+		compressed string
+		decompress func(io.Reader) (io.Reader, error)
+	}{
+		{
+			name:       "gzip",
+			compressed: preference.SyncCompressionGzip,
+			decompress: func(r io.Reader) (io.Reader, error) {
+				return gzip.NewReader(r)
+			},
+		},
+		{
+			name:       "zstd",
+			compressed: preference.SyncCompressionZstd,
+			decompress: func(r io.Reader) (io.Reader, error) {
+				return zstd.NewReader(r)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var compressed bytes.Buffer
+			_, err := io.Copy(&compressed, compressStream(tt.compressed, strings.NewReader(content)))
+			if err != nil {
+				t.Fatalf("compressStream() error = %v", err)
+			}
+			if compressed.Len() >= len(content) {
+				t.Errorf("compressStream() returned %d bytes, expected less than %d", compressed.Len(), len(content))
+			}
+			reader, err := tt.decompress(&compressed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := io.ReadAll(reader)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != content {
+				t.Errorf("decompressed content differs from the original content")
+			}
+		})
+	}
+}
+
+func TestSyncClient_ExtractProjectToComponent_decompressorFailing(t *testing.T) {
+	const content = "content of the archive"
+	ctrl := gomock.NewController(t)
+	prefClient := preference.NewMockClient(ctrl)
+	prefClient.EXPECT().GetSyncCompression().Return(preference.SyncCompressionGzip)
+	platformClient := platform.NewMockClient(ctrl)
+	platformClient.EXPECT().ExecCMDInContainer(gomock.Any(), "runtime", "pod", getCmdToProbeDecompressors([]string{"gzip"}), gomock.Any(), gomock.Any(), nil, false).
+		DoAndReturn(func(_ context.Context, _, _ string, _ []string, stdout, _ io.Writer, _ io.Reader, _ bool) error {
+			_, _ = stdout.Write([]byte("gzip\n"))
+			return nil
+		})
+	gomock.InOrder(
+		// gzip is found, but fails to decompress
+		platformClient.EXPECT().ExecCMDInContainer(gomock.Any(), "runtime", "pod", getCmdToExtract(preference.SyncCompressionGzip, "/projects"), gomock.Any(), gomock.Any(), gomock.Any(), false).
+			DoAndReturn(func(_ context.Context, _, _ string, _ []string, _, stderr io.Writer, stdin io.Reader, _ bool) error {
+				_, _ = io.ReadFull(stdin, make([]byte, 4))
+				_, _ = stderr.Write([]byte("gzip: invalid option"))
+				return errors.New("exit status 1")
+			}),
+		// the same files are transferred again without compression
+		platformClient.EXPECT().ExecCMDInContainer(gomock.Any(), "runtime", "pod", getCmdToExtract(preference.SyncCompressionNone, "/projects"), gomock.Any(), gomock.Any(), gomock.Any(), false).
+			DoAndReturn(func(_ context.Context, _, _ string, _ []string, _, _ io.Writer, stdin io.Reader, _ bool) error {
+				got, err := io.ReadAll(stdin)
+				if err != nil || string(got) != content {
+					t.Errorf("received %q, want %q", got, content)
+				}
+				return nil
+			}),
+	)
+
+	a := NewSyncClient(platformClient, nil, prefClient)
+	newArchive := func() io.ReadCloser {
+		return io.NopCloser(strings.NewReader(content))
+	}
+	err := a.ExtractProjectToComponent(context.Background(), "runtime", "pod", "/projects", newArchive)
+	if err != nil {
+		t.Fatalf("ExtractProjectToComponent() unexpected error: %v", err)
+	}
+	if got := a.negotiateCompression(context.Background(), "runtime", "pod"); got != preference.SyncCompressionNone {
+		t.Errorf("compression should be disabled for the next syncs, got %q", got)
+	}
+}
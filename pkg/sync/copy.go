@@ -4,14 +4,17 @@ import (
 	taro "archive/tar"
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/preference"
 	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
 	"github.com/redhat-developer/odo/pkg/util"
 
@@ -34,19 +37,22 @@ func (a SyncClient) CopyFile(ctx context.Context, localPath string, compInfo Com
 	targetPath = filepath.ToSlash(targetPath)
 
 	klog.V(4).Infof("CopyFile arguments: localPath %s, dest %s, targetPath %s, copyFiles %s, globalExps %s", localPath, dest, targetPath, copyFiles, globExps)
-	reader, writer := io.Pipe()
-	// inspired from https://github.com/kubernetes/kubernetes/blob/master/pkg/kubectl/cmd/cp.go#L235
-	go func() {
-		defer writer.Close()
-
-		err := makeTar(localPath, dest, writer, copyFiles, globExps, ret, filesystem.DefaultFs{})
-		if err != nil {
-			log.Errorf("Error while creating tar: %#v", err)
-			os.Exit(1)
-		}
-	}()
+	newArchive := func() io.ReadCloser {
+		reader, writer := io.Pipe()
+		// inspired from https://github.com/kubernetes/kubernetes/blob/master/pkg/kubectl/cmd/cp.go#L235
+		go func() {
+			defer writer.Close()
+
+			err := makeTar(localPath, dest, writer, copyFiles, globExps, ret, filesystem.DefaultFs{})
+			if err != nil && !errors.Is(err, io.ErrClosedPipe) {
+				log.Errorf("Error while creating tar: %#v", err)
+				os.Exit(1)
+			}
+		}()
+		return reader
+	}
 
-	err := a.ExtractProjectToComponent(ctx, compInfo.ContainerName, compInfo.PodName, targetPath, reader)
+	err := a.ExtractProjectToComponent(ctx, compInfo.ContainerName, compInfo.PodName, targetPath, newArchive)
 	if err != nil {
 		return err
 	}
@@ -54,14 +60,53 @@ func (a SyncClient) CopyFile(ctx context.Context, localPath string, compInfo Com
 	return nil
 }
 
-// ExtractProjectToComponent extracts the project archive(tar) to the target path from the reader stdin
-func (a SyncClient) ExtractProjectToComponent(ctx context.Context, containerName, podName, targetPath string, stdin io.Reader) error {
+// ExtractProjectToComponent extracts the project archive(tar) returned by newArchive to the target path.
+// The archive is compressed during the transfer if the container supports it. If the extraction of the compressed archive fails,
+// for example because the decompressor does not work in the container, a new archive is transferred again without compression.
+func (a SyncClient) ExtractProjectToComponent(ctx context.Context, containerName, podName, targetPath string, newArchive func() io.ReadCloser) error {
+	compression := a.negotiateCompression(ctx, containerName, podName)
+	if compression == preference.SyncCompressionNone {
+		return a.extractArchive(ctx, containerName, podName, targetPath, compression, newArchive)
+	}
+
+	err := a.extractArchive(ctx, containerName, podName, targetPath, compression, newArchive)
+	if err == nil {
+		return nil
+	}
+	// Do not try again to use a decompressor which does not work in the container
+	a.disableCompression(containerName, podName)
+	klog.V(2).Infof("unable to extract the archive compressed with %s in container %q, syncing files without compression: %v", compression, containerName, err)
+	return a.extractArchive(ctx, containerName, podName, targetPath, preference.SyncCompressionNone, newArchive)
+}
+
+// extractArchive transfers a new archive returned by newArchive, compressed with compression, and extracts it to the target path.
+// The errors are displayed only if the archive is not compressed, as the transfer of a compressed archive is retried without compression.
+func (a SyncClient) extractArchive(ctx context.Context, containerName, podName, targetPath, compression string, newArchive func() io.ReadCloser) error {
+	archive := newArchive()
+	// Unblock the creation of the archive if the command exits before reading all its input
+	defer archive.Close()
+
+	uncompressed := &countingReader{reader: archive}
+	transferred := uncompressed
+	if compression != preference.SyncCompressionNone {
+		compressed := compressStream(compression, uncompressed)
+		// Unblock the compression if the command exits before reading all its input
+		defer compressed.Close()
+		transferred = &countingReader{reader: compressed}
+	}
+
 	// cmdArr will run inside container
-	cmdArr := []string{"tar", "xf", "-", "-C", targetPath, "--no-same-owner"}
+	cmdArr := getCmdToExtract(compression, targetPath)
 	var stdout bytes.Buffer
 	var stderr bytes.Buffer
 	klog.V(3).Infof("Executing command %s", strings.Join(cmdArr, " "))
-	err := a.platformClient.ExecCMDInContainer(ctx, containerName, podName, cmdArr, &stdout, &stderr, stdin, false)
+	start := time.Now()
+	err := a.platformClient.ExecCMDInContainer(ctx, containerName, podName, cmdArr, &stdout, &stderr, transferred, false)
+	if err == nil {
+		reportSyncStatistics(compression, uncompressed.count, transferred.count, time.Since(start))
+	} else if compression != preference.SyncCompressionNone {
+		return fmt.Errorf("%w (stderr: %s)", err, stderr.String())
+	}
 	if err != nil {
 		log.Errorf("Command '%s' in container failed.\n", strings.Join(cmdArr, " "))
 		log.Errorf("stdout: %s\n", stdout.String())
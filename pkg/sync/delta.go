@@ -0,0 +1,260 @@
+package sync
+
+import (
+	taro "archive/tar"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	gopath "path"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+const (
+	// deltaBlockSize is the size of the blocks compared between two syncs of a file
+	deltaBlockSize = 128 * 1024
+	// deltaMinFileSize is the minimal size of a file to be synced by sending only its changed blocks
+	deltaMinFileSize = 4 * 1024 * 1024
+)
+
+// deltaFile describes a file to be synced by sending only its changed blocks
+type deltaFile struct {
+	// localPath is the path of the file on the local filesystem
+	localPath string
+	// remotePath is the path of the file in the container
+	remotePath string
+	// size is the new size of the file
+	size int64
+	// blocks are the indexes of the blocks that changed since the last sync, in increasing order
+	blocks []int
+}
+
+// computeBlockHashes returns the hashes of the successive blocks of size deltaBlockSize of the file at path
+func computeBlockHashes(fs filesystem.Filesystem, path string) ([]string, error) {
+	f, err := fs.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close() // #nosec G307
+
+	var hashes []string
+	buf := make([]byte, deltaBlockSize)
+	for {
+		n, err := io.ReadFull(f, buf)
+		if n > 0 {
+			sum := sha256.Sum256(buf[:n])
+			hashes = append(hashes, hex.EncodeToString(sum[:]))
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return hashes, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// changedBlocks returns the indexes of the blocks in current which are new or different from the ones in previous
+func changedBlocks(previous, current []string) []int {
+	var result []int
+	for i := range current {
+		if i >= len(previous) || previous[i] != current[i] {
+			result = append(result, i)
+		}
+	}
+	return result
+}
+
+// deltaBlockName returns the name of the entry in the delta archive containing the block of the i-th file
+func deltaBlockName(i int, block int) string {
+	return fmt.Sprintf("%d.%d", i, block)
+}
+
+// getDeltaScript returns the shell script executed in the container to reassemble the files
+// from the blocks received in an archive on its standard input
+func getDeltaScript(files []deltaFile) string {
+	var script strings.Builder
+	script.WriteString("set -e\n")
+	script.WriteString("d=$(mktemp -d)\n")
+	script.WriteString("trap 'rm -rf \"$d\"' EXIT\n")
+	script.WriteString("tar xf - -C \"$d\" --no-same-owner\n")
+	for i, file := range files {
+		target := util.ShellQuote(file.remotePath)
+		for _, block := range file.blocks {
+			fmt.Fprintf(&script, "dd if=\"$d/%s\" of=%s bs=%d seek=%d conv=notrunc 2>/dev/null\n", deltaBlockName(i, block), target, deltaBlockSize, block)
+		}
+		// Copying nothing without conv=notrunc truncates the file at the new size
+		fmt.Fprintf(&script, "dd if=/dev/null of=%s bs=1 seek=%d 2>/dev/null\n", target, file.size)
+	}
+	return script.String()
+}
+
+// makeDeltaTar writes to writer an archive containing the changed blocks of the files
+func makeDeltaTar(files []deltaFile, writer io.Writer, fs filesystem.Filesystem) error {
+	tarWriter := taro.NewWriter(writer)
+	defer tarWriter.Close()
+
+	buf := make([]byte, deltaBlockSize)
+	for i, file := range files {
+		err := func() error {
+			f, err := fs.Open(file.localPath)
+			if err != nil {
+				return err
+			}
+			defer f.Close() // #nosec G307
+
+			wanted := make(map[int]bool, len(file.blocks))
+			for _, block := range file.blocks {
+				wanted[block] = true
+			}
+			for block := 0; len(wanted) > 0; block++ {
+				n, err := io.ReadFull(f, buf)
+				if n > 0 && wanted[block] {
+					err := tarWriter.WriteHeader(&taro.Header{
+						Name: deltaBlockName(i, block),
+						Mode: 0600,
+						Size: int64(n),
+					})
+					if err != nil {
+						return err
+					}
+					if _, err = tarWriter.Write(buf[:n]); err != nil {
+						return err
+					}
+					delete(wanted, block)
+				}
+				if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+					if len(wanted) > 0 {
+						return fmt.Errorf("file %s has been truncated during sync", file.localPath)
+					}
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		}()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// syncDelta sends the changed blocks of the files to the container, and reassembles the files in the container
+func (a SyncClient) syncDelta(ctx context.Context, compInfo ComponentInfo, files []deltaFile, fs filesystem.Filesystem) error {
+	reader, writer := io.Pipe()
+	go func() {
+		err := makeDeltaTar(files, writer, fs)
+		_ = writer.CloseWithError(err)
+	}()
+
+	cmdArr := []string{"sh", "-c", getDeltaScript(files)}
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	klog.V(3).Infof("Executing command %s", strings.Join(cmdArr, " "))
+	err := a.platformClient.ExecCMDInContainer(ctx, compInfo.ContainerName, compInfo.PodName, cmdArr, &stdout, &stderr, reader, false)
+	// Unblock the archive writer if the command exited before reading all its input
+	_ = reader.Close()
+	if err != nil {
+		return fmt.Errorf("unable to reassemble files in container: %w (stderr: %s)", err, stderr.String())
+	}
+	return nil
+}
+
+// pushDeltas syncs the changed blocks of the files, and falls back to copying the entire files if it fails
+func (a SyncClient) pushDeltas(ctx context.Context, path string, compInfo ComponentInfo, files []deltaFile, ret util.IndexerRet) error {
+	err := a.syncDelta(ctx, compInfo, files, filesystem.DefaultFs{})
+	if err == nil {
+		return nil
+	}
+	klog.V(2).Infof("unable to sync changed blocks only, copying entire files: %v", err)
+	localPaths := make([]string, 0, len(files))
+	for _, file := range files {
+		localPaths = append(localPaths, file.localPath)
+	}
+	return a.CopyFile(ctx, path, compInfo, compInfo.SyncFolder, localPaths, nil, ret)
+}
+
+// planDeltaSync splits the changed files between the files to be copied entirely and the large files for which
+// only the blocks changed since the previous sync are sent, based on the block hashes stored in previousIndex.
+// It also returns the new block hashes of the large files, indexed by their key in the index
+func planDeltaSync(path string, syncFolder string, changedFiles []string, previousIndex *util.FileIndex, fs filesystem.Filesystem) (
+	copyFiles []string,
+	deltas []deltaFile,
+	blockHashes map[string][]string,
+	err error,
+) {
+	blockHashes = make(map[string][]string)
+	for _, file := range changedFiles {
+		stat, err := fs.Stat(file)
+		if err != nil || !stat.Mode().IsRegular() || stat.Size() < deltaMinFileSize {
+			copyFiles = append(copyFiles, file)
+			continue
+		}
+		key, err := util.CalculateFileDataKeyFromPath(file, path)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		var previous util.FileData
+		if previousIndex != nil {
+			previous = previousIndex.Files[key]
+		}
+		if previous.RemoteAttribute != "" {
+			copyFiles = append(copyFiles, file)
+			continue
+		}
+		hashes, err := computeBlockHashes(fs, file)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		blockHashes[key] = hashes
+		blocks := changedBlocks(previous.BlockHashes, hashes)
+		// Sending more than half of the blocks is not worth the cost of reassembling the file
+		if len(previous.BlockHashes) == 0 || 2*len(blocks) > len(hashes) {
+			copyFiles = append(copyFiles, file)
+			continue
+		}
+		deltas = append(deltas, deltaFile{
+			localPath:  file,
+			remotePath: gopath.Join(filepath.ToSlash(syncFolder), filepath.ToSlash(key)),
+			size:       stat.Size(),
+			blocks:     blocks,
+		})
+	}
+	return copyFiles, deltas, blockHashes, nil
+}
+
+// storeBlockHashes stores the block hashes of the files into the index of the files synced from path
+func storeBlockHashes(path string, blockHashes map[string][]string) error {
+	if len(blockHashes) == 0 {
+		return nil
+	}
+	indexFilePath, err := util.ResolveIndexFilePath(path)
+	if err != nil {
+		return err
+	}
+	fileIndex, err := util.ReadFileIndex(indexFilePath)
+	if err != nil {
+		return err
+	}
+	for key, hashes := range blockHashes {
+		fileData, ok := fileIndex.Files[key]
+		if !ok {
+			continue
+		}
+		fileData.BlockHashes = hashes
+		fileIndex.Files[key] = fileData
+	}
+	return util.WriteFile(fileIndex.Files, indexFilePath)
+}
@@ -0,0 +1,227 @@
+package sync
+
+import (
+	taro "archive/tar"
+	"bytes"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+func Test_changedBlocks(t *testing.T) {
+	tests := []struct {
+		name     string
+		previous []string
+		current  []string
+		want     []int
+	}{
+		{
+			name:     "no change",
+			previous: []string{"a", "b", "c"},
+			current:  []string{"a", "b", "c"},
+			want:     nil,
+		},
+		{
+			name:     "block modified",
+			previous: []string{"a", "b", "c"},
+			current:  []string{"a", "B", "c"},
+			want:     []int{1},
+		},
+		{
+			name:     "blocks appended",
+			previous: []string{"a", "b"},
+			current:  []string{"a", "b", "c", "d"},
+			want:     []int{2, 3},
+		},
+		{
+			name:     "file truncated",
+			previous: []string{"a", "b", "c"},
+			current:  []string{"a", "B"},
+			want:     []int{1},
+		},
+		{
+			name:     "no previous hashes",
+			previous: nil,
+			current:  []string{"a", "b"},
+			want:     []int{0, 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := changedBlocks(tt.previous, tt.current)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("changedBlocks() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_getDeltaScript(t *testing.T) {
+	files := []deltaFile{
+		{
+			remotePath: "/projects/data.bin",
+			size:       300000,
+			blocks:     []int{0, 2},
+		},
+		{
+			remotePath: "/projects/it's.bin",
+			size:       10,
+			blocks:     []int{0},
+		},
+	}
+	want := `set -e
+d=$(mktemp -d)
+trap 'rm -rf "$d"' EXIT
+tar xf - -C "$d" --no-same-owner
+dd if="$d/0.0" of='/projects/data.bin' bs=131072 seek=0 conv=notrunc 2>/dev/null
+dd if="$d/0.2" of='/projects/data.bin' bs=131072 seek=2 conv=notrunc 2>/dev/null
+dd if=/dev/null of='/projects/data.bin' bs=1 seek=300000 2>/dev/null
+dd if="$d/1.0" of='/projects/it'\''s.bin' bs=131072 seek=0 conv=notrunc 2>/dev/null
+dd if=/dev/null of='/projects/it'\''s.bin' bs=1 seek=10 2>/dev/null
+`
+	got := getDeltaScript(files)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("getDeltaScript() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_makeDeltaTar(t *testing.T) {
+	fs := filesystem.NewFakeFs()
+	content := bytes.Repeat([]byte("a"), 2*deltaBlockSize+10)
+	content[deltaBlockSize] = 'b'
+	filePath := filepath.Join("tmp", "data.bin")
+	err := fs.WriteFile(filePath, content, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	err = makeDeltaTar([]deltaFile{{localPath: filePath, blocks: []int{1, 2}}}, &buf, fs)
+	if err != nil {
+		t.Fatalf("makeDeltaTar() error = %v", err)
+	}
+
+	got := map[string][]byte{}
+	reader := taro.NewReader(&buf)
+	for {
+		hdr, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := io.ReadAll(reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got[hdr.Name] = data
+	}
+	want := map[string][]byte{
+		"0.1": content[deltaBlockSize : 2*deltaBlockSize],
+		"0.2": content[2*deltaBlockSize:],
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("makeDeltaTar() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_planDeltaSync(t *testing.T) {
+	fs := filesystem.NewFakeFs()
+	dir := filepath.Join("tmp", "project")
+
+	small := filepath.Join(dir, "small.txt")
+	large := filepath.Join(dir, "large.bin")
+	largeContent := bytes.Repeat([]byte("a"), deltaMinFileSize)
+	for path, content := range map[string][]byte{
+		small: []byte("hello"),
+		large: largeContent,
+	} {
+		if err := fs.WriteFile(path, content, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	hashes, err := computeBlockHashes(fs, large)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nbBlocks := deltaMinFileSize / deltaBlockSize
+	if len(hashes) != nbBlocks {
+		t.Fatalf("computeBlockHashes() returned %d hashes, expected %d", len(hashes), nbBlocks)
+	}
+	modifiedHashes := append([]string{"modified"}, hashes[1:]...)
+	allModifiedHashes := make([]string, nbBlocks)
+
+	tests := []struct {
+		name          string
+		previousIndex *util.FileIndex
+		wantCopyFiles []string
+		wantDeltas    []deltaFile
+	}{
+		{
+			name:          "no previous index",
+			previousIndex: nil,
+			wantCopyFiles: []string{small, large},
+		},
+		{
+			name: "no previous hashes",
+			previousIndex: &util.FileIndex{Files: map[string]util.FileData{
+				"large.bin": {},
+			}},
+			wantCopyFiles: []string{small, large},
+		},
+		{
+			name: "one block changed",
+			previousIndex: &util.FileIndex{Files: map[string]util.FileData{
+				"large.bin": {BlockHashes: modifiedHashes},
+			}},
+			wantCopyFiles: []string{small},
+			wantDeltas: []deltaFile{
+				{
+					localPath:  large,
+					remotePath: "/projects/large.bin",
+					size:       deltaMinFileSize,
+					blocks:     []int{0},
+				},
+			},
+		},
+		{
+			name: "all blocks changed",
+			previousIndex: &util.FileIndex{Files: map[string]util.FileData{
+				"large.bin": {BlockHashes: allModifiedHashes},
+			}},
+			wantCopyFiles: []string{small, large},
+		},
+		{
+			name: "file with remote attribute",
+			previousIndex: &util.FileIndex{Files: map[string]util.FileData{
+				"large.bin": {BlockHashes: modifiedHashes, RemoteAttribute: "remote/large.bin"},
+			}},
+			wantCopyFiles: []string{small, large},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			copyFiles, deltas, blockHashes, err := planDeltaSync(dir, "/projects", []string{small, large}, tt.previousIndex, fs)
+			if err != nil {
+				t.Fatalf("planDeltaSync() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.wantCopyFiles, copyFiles); diff != "" {
+				t.Errorf("planDeltaSync() copyFiles mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantDeltas, deltas, cmp.AllowUnexported(deltaFile{})); diff != "" {
+				t.Errorf("planDeltaSync() deltas mismatch (-want +got):\n%s", diff)
+			}
+			if tt.previousIndex == nil || tt.previousIndex.Files["large.bin"].RemoteAttribute == "" {
+				if diff := cmp.Diff(map[string][]string{"large.bin": hashes}, blockHashes); diff != "" {
+					t.Errorf("planDeltaSync() blockHashes mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
@@ -27,6 +27,16 @@ type SyncParameters struct {
 	Files                    map[string]string
 }
 
+// PullParameters is a struct containing the parameters to be used when pulling files from a devfile component
+type PullParameters struct {
+	Path        string   // Path refers to the parent folder into which the files are pulled
+	RemotePaths []string // RemotePaths are the paths of the files and directories to pull, relative to the sync folder of the component
+	CompInfo    ComponentInfo
+}
+
 type Client interface {
 	SyncFiles(ctx context.Context, syncParameters SyncParameters) (bool, error)
+	// PullFiles copies files from the component into the local folder, and updates the index of the synced files
+	// so that the pulled files are not pushed back to the component. It returns the local paths of the pulled files.
+	PullFiles(ctx context.Context, pullParameters PullParameters) ([]string, error)
 }
@@ -34,6 +34,21 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// PullFiles mocks base method.
+func (m *MockClient) PullFiles(ctx context.Context, pullParameters PullParameters) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PullFiles", ctx, pullParameters)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PullFiles indicates an expected call of PullFiles.
+func (mr *MockClientMockRecorder) PullFiles(ctx, pullParameters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PullFiles", reflect.TypeOf((*MockClient)(nil).PullFiles), ctx, pullParameters)
+}
+
 // SyncFiles mocks base method.
 func (m *MockClient) SyncFiles(ctx context.Context, syncParameters SyncParameters) (bool, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,200 @@
+package sync
+
+import (
+	taro "archive/tar"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	gopath "path"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// PullFiles copies the files and directories at pullParameters.RemotePaths in the sync folder of the component
+// into pullParameters.Path, and updates the index of the synced files with the pulled files
+func (a SyncClient) PullFiles(ctx context.Context, pullParameters PullParameters) ([]string, error) {
+	remotePaths, err := cleanRemotePaths(pullParameters.RemotePaths)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, writer := io.Pipe()
+	var stderr bytes.Buffer
+	cmdArr := getCmdToArchiveFiles(filepath.ToSlash(pullParameters.CompInfo.SyncFolder), remotePaths)
+	klog.V(3).Infof("Executing command %s", strings.Join(cmdArr, " "))
+	go func() {
+		err := a.platformClient.ExecCMDInContainer(ctx, pullParameters.CompInfo.ContainerName, pullParameters.CompInfo.PodName, cmdArr, writer, &stderr, nil, false)
+		if err != nil {
+			err = fmt.Errorf("unable to archive files in container: %w (stderr: %s)", err, stderr.String())
+		}
+		_ = writer.CloseWithError(err)
+	}()
+
+	pulledFiles, err := untar(reader, pullParameters.Path)
+	// Unblock the command if the extraction stopped before reading the entire archive
+	_ = reader.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	err = updateIndexWithPulledFiles(pullParameters.Path, pulledFiles)
+	if err != nil {
+		return nil, fmt.Errorf("unable to update the index with pulled files: %w", err)
+	}
+	return pulledFiles, nil
+}
+
+// cleanRemotePaths returns the paths cleaned, and returns an error if a path is not relative
+// or is outside the sync folder
+func cleanRemotePaths(remotePaths []string) ([]string, error) {
+	if len(remotePaths) == 0 {
+		return nil, errors.New("no path to pull")
+	}
+	result := make([]string, 0, len(remotePaths))
+	for _, p := range remotePaths {
+		cleaned := gopath.Clean(filepath.ToSlash(p))
+		if gopath.IsAbs(cleaned) || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+			return nil, fmt.Errorf("path %q must be relative to the project directory in the container", p)
+		}
+		result = append(result, cleaned)
+	}
+	return result, nil
+}
+
+// getCmdToArchiveFiles returns the command used to write to the standard output an archive of the remote paths
+// relative to the sync folder
+func getCmdToArchiveFiles(syncFolder string, remotePaths []string) []string {
+	return append([]string{"tar", "cf", "-", "-C", syncFolder, "--"}, remotePaths...)
+}
+
+// untar extracts the archive read from reader into the directory, and returns the paths of the extracted files
+func untar(reader io.Reader, directory string) ([]string, error) {
+	var extracted []string
+	tarReader := taro.NewReader(reader)
+	for {
+		hdr, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			return extracted, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		name := gopath.Clean(hdr.Name)
+		if gopath.IsAbs(name) || name == ".." || strings.HasPrefix(name, "../") {
+			return nil, fmt.Errorf("invalid path %q in archive", hdr.Name)
+		}
+		target := filepath.Join(directory, filepath.FromSlash(name))
+
+		switch hdr.Typeflag {
+		case taro.TypeDir:
+			if err = os.MkdirAll(target, 0750); err != nil {
+				return nil, err
+			}
+		case taro.TypeReg:
+			if err = extractFile(tarReader, target, os.FileMode(hdr.Mode).Perm()); err != nil {
+				return nil, err
+			}
+			extracted = append(extracted, target)
+		case taro.TypeSymlink:
+			// Do not create links pointing outside the directory
+			linkTarget := gopath.Clean(gopath.Join(gopath.Dir(name), hdr.Linkname))
+			if gopath.IsAbs(hdr.Linkname) || linkTarget == ".." || strings.HasPrefix(linkTarget, "../") {
+				klog.V(2).Infof("ignoring symbolic link %q pointing outside the project: %q", hdr.Name, hdr.Linkname)
+				continue
+			}
+			if err = os.MkdirAll(filepath.Dir(target), 0750); err != nil {
+				return nil, err
+			}
+			if err = removeIfExists(target); err != nil {
+				return nil, err
+			}
+			if err = os.Symlink(hdr.Linkname, target); err != nil {
+				return nil, err
+			}
+			extracted = append(extracted, target)
+		default:
+			klog.V(4).Infof("ignoring entry %q of type %c", hdr.Name, hdr.Typeflag)
+		}
+	}
+}
+
+// extractFile writes the content read from reader to the file at target
+func extractFile(reader io.Reader, target string, perm os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0750); err != nil {
+		return err
+	}
+	// Do not write through an existing symbolic link
+	if err := removeIfExists(target); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	defer f.Close() // #nosec G307
+	if _, err = io.Copy(f, reader); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+// removeIfExists removes the file at path if it exists and is not a directory
+func removeIfExists(path string) error {
+	stat, err := os.Lstat(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if stat.IsDir() {
+		return fmt.Errorf("unable to replace directory %q with a file", path)
+	}
+	return os.Remove(path)
+}
+
+// updateIndexWithPulledFiles updates the index of the files in directory with the pulled files and the hashes of their content,
+// so that they are not considered as changed during the next sync if their content has not changed since they were pulled
+func updateIndexWithPulledFiles(directory string, pulledFiles []string) error {
+	directory = filepath.Clean(directory)
+	indexFilePath, err := util.ResolveIndexFilePath(directory)
+	if err != nil {
+		return err
+	}
+	if _, err = os.Stat(indexFilePath); os.IsNotExist(err) {
+		// No sync has been done yet, all the files will be synced anyway
+		return nil
+	}
+	fileIndex, err := util.ReadFileIndex(indexFilePath)
+	if err != nil {
+		return err
+	}
+	// The directories containing the pulled files are modified too
+	updated := make(map[string]bool)
+	for _, file := range pulledFiles {
+		for p := file; p != directory && strings.HasPrefix(p, directory) && !updated[p]; p = filepath.Dir(p) {
+			updated[p] = true
+		}
+	}
+	for file := range updated {
+		relativePath, fileData, err := util.GenerateNewFileDataEntry(file, directory)
+		if err != nil {
+			klog.V(4).Infof("unable to add %s to the index: %v", file, err)
+			continue
+		}
+		fileData.PulledHash, err = util.GetContentHash(file)
+		if err != nil {
+			klog.V(4).Infof("unable to compute the hash of %s: %v", file, err)
+		}
+		fileIndex.Files[relativePath] = *fileData
+	}
+	return util.WriteFile(fileIndex.Files, indexFilePath)
+}
@@ -0,0 +1,237 @@
+package sync
+
+import (
+	taro "archive/tar"
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/redhat-developer/odo/pkg/platform"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// tarEntry is an entry of an archive generated for tests
+type tarEntry struct {
+	name     string
+	typeflag byte
+	content  string
+	linkname string
+}
+
+func makeTestTar(t *testing.T, entries []tarEntry) []byte {
+	var buf bytes.Buffer
+	tw := taro.NewWriter(&buf)
+	for _, entry := range entries {
+		err := tw.WriteHeader(&taro.Header{
+			Name:     entry.name,
+			Typeflag: entry.typeflag,
+			Mode:     0644,
+			Size:     int64(len(entry.content)),
+			Linkname: entry.linkname,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = tw.Write([]byte(entry.content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func Test_cleanRemotePaths(t *testing.T) {
+	tests := []struct {
+		name        string
+		remotePaths []string
+		want        []string
+		wantErr     bool
+	}{
+		{
+			name:        "relative paths",
+			remotePaths: []string{"package-lock.json", "generated/", "./src/../gen"},
+			want:        []string{"package-lock.json", "generated", "gen"},
+		},
+		{
+			name:        "absolute path",
+			remotePaths: []string{"/etc/passwd"},
+			wantErr:     true,
+		},
+		{
+			name:        "path outside the project",
+			remotePaths: []string{"src/../../other"},
+			wantErr:     true,
+		},
+		{
+			name:        "no path",
+			remotePaths: nil,
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := cleanRemotePaths(tt.remotePaths)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("cleanRemotePaths() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("cleanRemotePaths() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_untar(t *testing.T) {
+	tests := []struct {
+		name      string
+		entries   []tarEntry
+		wantFiles map[string]string
+		wantErr   bool
+	}{
+		{
+			name: "files and directories",
+			entries: []tarEntry{
+				{name: "generated/", typeflag: taro.TypeDir},
+				{name: "generated/api.go", typeflag: taro.TypeReg, content: "package api"},
+				{name: "package-lock.json", typeflag: taro.TypeReg, content: "{}"},
+			},
+			wantFiles: map[string]string{
+				filepath.Join("generated", "api.go"): "package api",
+				"package-lock.json":                  "{}",
+			},
+		},
+		{
+			name: "file outside the directory",
+			entries: []tarEntry{
+				{name: "../evil", typeflag: taro.TypeReg, content: "evil"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "link outside the directory is ignored",
+			entries: []tarEntry{
+				{name: "link", typeflag: taro.TypeSymlink, linkname: "../../etc/passwd"},
+			},
+			wantFiles: map[string]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			got, err := untar(bytes.NewReader(makeTestTar(t, tt.entries)), dir)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("untar() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			gotFiles := map[string]string{}
+			for _, file := range got {
+				content, err := os.ReadFile(file)
+				if err != nil {
+					t.Fatal(err)
+				}
+				rel, err := filepath.Rel(dir, file)
+				if err != nil {
+					t.Fatal(err)
+				}
+				gotFiles[rel] = string(content)
+			}
+			if diff := cmp.Diff(tt.wantFiles, gotFiles); diff != "" {
+				t.Errorf("untar() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSyncClient_PullFiles(t *testing.T) {
+	dir := t.TempDir()
+	indexFilePath, err := util.ResolveIndexFilePath(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.MkdirAll(filepath.Dir(indexFilePath), 0750); err != nil {
+		t.Fatal(err)
+	}
+	if err = util.WriteFile(map[string]util.FileData{}, indexFilePath); err != nil {
+		t.Fatal(err)
+	}
+
+	archive := makeTestTar(t, []tarEntry{
+		{name: "generated/", typeflag: taro.TypeDir},
+		{name: "generated/api.go", typeflag: taro.TypeReg, content: "package api"},
+	})
+	ctrl := gomock.NewController(t)
+	platformClient := platform.NewMockClient(ctrl)
+	platformClient.EXPECT().ExecCMDInContainer(gomock.Any(), "runtime", "pod", []string{"tar", "cf", "-", "-C", "/projects", "--", "generated"}, gomock.Any(), gomock.Any(), nil, false).
+		DoAndReturn(func(_ context.Context, _, _ string, _ []string, stdout, _ io.Writer, _ io.Reader, _ bool) error {
+			_, err := stdout.Write(archive)
+			return err
+		})
+
+	a := NewSyncClient(platformClient, nil, nil)
+	got, err := a.PullFiles(context.Background(), PullParameters{
+		Path:        dir,
+		RemotePaths: []string{"generated/"},
+		CompInfo: ComponentInfo{
+			ContainerName: "runtime",
+			PodName:       "pod",
+			SyncFolder:    "/projects",
+		},
+	})
+	if err != nil {
+		t.Fatalf("PullFiles() error = %v", err)
+	}
+	pulledFile := filepath.Join(dir, "generated", "api.go")
+	if diff := cmp.Diff([]string{pulledFile}, got); diff != "" {
+		t.Errorf("PullFiles() mismatch (-want +got):\n%s", diff)
+	}
+
+	// The pulled files must be considered as synced
+	changedFiles, err := updateIndexWithWatchChanges(SyncParameters{
+		Path:       dir,
+		WatchFiles: []string{pulledFile, filepath.Join(dir, "generated")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changedFiles) != 0 {
+		t.Errorf("pulled files should not be considered as changed, got %v", changedFiles)
+	}
+
+	// An edit of the same size as the pulled content, within the granularity of the modification time, must be synced
+	stat, err := os.Stat(pulledFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, err := os.ReadFile(pulledFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(pulledFile, bytes.ToUpper(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Chtimes(pulledFile, stat.ModTime(), stat.ModTime()); err != nil {
+		t.Fatal(err)
+	}
+	changedFiles, err = updateIndexWithWatchChanges(SyncParameters{
+		Path:       dir,
+		WatchFiles: []string{pulledFile},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{pulledFile}, changedFiles); diff != "" {
+		t.Errorf("edited pulled file should be considered as changed (-want +got):\n%s", diff)
+	}
+}
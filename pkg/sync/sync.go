@@ -13,6 +13,8 @@ import (
 
 	"github.com/redhat-developer/odo/pkg/exec"
 	"github.com/redhat-developer/odo/pkg/platform"
+	"github.com/redhat-developer/odo/pkg/preference"
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
 	"github.com/redhat-developer/odo/pkg/util"
 
 	"k8s.io/klog"
@@ -20,17 +22,23 @@ import (
 
 // SyncClient is a platform-agnostic implementation for sync
 type SyncClient struct {
-	platformClient platform.Client
-	execClient     exec.Client
+	platformClient   platform.Client
+	execClient       exec.Client
+	preferenceClient preference.Client
+
+	// compressions stores the compression negotiated with each container
+	compressions *compressionCache
 }
 
 var _ Client = (*SyncClient)(nil)
 
 // NewSyncClient instantiates a new SyncClient
-func NewSyncClient(platformClient platform.Client, execClient exec.Client) *SyncClient {
+func NewSyncClient(platformClient platform.Client, execClient exec.Client, preferenceClient preference.Client) *SyncClient {
 	return &SyncClient{
-		platformClient: platformClient,
-		execClient:     execClient,
+		platformClient:   platformClient,
+		execClient:       execClient,
+		preferenceClient: preferenceClient,
+		compressions:     newCompressionCache(),
 	}
 }
 
@@ -47,6 +55,19 @@ func (a SyncClient) SyncFiles(ctx context.Context, syncParameters SyncParameters
 	// Ret from Indexer function
 	var ret util.IndexerRet
 
+	// The index of the previous sync, containing the block hashes of the large files
+	var previousIndex *util.FileIndex
+	if !syncParameters.ForcePush {
+		indexFilePath, err := util.ResolveIndexFilePath(syncParameters.Path)
+		if err != nil {
+			return false, fmt.Errorf("unable to resolve path: %s: %w", syncParameters.Path, err)
+		}
+		previousIndex, err = util.ReadFileIndex(indexFilePath)
+		if err != nil {
+			return false, fmt.Errorf("unable to read index from path: %s: %w", indexFilePath, err)
+		}
+	}
+
 	var deletedFiles []string
 	var changedFiles []string
 	isWatch := len(syncParameters.WatchFiles) > 0 || len(syncParameters.WatchDeletedFiles) > 0
@@ -74,13 +95,17 @@ func (a SyncClient) SyncFiles(ctx context.Context, syncParameters SyncParameters
 	// changed files into the existing file index, and delete removed files from the index
 	if isWatch && !syncParameters.DevfileScanIndexForWatch {
 
-		err := updateIndexWithWatchChanges(syncParameters)
+		var err error
+		changedFiles, err = updateIndexWithWatchChanges(syncParameters)
 
 		if err != nil {
 			return false, err
 		}
+		if len(changedFiles) == 0 && len(syncParameters.WatchDeletedFiles) == 0 {
+			// The files are already in sync with the component (for example, files pulled from the component)
+			return false, nil
+		}
 
-		changedFiles = syncParameters.WatchFiles
 		deletedFiles = syncParameters.WatchDeletedFiles
 		deletedFiles, err = dfutil.RemoveRelativePathFromFiles(deletedFiles, syncParameters.Path)
 		if err != nil {
@@ -148,16 +173,32 @@ func (a SyncClient) SyncFiles(ctx context.Context, syncParameters SyncParameters
 		}
 	}
 
-	err := a.pushLocal(ctx, syncParameters.Path, changedFiles, deletedFiles, syncParameters.ForcePush, syncParameters.IgnoredFiles, syncParameters.CompInfo, ret)
+	// Large files changed since the previous sync are synced by sending only their changed blocks
+	copyFiles, deltas, blockHashes, err := planDeltaSync(syncParameters.Path, syncParameters.CompInfo.SyncFolder, changedFiles, previousIndex, filesystem.DefaultFs{})
+	if err != nil {
+		return false, fmt.Errorf("unable to compute changes in files: %w", err)
+	}
+
+	err = a.pushLocal(ctx, syncParameters.Path, copyFiles, deletedFiles, syncParameters.ForcePush, syncParameters.IgnoredFiles, syncParameters.CompInfo, ret)
 	if err != nil {
 		return false, fmt.Errorf("failed to sync to component with name %s: %w", syncParameters.CompInfo.ComponentName, err)
 	}
+	if len(deltas) > 0 {
+		err = a.pushDeltas(ctx, syncParameters.Path, syncParameters.CompInfo, deltas, ret)
+		if err != nil {
+			return false, fmt.Errorf("failed to sync to component with name %s: %w", syncParameters.CompInfo.ComponentName, err)
+		}
+	}
 	if forceWrite {
 		err = util.WriteFile(ret.NewFileMap, ret.ResolvedPath)
 		if err != nil {
 			return false, fmt.Errorf("failed to write file: %w", err)
 		}
 	}
+	err = storeBlockHashes(syncParameters.Path, blockHashes)
+	if err != nil {
+		return false, fmt.Errorf("failed to write file: %w", err)
+	}
 
 	return true, nil
 }
@@ -242,11 +283,12 @@ func (a SyncClient) pushLocal(ctx context.Context, path string, files []string,
 
 // updateIndexWithWatchChanges uses the pushParameters.WatchDeletedFiles and pushParamters.WatchFiles to update
 // the existing index file; the index file is required to exist when this function is called.
-func updateIndexWithWatchChanges(syncParameters SyncParameters) error {
+// It returns the watched files, except the files written by PullFiles whose content has not changed since then
+func updateIndexWithWatchChanges(syncParameters SyncParameters) ([]string, error) {
 	indexFilePath, err := util.ResolveIndexFilePath(syncParameters.Path)
 
 	if err != nil {
-		return fmt.Errorf("unable to resolve path: %s: %w", syncParameters.Path, err)
+		return nil, fmt.Errorf("unable to resolve path: %s: %w", syncParameters.Path, err)
 	}
 
 	// Check that the path exists
@@ -258,13 +300,13 @@ func updateIndexWithWatchChanges(syncParameters SyncParameters) error {
 		//
 		// If you see this error it means somehow watch's SyncFiles was called without the index being first generated (likely because the
 		// above mentioned pushParam wasn't set). See SyncFiles(...) for details.
-		return fmt.Errorf("resolved path doesn't exist: %s: %w", indexFilePath, err)
+		return nil, fmt.Errorf("resolved path doesn't exist: %s: %w", indexFilePath, err)
 	}
 
 	// Parse the existing index
 	fileIndex, err := util.ReadFileIndex(indexFilePath)
 	if err != nil {
-		return fmt.Errorf("unable to read index from path: %s: %w", indexFilePath, err)
+		return nil, fmt.Errorf("unable to read index from path: %s: %w", indexFilePath, err)
 	}
 
 	rootDir := syncParameters.Path
@@ -283,19 +325,32 @@ func updateIndexWithWatchChanges(syncParameters SyncParameters) error {
 	}
 
 	// Add changed files to the existing index
+	var changedFiles []string
 	for _, addedOrModifiedFile := range syncParameters.WatchFiles {
 		relativePath, fileData, err := util.GenerateNewFileDataEntry(addedOrModifiedFile, rootDir)
 
 		if err != nil {
 			klog.V(4).Infof("Error occurred for %s: %v", addedOrModifiedFile, err)
+			changedFiles = append(changedFiles, addedOrModifiedFile)
 			continue
 		}
+		// The size and modification time cannot tell if a file has changed, as the modification time
+		// may be too coarse on some filesystems: only the content of the pulled files is compared
+		if existing, ok := fileIndex.Files[relativePath]; ok && existing.PulledHash != "" {
+			hash, err := util.GetContentHash(addedOrModifiedFile)
+			if err == nil && hash == existing.PulledHash {
+				klog.V(4).Infof("Watched file unchanged since it was pulled: %s", relativePath)
+				fileIndex.Files[relativePath] = *fileData
+				continue
+			}
+		}
+		changedFiles = append(changedFiles, addedOrModifiedFile)
 		fileIndex.Files[relativePath] = *fileData
 		klog.V(4).Infof("Added/updated watched file in index: %s", relativePath)
 	}
 
 	// Write the result
-	return util.WriteFile(fileIndex.Files, indexFilePath)
+	return changedFiles, util.WriteFile(fileIndex.Files, indexFilePath)
 
 }
 
@@ -171,7 +171,7 @@ func TestSyncFiles(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			execClient := exec.NewExecClient(kc)
-			syncAdapter := NewSyncClient(kc, execClient)
+			syncAdapter := NewSyncClient(kc, execClient, nil)
 			isPushRequired, err := syncAdapter.SyncFiles(context.Background(), tt.syncParameters)
 			if !tt.wantErr && err != nil {
 				t.Errorf("TestSyncFiles error: unexpected error when syncing files %v", err)
@@ -303,7 +303,7 @@ func TestPushLocal(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			execClient := exec.NewExecClient(kc)
-			syncAdapter := NewSyncClient(kc, execClient)
+			syncAdapter := NewSyncClient(kc, execClient, nil)
 			err := syncAdapter.pushLocal(context.Background(), tt.path, tt.files, tt.delFiles, tt.isForcePush, []string{}, tt.compInfo, util.IndexerRet{})
 			if !tt.wantErr && err != nil {
 				t.Errorf("TestPushLocal error: error pushing files: %v", err)
@@ -320,7 +320,10 @@ func TestUpdateIndexWithWatchChanges(t *testing.T) {
 		initialFilesToCreate []string
 		watchDeletedFiles    []string
 		watchAddedFiles      []string
+		// watchEditedFiles are edited with a content of the same size, without changing their modification time
+		watchEditedFiles     []string
 		expectedFilesInIndex []string
+		expectedChangedFiles []string
 	}{
 		{
 			name:                 "Case 1 - Watch file deleted should remove file from index",
@@ -333,12 +336,20 @@ func TestUpdateIndexWithWatchChanges(t *testing.T) {
 			initialFilesToCreate: []string{"file1"},
 			watchAddedFiles:      []string{"file2"},
 			expectedFilesInIndex: []string{"file1", "file2"},
+			expectedChangedFiles: []string{"file2"},
 		},
 		{
 			name:                 "Case 3 - No watch changes should mean no index changes",
 			initialFilesToCreate: []string{"file1"},
 			expectedFilesInIndex: []string{"file1"},
 		},
+		{
+			name:                 "Case 4 - Watch file edited within the granularity of the modification time should be returned as changed",
+			initialFilesToCreate: []string{"file1", "file2"},
+			watchEditedFiles:     []string{"file1"},
+			expectedFilesInIndex: []string{"file1", "file2"},
+			expectedChangedFiles: []string{"file1"},
+		},
 	}
 	for _, tt := range tests {
 
@@ -402,9 +413,31 @@ func TestUpdateIndexWithWatchChanges(t *testing.T) {
 				}
 			}
 
-			if err := updateIndexWithWatchChanges(syncParams); err != nil {
+			// Edit files without changing their size nor their modification time
+			for _, editedFile := range tt.watchEditedFiles {
+				editedFilePath := filepath.Join(directory, editedFile)
+				syncParams.WatchFiles = append(syncParams.WatchFiles, editedFilePath)
+
+				modTime := indexData[editedFile].LastModifiedDate
+				if err := os.WriteFile(editedFilePath, []byte("edited-same-size"), 0644); err != nil {
+					t.Fatalf("TestUpdateIndexWithWatchChangesLocal error: unable to edit file %s: %v", editedFilePath, err)
+				}
+				if err := os.Chtimes(editedFilePath, modTime, modTime); err != nil {
+					t.Fatalf("TestUpdateIndexWithWatchChangesLocal error: unable to set modification time of %s: %v", editedFilePath, err)
+				}
+			}
+
+			changedFiles, err := updateIndexWithWatchChanges(syncParams)
+			if err != nil {
 				t.Fatalf("TestUpdateIndexWithWatchChangesLocal: unexpected error: %v", err)
 			}
+			var expectedChangedFiles []string
+			for _, changedFile := range tt.expectedChangedFiles {
+				expectedChangedFiles = append(expectedChangedFiles, filepath.Join(directory, changedFile))
+			}
+			if diff := cmp.Diff(expectedChangedFiles, changedFiles); diff != "" {
+				t.Errorf("updateIndexWithWatchChanges() changed files mismatch (-want +got):\n%s", diff)
+			}
 
 			postFileIndex, err := util.ReadFileIndex(fileIndexPath)
 			if err != nil || postFileIndex == nil {
@@ -1,8 +1,11 @@
 package util
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -43,6 +46,12 @@ type FileData struct {
 	Size             int64
 	LastModifiedDate time.Time
 	RemoteAttribute  string `json:"RemoteAttribute,omitempty"`
+	// BlockHashes are the hashes of the blocks of the file, as synced into the container.
+	// They are computed only for large files, to sync only the blocks that changed.
+	BlockHashes []string `json:"BlockHashes,omitempty"`
+	// PulledHash is the hash of the content of the file written by odo sync pull,
+	// until the next change of the file is watched
+	PulledHash string `json:"PulledHash,omitempty"`
 }
 
 // ReadFileIndex tries to read the odo index file from the given location and returns the data from the file
@@ -145,6 +154,61 @@ func DeleteIndexFile(directory string) error {
 	return dfutil.DeletePath(indexFile)
 }
 
+// GetIndexFileDigest returns the SHA256 digest of the index file for the given directory,
+// or an empty string if the index file does not exist
+func GetIndexFileDigest(directory string) (string, error) {
+	indexFile, err := ResolveIndexFilePath(directory)
+	if err != nil {
+		return "", err
+	}
+	content, err := os.ReadFile(indexFile)
+	if os.IsNotExist(err) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// GetContentHash returns the SHA256 digest of the content of the file at path: the content of a regular file,
+// the target of a symbolic link, or the names of the entries of a directory
+func GetContentHash(path string) (string, error) {
+	fi, err := os.Lstat(path)
+	if err != nil {
+		return "", err
+	}
+	h := sha256.New()
+	switch {
+	case fi.Mode()&os.ModeSymlink != 0:
+		target, err := os.Readlink(path)
+		if err != nil {
+			return "", err
+		}
+		h.Write([]byte("link:" + target))
+	case fi.IsDir():
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return "", err
+		}
+		h.Write([]byte("dir:"))
+		for _, entry := range entries {
+			h.Write([]byte(entry.Name() + "\x00"))
+		}
+	default:
+		f, err := os.Open(path)
+		if err != nil {
+			return "", err
+		}
+		defer f.Close() // #nosec G307
+		h.Write([]byte("file:"))
+		if _, err = io.Copy(h, f); err != nil {
+			return "", err
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
 // IndexerRet is a struct that represent return value of RunIndexer function
 type IndexerRet struct {
 	FilesChanged  []string
@@ -519,6 +583,10 @@ func recursiveChecker(pathOptions recursiveCheckerPathOptions, ignoreRules []str
 			fileData, fileChangedData, fileRemoteChangedData := handleRemoteDataFile(pathOptions.destFile, matchedPath, joinedRelPath, remoteDirectories, existingFileIndex)
 			fileData.Size = stat.Size()
 			fileData.LastModifiedDate = stat.ModTime()
+			if existing, ok := existingFileIndex.Files[joinedRelPath]; ok && !fileChanged[matchedPath] {
+				// The file did not change, the hashes of its blocks are still valid
+				fileData.BlockHashes = existing.BlockHashes
+			}
 			ret.NewFileMap[joinedRelPath] = fileData
 
 			for data, value := range fileChangedData {
@@ -747,6 +747,11 @@ func GetCommandStringFromEnvs(envVars []v1alpha2.EnvVar) string {
 	return setEnvVariable
 }
 
+// ShellQuote returns s quoted with single quotes, to be used as a single argument in a POSIX shell script
+func ShellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
 // GetGitOriginPath gets the remote fetch URL from the given git repo
 // if the repo is not a git repo, the error is ignored
 func GetGitOriginPath(path string) string {
@@ -2591,6 +2591,37 @@ func TestNamespaceKubernetesObjectWithTrim(t *testing.T) {
 	}
 }
 
+func TestShellQuote(t *testing.T) {
+	tests := []struct {
+		name string
+		arg  string
+		want string
+	}{
+		{
+			name: "case 1: empty string",
+			arg:  "",
+			want: "''",
+		},
+		{
+			name: "case 2: string with spaces and special characters",
+			arg:  "/projects/my dir/$HOME/*.js",
+			want: "'/projects/my dir/$HOME/*.js'",
+		},
+		{
+			name: "case 3: string with single quotes",
+			arg:  "it's",
+			want: `'it'\''s'`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ShellQuote(tt.arg); got != tt.want {
+				t.Errorf("ShellQuote() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestSafeGetBool(t *testing.T) {
 
 	tests := []struct {
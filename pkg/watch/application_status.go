@@ -0,0 +1,127 @@
+package watch
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/dev/common"
+	"github.com/redhat-developer/odo/pkg/log"
+)
+
+// maxApplicationErrors is the number of lines matching the error pattern retained in the status of the application
+const maxApplicationErrors = 10
+
+// clockSkewTolerance is the difference tolerated between the local clock, giving the start time of the command,
+// and the clock of the node running the container, giving the timestamps of the lines of logs
+const clockSkewTolerance = 5 * time.Second
+
+// applicationStatus follows the lines of the logs of the application started by the run (or debug) command,
+// to detect when the application is ready, and the errors it reports.
+// The methods can be called on a nil applicationStatus, when the command does not declare any log patterns.
+type applicationStatus struct {
+	out      io.Writer
+	patterns common.LogPatterns
+
+	// startedAt is the time at which the command has been started, the lines of logs written before being ignored
+	startedAt time.Time
+	// writingSinceStart is true when a line written after the start of the command has been received:
+	// the lines of logs being received in order, the next lines are also written after the start
+	writingSinceStart bool
+	// waitingReady is true when the command has been started, and no line of the logs matched the ready pattern since
+	waitingReady bool
+	// errors are the last lines of the logs matching the error pattern since the command has been started
+	errors []api.ContainerLogLine
+}
+
+func newApplicationStatus(out io.Writer, patterns common.LogPatterns) *applicationStatus {
+	return &applicationStatus{
+		out:      out,
+		patterns: patterns,
+	}
+}
+
+// CommandStarted is called when the command has been started, or restarted, at startedAt: the application is not ready
+// until a line written after startedAt matches the ready pattern, and the errors reported by the previous execution are forgotten
+func (o *applicationStatus) CommandStarted(startedAt time.Time) {
+	if o == nil {
+		return
+	}
+	o.startedAt = startedAt
+	o.writingSinceStart = false
+	o.waitingReady = o.patterns.Ready != nil
+	o.errors = nil
+	if o.waitingReady {
+		log.Finfof(o.out, "Waiting for the application to be ready (pattern %q in the logs of the application)", o.patterns.Ready.String())
+	}
+}
+
+// IsWaitingReady returns true if the command has been started and the application is not ready yet
+func (o *applicationStatus) IsWaitingReady() bool {
+	return o != nil && o.waitingReady
+}
+
+// Update matches the line of logs against the patterns, and reports the lines matching the error pattern.
+// The lines written before the command has been started, if their timestamp is known, are ignored.
+// It returns whether the line makes the application ready, and whether the status of the application changed.
+func (o *applicationStatus) Update(line api.ContainerLogLine) (ready bool, changed bool) {
+	if o == nil || o.writtenBeforeStart(line) {
+		return false, false
+	}
+	if o.patterns.IsError(line) {
+		log.Fwarningf(o.out, "Error reported by the application in container %q: %s", line.Container, line.Message)
+		o.errors = append(o.errors, line)
+		if len(o.errors) > maxApplicationErrors {
+			o.errors = o.errors[len(o.errors)-maxApplicationErrors:]
+		}
+		changed = true
+	}
+	if o.waitingReady && o.patterns.IsReady(line) {
+		o.waitingReady = false
+		return true, true
+	}
+	return false, changed
+}
+
+// writtenBeforeStart returns true if the timestamp of the line is before the start of the command.
+// As the timestamp is given by the clock of the node, a difference of clockSkewTolerance is tolerated,
+// and the lines received after a line written after the start are never considered as written before.
+func (o *applicationStatus) writtenBeforeStart(line api.ContainerLogLine) bool {
+	if o.startedAt.IsZero() || o.writingSinceStart || line.Timestamp == "" {
+		return false
+	}
+	timestamp, err := time.Parse(time.RFC3339Nano, line.Timestamp)
+	if err != nil {
+		return false
+	}
+	if timestamp.Before(o.startedAt.Add(-clockSkewTolerance)) {
+		return true
+	}
+	o.writingSinceStart = true
+	return false
+}
+
+// Status returns the status of the application, to be exposed by the API server
+func (o *applicationStatus) Status() api.ApplicationStatus {
+	return api.ApplicationStatus{
+		Ready:  !o.waitingReady,
+		Errors: append([]api.ContainerLogLine(nil), o.errors...),
+	}
+}
+
+// StatusLine returns the description of the status of the application, displayed with the status of the Dev mode,
+// or an empty string if the command does not declare any log patterns
+func (o *applicationStatus) StatusLine() string {
+	switch {
+	case o == nil:
+		return ""
+	case o.waitingReady:
+		return "Waiting for the application to be ready"
+	case len(o.errors) != 0:
+		last := o.errors[len(o.errors)-1]
+		return fmt.Sprintf("Ready, %d error(s) reported, last one in container %q: %s", len(o.errors), last.Container, last.Message)
+	default:
+		return "Ready"
+	}
+}
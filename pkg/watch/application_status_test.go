@@ -0,0 +1,187 @@
+package watch
+
+import (
+	"bytes"
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/dev/common"
+	"github.com/redhat-developer/odo/pkg/informer"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+)
+
+func Test_applicationStatus(t *testing.T) {
+	line := func(message string) api.ContainerLogLine {
+		return api.ContainerLogLine{Container: "runtime", Message: message}
+	}
+	out := &bytes.Buffer{}
+	status := newApplicationStatus(out, common.LogPatterns{
+		Container: "runtime",
+		Ready:     regexp.MustCompile("Started Application in"),
+		Error:     regexp.MustCompile("^ERROR"),
+	})
+
+	startedAt := time.Now()
+	status.CommandStarted(startedAt)
+	if !status.IsWaitingReady() {
+		t.Errorf("application should not be ready after the command is started")
+	}
+
+	// the lines written before the command has been started are ignored
+	previous := api.ContainerLogLine{
+		Container: "runtime",
+		Timestamp: startedAt.Add(-time.Minute).UTC().Format(time.RFC3339Nano),
+		Message:   "Started Application in 2.1 seconds",
+	}
+	if ready, changed := status.Update(previous); ready || changed {
+		t.Errorf("Update() = %v, %v, want false, false", ready, changed)
+	}
+
+	if ready, changed := status.Update(line("Starting Application")); ready || changed {
+		t.Errorf("Update() = %v, %v, want false, false", ready, changed)
+	}
+	if ready, changed := status.Update(line("ERROR unable to connect to the database")); ready || !changed {
+		t.Errorf("Update() = %v, %v, want false, true", ready, changed)
+	}
+	if !strings.Contains(out.String(), "ERROR unable to connect to the database") {
+		t.Errorf("error line should be displayed, output: %s", out.String())
+	}
+	if ready, changed := status.Update(line("Started Application in 2.3 seconds")); !ready || !changed {
+		t.Errorf("Update() = %v, %v, want true, true", ready, changed)
+	}
+	// the ready pattern makes the application ready only once per start of the command
+	if ready, _ := status.Update(line("Started Application in 2.3 seconds")); ready {
+		t.Errorf("Update() should not make the application ready again")
+	}
+
+	want := api.ApplicationStatus{
+		Ready:  true,
+		Errors: []api.ContainerLogLine{line("ERROR unable to connect to the database")},
+	}
+	if diff := cmp.Diff(want, status.Status()); diff != "" {
+		t.Errorf("Status() mismatch (-want +got):\n%s", diff)
+	}
+	if got := status.StatusLine(); !strings.HasPrefix(got, "Ready, 1 error(s) reported") {
+		t.Errorf("StatusLine() = %q", got)
+	}
+
+	// the errors are forgotten when the command is restarted
+	status.CommandStarted(time.Now())
+	if diff := cmp.Diff(api.ApplicationStatus{}, status.Status()); diff != "" {
+		t.Errorf("Status() after restart mismatch (-want +got):\n%s", diff)
+	}
+
+	// the number of errors retained is limited
+	for i := 0; i < maxApplicationErrors+5; i++ {
+		status.Update(line("ERROR"))
+	}
+	if got := len(status.Status().Errors); got != maxApplicationErrors {
+		t.Errorf("%d errors retained, want %d", got, maxApplicationErrors)
+	}
+}
+
+func Test_applicationStatus_clockSkew(t *testing.T) {
+	out := &bytes.Buffer{}
+	status := newApplicationStatus(out, common.LogPatterns{
+		Container: "runtime",
+		Ready:     regexp.MustCompile("Started Application in"),
+	})
+	lineAt := func(timestamp time.Time, message string) api.ContainerLogLine {
+		return api.ContainerLogLine{Container: "runtime", Timestamp: timestamp.UTC().Format(time.RFC3339Nano), Message: message}
+	}
+
+	// the clock of the node is 3 seconds behind the local clock
+	startedAt := time.Now()
+	nodeNow := startedAt.Add(-3 * time.Second)
+	status.CommandStarted(startedAt)
+
+	if ready, changed := status.Update(lineAt(nodeNow.Add(-time.Minute), "Started Application in 2.1 seconds")); ready || changed {
+		t.Errorf("Update() = %v, %v for a line of the previous execution, want false, false", ready, changed)
+	}
+	if ready, changed := status.Update(lineAt(nodeNow, "Starting Application")); ready || changed {
+		t.Errorf("Update() = %v, %v, want false, false", ready, changed)
+	}
+	// the lines following a line written after the start are not compared with the start time
+	if ready, changed := status.Update(lineAt(nodeNow.Add(-time.Minute), "Started Application in 2.3 seconds")); !ready || !changed {
+		t.Errorf("Update() = %v, %v, want true, true", ready, changed)
+	}
+
+	// the lines are compared again with the start time when the command is restarted
+	status.CommandStarted(time.Now())
+	if ready, changed := status.Update(lineAt(nodeNow.Add(-time.Minute), "Started Application in 2.3 seconds")); ready || changed {
+		t.Errorf("Update() = %v, %v after restart, want false, false", ready, changed)
+	}
+}
+
+func Test_applicationStatus_nil(t *testing.T) {
+	var status *applicationStatus
+	status.CommandStarted(time.Now())
+	if status.IsWaitingReady() {
+		t.Errorf("IsWaitingReady() should be false")
+	}
+	if ready, changed := status.Update(api.ContainerLogLine{Message: "ready"}); ready || changed {
+		t.Errorf("Update() = %v, %v, want false, false", ready, changed)
+	}
+	if got := status.StatusLine(); got != "" {
+		t.Errorf("StatusLine() = %q, want empty", got)
+	}
+}
+
+func TestWatchClient_processEvents_waitApplicationReady(t *testing.T) {
+	ctx := odocontext.WithDevfilePath(context.Background(), "/path/to/devfile")
+	out := &bytes.Buffer{}
+	o := WatchClient{
+		informerClient: informer.NewInformerClient(),
+		applicationStatus: newApplicationStatus(out, common.LogPatterns{
+			Ready: regexp.MustCompile("listening"),
+		}),
+	}
+	var saved []api.ApplicationStatus
+	var handlerState State
+	parameters := WatchParameters{
+		DevfileWatchHandler: func(ctx context.Context, params common.PushParameters, componentStatus *ComponentStatus) error {
+			handlerState = componentStatus.GetState()
+			if componentStatus.GetState() != StateReady {
+				componentStatus.RunCommandStarts++
+			}
+			componentStatus.SetState(StateReady)
+			return nil
+		},
+		ApplicationStatusHandler: func(ctx context.Context, status api.ApplicationStatus) error {
+			saved = append(saved, status)
+			return nil
+		},
+	}
+	parameters.StartOptions.Out = out
+	componentStatus := ComponentStatus{}
+
+	// the command is started: the component waits for the application to be ready
+	err := o.processEvents(ctx, parameters, nil, nil, &componentStatus)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if componentStatus.GetState() != StateWaitApplicationReady {
+		t.Errorf("state = %q, want %q", componentStatus.GetState(), StateWaitApplicationReady)
+	}
+	if diff := cmp.Diff([]api.ApplicationStatus{{Ready: false}}, saved); diff != "" {
+		t.Errorf("saved status mismatch (-want +got):\n%s", diff)
+	}
+
+	// the handler sees a Ready component, and the component still waits for the application after the push
+	err = o.processEvents(ctx, parameters, nil, nil, &componentStatus)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if handlerState != StateReady {
+		t.Errorf("state seen by the handler = %q, want %q", handlerState, StateReady)
+	}
+	if componentStatus.GetState() != StateWaitApplicationReady {
+		t.Errorf("state = %q, want %q", componentStatus.GetState(), StateWaitApplicationReady)
+	}
+}
@@ -14,19 +14,20 @@ import (
 	"github.com/redhat-developer/odo/pkg/util"
 )
 
-func getFullSourcesWatcher(path string, fileIgnores []string) (*fsnotify.Watcher, error) {
+func getFullSourcesWatcher(path string, fileIgnores []string) (*fsnotifySourcesWatcher, error) {
 	absIgnorePaths := dfutil.GetAbsGlobExps(path, fileIgnores)
 
-	watcher, err := fsnotify.NewWatcher()
+	watcher, err := newFsnotifySourcesWatcher()
 	if err != nil {
-		return nil, fmt.Errorf("error setting up filesystem watcher: %v", err)
+		return nil, err
 	}
 
 	// adding watch on the root folder and the sub folders recursively
 	// so directory and the path in addRecursiveWatch() are the same
-	err = addRecursiveWatch(watcher, path, path, absIgnorePaths)
+	err = watcher.AddRecursive(path, path, absIgnorePaths)
 	if err != nil {
-		return nil, fmt.Errorf("error watching source path %s: %v", path, err)
+		_ = watcher.Close()
+		return nil, fmt.Errorf("error watching source path %s: %w", path, err)
 	}
 	return watcher, nil
 }
@@ -37,7 +38,8 @@ func getFullSourcesWatcher(path string, fileIgnores []string) (*fsnotify.Watcher
 // Taken from https://github.com/openshift/origin/blob/85eb37b34f0657631592356d020cef5a58470f8e/pkg/util/fsnotification/fsnotification.go
 // rootPath is the root path of the file or directory,
 // path is the recursive path of the file or the directory,
-// ignores contains the glob rules for matching
+// ignores contains the glob rules for matching.
+// An error wrapping errWatchLimitReached is returned if the system limits on the number of watches are reached
 func addRecursiveWatch(watcher *fsnotify.Watcher, rootPath string, path string, ignores []string) error {
 
 	fsys := filesystem.DefaultFs{}
@@ -70,6 +72,9 @@ func addRecursiveWatch(watcher *fsnotify.Watcher, rootPath string, path string,
 
 			err = watcher.Add(path)
 			if err != nil {
+				if isWatchLimitError(err) {
+					return fmt.Errorf("%w: %v", errWatchLimitReached, err)
+				}
 				klog.V(4).Infof("error adding watcher for path %s: %v", path, err)
 			}
 			return nil
@@ -130,10 +135,10 @@ func addRecursiveWatch(watcher *fsnotify.Watcher, rootPath string, path string,
 		klog.V(4).Infof("adding watch on path %s", folder)
 		err = watcher.Add(folder)
 		if err != nil {
-			// Linux "no space left on device" issues are usually resolved via
-			// $ sudo sysctl fs.inotify.max_user_watches=65536
-			// BSD / OSX: "too many open files" issues are ussualy resolved via
-			// $ sysctl variables "kern.maxfiles" and "kern.maxfilesperproc",
+			// See isWatchLimitError for how to increase the limits
+			if isWatchLimitError(err) {
+				return fmt.Errorf("%w: %v", errWatchLimitReached, err)
+			}
 			klog.V(4).Infof("error adding watcher for path %s: %v", folder, err)
 		}
 	}
@@ -0,0 +1,173 @@
+package watch
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	gosync "sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	gitignore "github.com/sabhiram/go-gitignore"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// pollingSourcesWatcher is a SourcesWatcher regularly scanning the sources for changes.
+// It is used on filesystems not supporting notifications (NFS, SMB, etc),
+// or when the system limits on the number of watches are reached
+type pollingSourcesWatcher struct {
+	path          string
+	ignoreMatcher *gitignore.GitIgnore
+	interval      time.Duration
+
+	// index contains the sources found during the last scan.
+	// The directories are indexed with empty data, as only their creation and deletion are relevant
+	index *util.FileIndex
+
+	events    chan fsnotify.Event
+	errors    chan error
+	done      chan struct{}
+	closeOnce gosync.Once
+}
+
+var _ SourcesWatcher = (*pollingSourcesWatcher)(nil)
+
+func newPollingSourcesWatcher(path string, fileIgnores []string, interval time.Duration) (*pollingSourcesWatcher, error) {
+	o := &pollingSourcesWatcher{
+		path:          path,
+		ignoreMatcher: gitignore.CompileIgnoreLines(fileIgnores...),
+		interval:      interval,
+		events:        make(chan fsnotify.Event),
+		errors:        make(chan error),
+		done:          make(chan struct{}),
+	}
+	index, err := o.scan()
+	if err != nil {
+		return nil, fmt.Errorf("error scanning source path %s: %w", path, err)
+	}
+	o.index = index
+	go o.poll()
+	return o, nil
+}
+
+func (o *pollingSourcesWatcher) Events() <-chan fsnotify.Event {
+	return o.events
+}
+
+func (o *pollingSourcesWatcher) Errors() <-chan error {
+	return o.errors
+}
+
+// AddRecursive is a no-op, as all the sources are scanned at each interval
+func (o *pollingSourcesWatcher) AddRecursive(rootPath string, path string, ignores []string) error {
+	return nil
+}
+
+// Remove is a no-op, as all the sources are scanned at each interval
+func (o *pollingSourcesWatcher) Remove(path string) error {
+	return nil
+}
+
+func (o *pollingSourcesWatcher) Close() error {
+	o.closeOnce.Do(func() {
+		close(o.done)
+	})
+	return nil
+}
+
+// poll scans the sources at each interval and sends the changes found since the previous scan, until the watcher is closed
+func (o *pollingSourcesWatcher) poll() {
+	ticker := time.NewTicker(o.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-o.done:
+			return
+		case <-ticker.C:
+			index, err := o.scan()
+			if err != nil {
+				klog.V(4).Infof("error scanning source path %s: %v", o.path, err)
+				continue
+			}
+			for _, event := range diffFileIndexes(o.path, o.index, index) {
+				select {
+				case o.events <- event:
+				case <-o.done:
+					return
+				}
+			}
+			o.index = index
+		}
+	}
+}
+
+// scan returns an index of the files and directories in the sources, except those matching the ignore rules
+func (o *pollingSourcesWatcher) scan() (*util.FileIndex, error) {
+	index := util.NewFileIndex()
+	err := filepath.Walk(o.path, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			// The file has been deleted since the directory has been read, it will be reported as deleted
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return fmt.Errorf("unable to walk path: %s: %w", path, err)
+		}
+		if path == o.path {
+			return nil
+		}
+		rel, err := util.CalculateFileDataKeyFromPath(path, o.path)
+		if err != nil {
+			return err
+		}
+		if o.ignoreMatcher.MatchesPath(rel) {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if info.IsDir() {
+			index.Files[rel] = util.FileData{}
+			return nil
+		}
+		index.Files[rel] = util.FileData{
+			Size:             info.Size(),
+			LastModifiedDate: info.ModTime(),
+		}
+		return nil
+	})
+	return index, err
+}
+
+// diffFileIndexes returns the events corresponding to the changes between the previous and current indexes
+// of the sources in path
+func diffFileIndexes(path string, previous, current *util.FileIndex) []fsnotify.Event {
+	var events []fsnotify.Event
+	for _, rel := range sortedKeys(current.Files) {
+		data := current.Files[rel]
+		previousData, found := previous.Files[rel]
+		switch {
+		case !found:
+			events = append(events, fsnotify.Event{Name: filepath.Join(path, rel), Op: fsnotify.Create})
+		case data.Size != previousData.Size || !data.LastModifiedDate.Equal(previousData.LastModifiedDate):
+			events = append(events, fsnotify.Event{Name: filepath.Join(path, rel), Op: fsnotify.Write})
+		}
+	}
+	for _, rel := range sortedKeys(previous.Files) {
+		if _, found := current.Files[rel]; !found {
+			events = append(events, fsnotify.Event{Name: filepath.Join(path, rel), Op: fsnotify.Remove})
+		}
+	}
+	return events
+}
+
+func sortedKeys(files map[string]util.FileData) []string {
+	keys := make([]string, 0, len(files))
+	for key := range files {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,110 @@
+package watch
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+func Test_diffFileIndexes(t *testing.T) {
+	now := time.Now()
+	later := now.Add(time.Second)
+	tests := []struct {
+		name     string
+		previous map[string]util.FileData
+		current  map[string]util.FileData
+		want     []fsnotify.Event
+	}{
+		{
+			name:     "no change",
+			previous: map[string]util.FileData{"file1": {Size: 1, LastModifiedDate: now}, "dir": {}},
+			current:  map[string]util.FileData{"file1": {Size: 1, LastModifiedDate: now}, "dir": {}},
+			want:     nil,
+		},
+		{
+			name:     "file and directory created",
+			previous: map[string]util.FileData{},
+			current:  map[string]util.FileData{"dir": {}, filepath.Join("dir", "file1"): {Size: 1, LastModifiedDate: now}},
+			want: []fsnotify.Event{
+				{Name: filepath.Join("/src", "dir"), Op: fsnotify.Create},
+				{Name: filepath.Join("/src", "dir", "file1"), Op: fsnotify.Create},
+			},
+		},
+		{
+			name:     "file modified",
+			previous: map[string]util.FileData{"file1": {Size: 1, LastModifiedDate: now}, "file2": {Size: 1, LastModifiedDate: now}},
+			current:  map[string]util.FileData{"file1": {Size: 1, LastModifiedDate: later}, "file2": {Size: 2, LastModifiedDate: now}},
+			want: []fsnotify.Event{
+				{Name: filepath.Join("/src", "file1"), Op: fsnotify.Write},
+				{Name: filepath.Join("/src", "file2"), Op: fsnotify.Write},
+			},
+		},
+		{
+			name:     "file and directory removed",
+			previous: map[string]util.FileData{"dir": {}, filepath.Join("dir", "file1"): {Size: 1, LastModifiedDate: now}, "file2": {Size: 1, LastModifiedDate: now}},
+			current:  map[string]util.FileData{"file2": {Size: 1, LastModifiedDate: now}},
+			want: []fsnotify.Event{
+				{Name: filepath.Join("/src", "dir"), Op: fsnotify.Remove},
+				{Name: filepath.Join("/src", "dir", "file1"), Op: fsnotify.Remove},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := diffFileIndexes(filepath.FromSlash("/src"), &util.FileIndex{Files: tt.previous}, &util.FileIndex{Files: tt.current})
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("diffFileIndexes() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_pollingSourcesWatcher(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "existing"), []byte("content"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	watcher, err := newPollingSourcesWatcher(dir, []string{"ignored"}, 10*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer watcher.Close()
+
+	if err = os.WriteFile(filepath.Join(dir, "ignored"), []byte("content"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(filepath.Join(dir, "created"), []byte("content"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Remove(filepath.Join(dir, "existing")); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []fsnotify.Event{
+		{Name: filepath.Join(dir, "created"), Op: fsnotify.Create},
+		{Name: filepath.Join(dir, "existing"), Op: fsnotify.Remove},
+	}
+	var got []fsnotify.Event
+	timeout := time.After(5 * time.Second)
+	for len(got) < len(want) {
+		select {
+		case event := <-watcher.Events():
+			// A scan can happen while a file is being written
+			if event.Op != fsnotify.Write {
+				got = append(got, event)
+			}
+		case <-timeout:
+			t.Fatalf("timeout waiting for events, got %v", got)
+		}
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("pollingSourcesWatcher events mismatch (-want +got):\n%s", diff)
+	}
+}
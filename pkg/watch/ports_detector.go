@@ -0,0 +1,117 @@
+package watch
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/fatih/color"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/dev"
+	"github.com/redhat-developer/odo/pkg/log"
+)
+
+// portsDetectionInterval is the interval between two scans of the ports listening in the component, when ports detection is enabled
+const portsDetectionInterval = 5 * time.Second
+
+// portsDetector reports, or forwards, the ports newly listening in the component.
+// A port is handled once, until it is not listening anymore.
+// The ports are detected and forwarded by Scan, outside of the events loop, and the result of the scan is applied by Update.
+// Only one scan must run at a time, and Update must not be called while a scan is running.
+type portsDetector struct {
+	out  io.Writer
+	mode string
+
+	// handled are the container ports already reported or forwarded, and still listening
+	handled map[int]struct{}
+}
+
+// portsScan is the result of a scan of the ports listening in the component
+type portsScan struct {
+	ports []api.ForwardedPort
+	// forwarded are the results of the forwarding of the ports not handled yet, by container port
+	forwarded map[int]forwardResult
+	err       error
+}
+
+// forwardResult is the result of the forwarding of a detected port
+type forwardResult struct {
+	port api.ForwardedPort
+	err  error
+}
+
+func newPortsDetector(out io.Writer, mode string) *portsDetector {
+	return &portsDetector{
+		out:     out,
+		mode:    mode,
+		handled: make(map[int]struct{}),
+	}
+}
+
+// Scan detects the ports listening in the component, and forwards the ports not handled yet with forward,
+// if the ports must be forwarded
+func (o *portsDetector) Scan(detect func() ([]api.ForwardedPort, error), forward func(api.ForwardedPort) (api.ForwardedPort, error)) portsScan {
+	ports, err := detect()
+	if err != nil {
+		return portsScan{err: err}
+	}
+	result := portsScan{
+		ports:     ports,
+		forwarded: make(map[int]forwardResult),
+	}
+	if o.mode != dev.DetectPortsForward {
+		return result
+	}
+	for _, port := range ports {
+		if _, found := o.handled[port.ContainerPort]; found {
+			continue
+		}
+		if _, found := result.forwarded[port.ContainerPort]; found {
+			continue
+		}
+		fwPort, err := forward(port)
+		result.forwarded[port.ContainerPort] = forwardResult{port: fwPort, err: err}
+	}
+	return result
+}
+
+// Update handles the ports detected by the scan which have not been handled yet,
+// by reporting them, or reporting their forwarding, depending on the mode
+func (o *portsDetector) Update(scan portsScan) {
+	if scan.err != nil {
+		klog.V(4).Infof("unable to detect the ports listening in the component: %v", scan.err)
+		return
+	}
+	listening := make(map[int]struct{}, len(scan.ports))
+	for _, port := range scan.ports {
+		listening[port.ContainerPort] = struct{}{}
+		if _, found := o.handled[port.ContainerPort]; found {
+			continue
+		}
+		o.handled[port.ContainerPort] = struct{}{}
+
+		switch o.mode {
+		case dev.DetectPortsForward:
+			forwarded := scan.forwarded[port.ContainerPort]
+			if forwarded.err != nil {
+				log.Fwarningf(o.out, "Unable to forward port %d detected in container %q: %v", port.ContainerPort, port.ContainerName, forwarded.err)
+				continue
+			}
+			fwPort := forwarded.port
+			log.Finfof(o.out, "Port %d detected in container %q", port.ContainerPort, port.ContainerName)
+			s := fmt.Sprintf("Forwarding from %s:%d -> %d", fwPort.LocalAddress, fwPort.LocalPort, fwPort.ContainerPort)
+			fmt.Fprintf(o.out, " -  %s", log.SboldColor(color.FgGreen, s))
+		default:
+			log.Finfof(o.out, "Port %d is listening in container %q, but is not declared as an endpoint in the Devfile. "+
+				"Add it to the endpoints of the container to forward it, or run 'odo dev --detect-ports %s'",
+				port.ContainerPort, port.ContainerName, dev.DetectPortsForward)
+		}
+	}
+	for containerPort := range o.handled {
+		if _, found := listening[containerPort]; !found {
+			delete(o.handled, containerPort)
+		}
+	}
+}
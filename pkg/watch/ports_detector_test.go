@@ -0,0 +1,94 @@
+package watch
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/dev"
+)
+
+func Test_portsDetector(t *testing.T) {
+	port := func(containerPort int) api.ForwardedPort {
+		return api.ForwardedPort{ContainerName: "runtime", ContainerPort: containerPort}
+	}
+	update := func(detector *portsDetector, ports []api.ForwardedPort, forward func(api.ForwardedPort) (api.ForwardedPort, error)) {
+		detector.Update(detector.Scan(func() ([]api.ForwardedPort, error) {
+			return ports, nil
+		}, forward))
+	}
+
+	t.Run("notify", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		detector := newPortsDetector(out, dev.DetectPortsNotify)
+		forward := func(api.ForwardedPort) (api.ForwardedPort, error) {
+			t.Error("ports should not be forwarded")
+			return api.ForwardedPort{}, nil
+		}
+
+		update(detector, []api.ForwardedPort{port(8081)}, forward)
+		if got := strings.Count(out.String(), "Port 8081 is listening"); got != 1 {
+			t.Errorf("port should be reported once, reported %d times, output: %s", got, out.String())
+		}
+
+		// a port still listening is not reported again
+		out.Reset()
+		update(detector, []api.ForwardedPort{port(8081), port(9229)}, forward)
+		if strings.Contains(out.String(), "8081") || !strings.Contains(out.String(), "Port 9229 is listening") {
+			t.Errorf("only port 9229 should be reported, output: %s", out.String())
+		}
+
+		// a port listening again is reported again
+		out.Reset()
+		update(detector, []api.ForwardedPort{port(9229)}, forward)
+		update(detector, []api.ForwardedPort{port(8081), port(9229)}, forward)
+		if !strings.Contains(out.String(), "Port 8081 is listening") {
+			t.Errorf("port 8081 should be reported again, output: %s", out.String())
+		}
+	})
+
+	t.Run("forward", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		detector := newPortsDetector(out, dev.DetectPortsForward)
+		var forwarded []int
+		forward := func(p api.ForwardedPort) (api.ForwardedPort, error) {
+			forwarded = append(forwarded, p.ContainerPort)
+			if p.ContainerPort == 5432 {
+				return api.ForwardedPort{}, errors.New("address already in use")
+			}
+			p.LocalAddress, p.LocalPort = "127.0.0.1", 20002
+			return p, nil
+		}
+
+		update(detector, []api.ForwardedPort{port(5432), port(9229)}, forward)
+		update(detector, []api.ForwardedPort{port(5432)}, forward)
+		if diff := cmp.Diff([]int{5432, 9229}, forwarded); diff != "" {
+			t.Errorf("forwarded ports mismatch (-want +got):\n%s", diff)
+		}
+		if !strings.Contains(out.String(), "Forwarding from 127.0.0.1:20002 -> 9229") {
+			t.Errorf("forwarded port should be displayed, output: %s", out.String())
+		}
+		if !strings.Contains(out.String(), "Unable to forward port 5432") {
+			t.Errorf("forwarding error should be displayed, output: %s", out.String())
+		}
+	})
+
+	t.Run("scan error", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		detector := newPortsDetector(out, dev.DetectPortsNotify)
+		update(detector, []api.ForwardedPort{port(8081)}, nil)
+		detector.Update(detector.Scan(func() ([]api.ForwardedPort, error) {
+			return nil, errors.New("container not running")
+		}, nil))
+		// a failed scan does not forget the handled ports
+		out.Reset()
+		update(detector, []api.ForwardedPort{port(8081)}, nil)
+		if out.Len() != 0 {
+			t.Errorf("port 8081 should not be reported again, output: %s", out.String())
+		}
+	})
+}
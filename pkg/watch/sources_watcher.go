@@ -0,0 +1,96 @@
+package watch
+
+import (
+	"errors"
+	"fmt"
+	"syscall"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/preference"
+)
+
+// errWatchLimitReached is returned when the system limits on the number of watched files are reached
+var errWatchLimitReached = errors.New("limit on the number of watched files reached")
+
+// SourcesWatcher notifies the changes in the source files of the component
+type SourcesWatcher interface {
+	// Events returns the channel on which the changes are sent
+	Events() <-chan fsnotify.Event
+	// Errors returns the channel on which the errors are sent
+	Errors() <-chan error
+	// AddRecursive watches path and its subdirectories, except those matching the ignores rules relative to rootPath
+	AddRecursive(rootPath string, path string, ignores []string) error
+	// Remove stops watching path
+	Remove(path string) error
+	// Close stops watching and releases the resources
+	Close() error
+}
+
+// fsnotifySourcesWatcher is a SourcesWatcher receiving the changes from the filesystem notifications
+type fsnotifySourcesWatcher struct {
+	watcher *fsnotify.Watcher
+}
+
+var _ SourcesWatcher = (*fsnotifySourcesWatcher)(nil)
+
+func newFsnotifySourcesWatcher() (*fsnotifySourcesWatcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("error setting up filesystem watcher: %v", err)
+	}
+	return &fsnotifySourcesWatcher{
+		watcher: watcher,
+	}, nil
+}
+
+func (o *fsnotifySourcesWatcher) Events() <-chan fsnotify.Event {
+	return o.watcher.Events
+}
+
+func (o *fsnotifySourcesWatcher) Errors() <-chan error {
+	return o.watcher.Errors
+}
+
+func (o *fsnotifySourcesWatcher) AddRecursive(rootPath string, path string, ignores []string) error {
+	return addRecursiveWatch(o.watcher, rootPath, path, ignores)
+}
+
+func (o *fsnotifySourcesWatcher) Remove(path string) error {
+	return o.watcher.Remove(path)
+}
+
+func (o *fsnotifySourcesWatcher) Close() error {
+	return o.watcher.Close()
+}
+
+// isWatchLimitError returns true if err indicates that the system limits on the number of watches
+// or open files are reached.
+// Linux "no space left on device" issues are usually resolved via
+// $ sudo sysctl fs.inotify.max_user_watches=65536
+// BSD / OSX: "too many open files" issues are usually resolved via
+// $ sysctl variables "kern.maxfiles" and "kern.maxfilesperproc"
+func isWatchLimitError(err error) bool {
+	return errors.Is(err, syscall.ENOSPC) || errors.Is(err, syscall.EMFILE)
+}
+
+// getSourcesWatcher returns a watcher of the sources in path, using the backend.
+// When the fsnotify backend cannot watch all the sources because of the system limits,
+// the polling backend is used instead
+func getSourcesWatcher(path string, fileIgnores []string, backend string, pollingInterval time.Duration) (SourcesWatcher, error) {
+	if backend == preference.WatchBackendPolling {
+		klog.V(2).Infof("polling sources every %s", pollingInterval)
+		return newPollingSourcesWatcher(path, fileIgnores, pollingInterval)
+	}
+
+	watcher, err := getFullSourcesWatcher(path, fileIgnores)
+	if errors.Is(err, errWatchLimitReached) {
+		klog.V(2).Infof("falling back to polling: %v", err)
+		log.Warningf("Unable to watch all the source files with filesystem notifications, polling them every %s instead", pollingInterval)
+		return newPollingSourcesWatcher(path, fileIgnores, pollingInterval)
+	}
+	return watcher, err
+}
@@ -3,6 +3,8 @@ package watch
 import (
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/state"
 )
 
 type State string
@@ -15,7 +17,10 @@ const (
 	//StateFilesSynced          State = "FilesSynced"
 	//StateBuildCommandExecuted State = "BuildCommandExecuted"
 	//StateRunCommandRunning    State = "RunCommandRunning"
-	StateReady State = "Ready"
+	// StateWaitApplicationReady indicates that the component is deployed and the run (or debug) command started,
+	// but that no line of the logs of the application matched the ready pattern of the command yet
+	StateWaitApplicationReady State = "WaitApplicationReady"
+	StateReady                State = "Ready"
 )
 
 type ComponentStatus struct {
@@ -23,7 +28,9 @@ type ComponentStatus struct {
 	PostStartEventsDone bool
 	// RunExecuted is set to true when the run command has been executed
 	// Used for HotReload capability
-	RunExecuted        bool
+	RunExecuted bool
+	// RunCommandStarts is incremented each time the run (or debug) command is started
+	RunCommandStarts   int
 	EndpointsForwarded map[string][]v1alpha2.Endpoint
 	// ImageComponentsAutoApplied is a cache of all image components that have been auto-applied.
 	// This map allows to avoid applying them too many times upon state changes in the cluster for example.
@@ -39,6 +46,27 @@ func (o *ComponentStatus) GetState() State {
 	return o.state
 }
 
+// ToSession returns the part of the status to persist in the state of the session
+func (o *ComponentStatus) ToSession() state.Session {
+	return state.Session{
+		PostStartEventsDone:        o.PostStartEventsDone,
+		RunExecuted:                o.RunExecuted,
+		ImageComponentsAutoApplied: o.ImageComponentsAutoApplied,
+	}
+}
+
+// RestoreSession restores the status from the state of a previous session
+func (o *ComponentStatus) RestoreSession(session state.Session) {
+	o.PostStartEventsDone = session.PostStartEventsDone
+	o.RunExecuted = session.RunExecuted
+	if o.ImageComponentsAutoApplied == nil {
+		o.ImageComponentsAutoApplied = make(map[string]v1alpha2.ImageComponent)
+	}
+	for name, image := range session.ImageComponentsAutoApplied {
+		o.ImageComponentsAutoApplied[name] = image
+	}
+}
+
 func componentCanSyncFile(state State) bool {
-	return state == StateReady
+	return state == StateReady || state == StateWaitApplicationReady
 }
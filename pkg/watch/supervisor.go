@@ -0,0 +1,142 @@
+package watch
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/dev"
+	"github.com/redhat-developer/odo/pkg/log"
+)
+
+// healthCheckInterval is the interval between two checks of the run command, when auto-restart is enabled
+const healthCheckInterval = 5 * time.Second
+
+// healthCheckResult is the result of a check of the run command, running outside of the events loop
+type healthCheckResult struct {
+	// generation is the generation of the supervisor when the check started
+	generation int
+	healthy    bool
+	reason     string
+	err        error
+}
+
+// runCommandSupervisor follows the health of the run (or debug) command and decides when to restart it.
+// The number of restarts and the backoff are reset after each push.
+// The checks run asynchronously: a check is started by StartCheck, and its result applied by EndCheck.
+type runCommandSupervisor struct {
+	out         io.Writer
+	notifier    dev.EventsNotifier
+	maxRestarts int
+
+	backoff *ExpBackoff
+	// restartTimer fires when the command needs to be restarted
+	restartTimer *time.Timer
+
+	healthy        bool
+	restartPending bool
+	gaveUp         bool
+	restarts       int
+
+	// checking is true while a check of the command is running
+	checking bool
+	// generation is incremented each time the command is restarted or the component pushed,
+	// so the result of a check started before is discarded
+	generation int
+}
+
+func newRunCommandSupervisor(out io.Writer, notifier dev.EventsNotifier, maxRestarts int) *runCommandSupervisor {
+	restartTimer := time.NewTimer(time.Millisecond)
+	<-restartTimer.C
+	return &runCommandSupervisor{
+		out:          out,
+		notifier:     notifier,
+		maxRestarts:  maxRestarts,
+		backoff:      NewExpBackoff(),
+		restartTimer: restartTimer,
+		healthy:      true,
+	}
+}
+
+// RestartC returns the channel on which the time is sent when the command needs to be restarted
+func (o *runCommandSupervisor) RestartC() <-chan time.Time {
+	return o.restartTimer.C
+}
+
+// CanCheck returns true if the health of the command needs to be checked:
+// no check is running, no restart is pending, and the supervisor did not give up restarting the command
+func (o *runCommandSupervisor) CanCheck() bool {
+	return !o.checking && !o.restartPending && !o.gaveUp
+}
+
+// StartCheck is called before starting a check of the command, and returns the generation to pass to EndCheck
+func (o *runCommandSupervisor) StartCheck() int {
+	o.checking = true
+	return o.generation
+}
+
+// EndCheck applies the result of a check started by StartCheck.
+// The result is discarded if the component is not ready, or if the command has been restarted
+// or the component pushed since the check started
+func (o *runCommandSupervisor) EndCheck(result healthCheckResult, ready bool) {
+	o.checking = false
+	if result.err != nil {
+		klog.V(4).Infof("unable to check the health of the run command: %v", result.err)
+		return
+	}
+	if !ready || result.generation != o.generation {
+		klog.V(4).Infof("discarding the result of the health check of the run command")
+		return
+	}
+	o.Update(result.healthy, result.reason)
+}
+
+// Update reports the transitions of the health of the command,
+// and schedules a restart if the command is not healthy
+func (o *runCommandSupervisor) Update(healthy bool, reason string) {
+	if healthy != o.healthy {
+		o.healthy = healthy
+		if healthy {
+			log.Finfof(o.out, "The application is running again")
+		} else {
+			log.Fwarningf(o.out, "The application is not running: %s", reason)
+		}
+		if o.notifier != nil {
+			o.notifier.NotifyRunCommandHealthChanged(healthy, reason)
+		}
+	}
+	if healthy || o.restartPending || o.gaveUp {
+		return
+	}
+	if o.restarts >= o.maxRestarts {
+		o.gaveUp = true
+		log.Fwarningf(o.out, "The application has been restarted %d time(s), it will not be restarted again until the next push", o.restarts)
+		return
+	}
+	o.restartPending = true
+	o.restartTimer.Reset(o.backoff.Delay())
+}
+
+// StartRestart is called when the restart timer fires, before restarting the command
+func (o *runCommandSupervisor) StartRestart() {
+	o.restartPending = false
+	o.generation++
+	o.restarts++
+	klog.V(2).Infof("restarting the run command, attempt %d/%d", o.restarts, o.maxRestarts)
+	fmt.Fprintf(o.out, "Restarting the application (%d/%d)\n", o.restarts, o.maxRestarts)
+}
+
+// Reset forgets the restarts and cancels the pending restart, after the component has been pushed.
+// The health is not reset, so a recovery is reported by the next check
+func (o *runCommandSupervisor) Reset() {
+	if o.restartPending && !o.restartTimer.Stop() {
+		<-o.restartTimer.C
+	}
+	o.restartPending = false
+	o.gaveUp = false
+	o.generation++
+	o.restarts = 0
+	o.backoff.Reset()
+}
@@ -0,0 +1,134 @@
+package watch
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type healthNotification struct {
+	healthy bool
+	reason  string
+}
+
+type fakeNotifier struct {
+	notifications []healthNotification
+}
+
+func (o *fakeNotifier) NotifyStatusChanged(string) {}
+
+func (o *fakeNotifier) NotifyFilesSynced([]string, []string) {}
+
+func (o *fakeNotifier) NotifyRunCommandHealthChanged(healthy bool, reason string) {
+	o.notifications = append(o.notifications, healthNotification{healthy: healthy, reason: reason})
+}
+
+func waitRestart(t *testing.T, supervisor *runCommandSupervisor) {
+	t.Helper()
+	select {
+	case <-supervisor.RestartC():
+		supervisor.StartRestart()
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for the restart")
+	}
+}
+
+func Test_runCommandSupervisor(t *testing.T) {
+	out := &bytes.Buffer{}
+	notifier := &fakeNotifier{}
+	supervisor := newRunCommandSupervisor(out, notifier, 2)
+
+	// healthy command, nothing to do
+	supervisor.Update(true, "")
+	if !supervisor.CanCheck() {
+		t.Fatal("expected to check a healthy command")
+	}
+
+	// the command stops, it is restarted twice
+	supervisor.Update(false, "stopped")
+	if supervisor.CanCheck() {
+		t.Fatal("expected no check while a restart is pending")
+	}
+	waitRestart(t, supervisor)
+	supervisor.Update(false, "stopped")
+	waitRestart(t, supervisor)
+
+	// the command is still stopped, the limit is reached
+	supervisor.Update(false, "stopped")
+	if supervisor.CanCheck() {
+		t.Fatal("expected no check after the limit of restarts is reached")
+	}
+
+	// a push resets the restarts, and the command runs again
+	supervisor.Reset()
+	if !supervisor.CanCheck() {
+		t.Fatal("expected to check the command after a push")
+	}
+	supervisor.Update(true, "")
+
+	wantNotifications := []healthNotification{
+		{healthy: false, reason: "stopped"},
+		{healthy: true},
+	}
+	if diff := cmp.Diff(wantNotifications, notifier.notifications, cmp.AllowUnexported(healthNotification{})); diff != "" {
+		t.Errorf("notifications mismatch (-want +got):\n%s", diff)
+	}
+	for _, want := range []string{
+		"The application is not running: stopped",
+		"Restarting the application (1/2)",
+		"Restarting the application (2/2)",
+		"The application has been restarted 2 time(s)",
+		"The application is running again",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("output %q should contain %q", out.String(), want)
+		}
+	}
+}
+
+func Test_runCommandSupervisor_ResetCancelsRestart(t *testing.T) {
+	supervisor := newRunCommandSupervisor(&bytes.Buffer{}, nil, 1)
+	supervisor.Update(false, "stopped")
+	supervisor.Reset()
+	select {
+	case <-supervisor.RestartC():
+		t.Error("expected the pending restart to be cancelled")
+	case <-time.After(500 * time.Millisecond):
+	}
+}
+
+func Test_runCommandSupervisor_EndCheck(t *testing.T) {
+	notifier := &fakeNotifier{}
+	supervisor := newRunCommandSupervisor(&bytes.Buffer{}, notifier, 1)
+
+	// no other check is started while a check is running
+	generation := supervisor.StartCheck()
+	if supervisor.CanCheck() {
+		t.Fatal("expected no check while a check is running")
+	}
+
+	// the component is pushed while the check is running, the result is discarded
+	supervisor.Reset()
+	supervisor.EndCheck(healthCheckResult{generation: generation, healthy: false, reason: "stopped"}, true)
+	if !supervisor.CanCheck() {
+		t.Fatal("expected to check the command after the end of a check")
+	}
+
+	// the component is not ready anymore, the result is discarded
+	generation = supervisor.StartCheck()
+	supervisor.EndCheck(healthCheckResult{generation: generation, healthy: false, reason: "stopped"}, false)
+
+	if len(notifier.notifications) != 0 {
+		t.Fatalf("expected the results to be discarded, got %v", notifier.notifications)
+	}
+
+	// the result of a check started after the push is applied
+	generation = supervisor.StartCheck()
+	supervisor.EndCheck(healthCheckResult{generation: generation, healthy: false, reason: "stopped"}, true)
+	if len(notifier.notifications) != 1 {
+		t.Fatalf("expected the result to be applied, got %v", notifier.notifications)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/redhat-developer/odo/pkg/api"
 	"github.com/redhat-developer/odo/pkg/dev"
 	"github.com/redhat-developer/odo/pkg/dev/common"
 	"github.com/redhat-developer/odo/pkg/informer"
@@ -34,13 +35,17 @@ import (
 const (
 	// PushErrorString is the string that is printed when an error occurs during watch's Push operation
 	PushErrorString = "Error occurred on Push"
+
+	// defaultMaxSyncBatchDuration is the maximum time during which file events are coalesced before being synced,
+	// even if files are still changing, unless twice the sync delay is longer (see getMaxSyncBatchDuration)
+	defaultMaxSyncBatchDuration = 5 * time.Second
 )
 
 type WatchClient struct {
 	kubeClient     kclient.ClientInterface
 	informerClient *informer.InformerClient
 
-	sourcesWatcher    *fsnotify.Watcher
+	sourcesWatcher    SourcesWatcher
 	deploymentWatcher watch.Interface
 	devfileWatcher    *fsnotify.Watcher
 	podWatcher        watch.Interface
@@ -53,6 +58,10 @@ type WatchClient struct {
 	// deploymentGeneration indicates the generation of the latest observed Deployment
 	deploymentGeneration int64
 	readyReplicas        int32
+
+	// applicationStatus follows the status of the application from its logs, nil if the run (or debug) command
+	// does not declare log patterns
+	applicationStatus *applicationStatus
 }
 
 var _ Client = (*WatchClient)(nil)
@@ -82,12 +91,29 @@ type WatchParameters struct {
 
 	// WatchCluster indicates to watch Cluster-related objects (Deployment, Pod, etc)
 	WatchCluster bool
+
+	// HealthCheckHandler checks that the run (or debug) command is healthy, when StartOptions.AutoRestart is set.
+	// It returns false and the reason if the command is not healthy
+	HealthCheckHandler func(context.Context, dev.StartOptions) (bool, string, error)
+	// RunCommandRestartHandler restarts the run (or debug) command, when HealthCheckHandler reports it is not healthy
+	RunCommandRestartHandler func(context.Context, dev.StartOptions) error
+
+	// PortsDetectionHandler returns the ports listening in the component which are neither declared as endpoints nor forwarded,
+	// when StartOptions.DetectPorts is set
+	PortsDetectionHandler func(context.Context, dev.StartOptions) ([]api.ForwardedPort, error)
+	// DetectedPortForwardHandler forwards a port returned by PortsDetectionHandler, when StartOptions.DetectPorts is dev.DetectPortsForward.
+	// It returns the forwarded port
+	DetectedPortForwardHandler func(context.Context, dev.StartOptions, api.ForwardedPort) (api.ForwardedPort, error)
+
+	// ApplicationStatusHandler saves the status of the application, when it changes,
+	// if the run (or debug) command declares log patterns and StartOptions.ApplicationLogs is set
+	ApplicationStatusHandler func(context.Context, api.ApplicationStatus) error
 }
 
 // evaluateChangesFunc evaluates any file changes for the events by ignoring the files in fileIgnores slice and removes
 // any deleted paths from the watcher. It returns a slice of changed files (if any) and paths that are deleted (if any)
 // by the events
-type evaluateChangesFunc func(events []fsnotify.Event, path string, fileIgnores []string, watcher *fsnotify.Watcher) (changedFiles, deletedPaths []string)
+type evaluateChangesFunc func(events []fsnotify.Event, path string, fileIgnores []string, watcher SourcesWatcher) (changedFiles, deletedPaths []string)
 
 // processEventsFunc processes the events received on the watcher. It uses the WatchParameters to trigger watch handler and writes to out
 // It returns a Duration after which to recall in case of error
@@ -106,12 +132,12 @@ func (o *WatchClient) WatchAndPush(ctx context.Context, parameters WatchParamete
 
 	var err error
 	if parameters.StartOptions.WatchFiles {
-		o.sourcesWatcher, err = getFullSourcesWatcher(path, parameters.StartOptions.IgnorePaths)
+		o.sourcesWatcher, err = getSourcesWatcher(path, parameters.StartOptions.IgnorePaths, parameters.StartOptions.WatchBackend, parameters.StartOptions.WatchPollingInterval)
 		if err != nil {
 			return err
 		}
 	} else {
-		o.sourcesWatcher, err = fsnotify.NewWatcher()
+		o.sourcesWatcher, err = newFsnotifySourcesWatcher()
 		if err != nil {
 			return err
 		}
@@ -166,7 +192,19 @@ func (o *WatchClient) WatchAndPush(ctx context.Context, parameters WatchParamete
 		o.warningsWatcher = NewNoOpWatcher()
 	}
 
-	o.keyWatcher = getKeyWatcher(ctx, parameters.StartOptions.Out)
+	o.keyWatcher = GetKeyWatcher(ctx, parameters.StartOptions.Out)
+
+	if parameters.StartOptions.ApplicationLogs != nil {
+		var patterns *common.LogPatterns
+		patterns, err = common.GetRunCommandLogPatterns(*devfileObj, parameters.StartOptions)
+		if err != nil {
+			return err
+		}
+		if patterns != nil {
+			o.applicationStatus = newApplicationStatus(parameters.StartOptions.Out, *patterns)
+			o.saveApplicationStatus(ctx, parameters)
+		}
+	}
 
 	err = o.processEvents(ctx, parameters, nil, nil, &componentStatus)
 	if err != nil {
@@ -196,11 +234,17 @@ func (o *WatchClient) eventWatcher(
 	)
 
 	var events []fsnotify.Event
+	// batchStart is the time at which the first event of the current batch of events has been received
+	var batchStart time.Time
+
+	// A zero delay syncs the changes as soon as they are received, without waiting for more changes
+	syncDelay := parameters.StartOptions.SyncDelay
 
 	// sourcesTimer helps collect multiple events that happen in a quick succession. We start with 1ms as we don't care much
 	// at this point. In the select block, however, every time we receive an event, we reset the sourcesTimer to watch for
-	// 100ms since receiving that event. This is done because a single filesystem event by the user triggers multiple
-	// events for fsnotify. It's a known-issue, but not really bug. For more info look at below issues:
+	// syncDelay since receiving that event, without exceeding getMaxSyncBatchDuration since the first event of the batch.
+	// This is done because a single filesystem event by the user triggers multiple events for fsnotify,
+	// and because operations like a branch switch change many files. It's a known-issue, but not really bug. For more info look at below issues:
 	//    - https://github.com/fsnotify/fsnotify/issues/122
 	//    - https://github.com/fsnotify/fsnotify/issues/344
 	sourcesTimer := time.NewTimer(time.Millisecond)
@@ -216,12 +260,40 @@ func (o *WatchClient) eventWatcher(
 
 	podsPhases := NewPodPhases()
 
+	// healthTicker regularly triggers a check of the run command, when auto-restart is enabled
+	var healthTickerC <-chan time.Time
+	if parameters.StartOptions.AutoRestart && parameters.HealthCheckHandler != nil && parameters.RunCommandRestartHandler != nil {
+		healthTicker := time.NewTicker(healthCheckInterval)
+		defer healthTicker.Stop()
+		healthTickerC = healthTicker.C
+	}
+	supervisor := newRunCommandSupervisor(out, parameters.StartOptions.EventsNotifier, parameters.StartOptions.MaxRestarts)
+	// healthResults receives the results of the health checks, which run in their own goroutine
+	// so a slow check does not block the events loop
+	healthResults := make(chan healthCheckResult, 1)
+
+	// portsTicker regularly triggers a scan of the ports listening in the component, when ports detection is enabled
+	var portsTickerC <-chan time.Time
+	if parameters.StartOptions.DetectPorts != "" && parameters.PortsDetectionHandler != nil && parameters.DetectedPortForwardHandler != nil {
+		portsTicker := time.NewTicker(portsDetectionInterval)
+		defer portsTicker.Stop()
+		portsTickerC = portsTicker.C
+	}
+	detector := newPortsDetector(out, parameters.StartOptions.DetectPorts)
+	// portsScanning is true while a scan of the ports is running in its own goroutine,
+	// which sends its result to portsResults
+	portsScanning := false
+	portsResults := make(chan portsScan, 1)
+
 	for {
 		select {
-		case event := <-o.sourcesWatcher.Events:
+		case event := <-o.sourcesWatcher.Events():
+			if len(events) == 0 {
+				batchStart = time.Now()
+			}
 			events = append(events, event)
 			// We are waiting for more events in this interval
-			sourcesTimer.Reset(100 * time.Millisecond)
+			sourcesTimer.Reset(getSyncTimerDuration(syncDelay, time.Since(batchStart)))
 
 		case <-sourcesTimer.C:
 			// timer has fired
@@ -242,17 +314,22 @@ func (o *WatchClient) eventWatcher(
 
 			componentStatus.SetState(StateSyncOutdated)
 			fmt.Fprintf(out, "Pushing files...\n\n")
+			if len(events) > 1 {
+				klog.V(2).Infof("%d file events coalesced in %s", len(events), time.Since(batchStart))
+				fmt.Fprintf(out, "%d file events coalesced into this push\n", len(events))
+			}
 			err := processEventsHandler(ctx, parameters, changedFiles, deletedPaths, &componentStatus)
 			o.forceSync = false
 			if err != nil {
 				return err
 			}
+			supervisor.Reset()
 			// empty the events to receive new events
-			if componentStatus.GetState() == StateReady {
+			if componentCanSyncFile(componentStatus.GetState()) {
 				events = []fsnotify.Event{} // empty the events slice to capture new events
 			}
 
-		case watchErr := <-o.sourcesWatcher.Errors:
+		case watchErr := <-o.sourcesWatcher.Errors():
 			return watchErr
 
 		case key := <-o.keyWatcher:
@@ -285,6 +362,7 @@ func (o *WatchClient) eventWatcher(
 			if err != nil {
 				return err
 			}
+			supervisor.Reset()
 
 		case <-o.devfileWatcher.Events:
 			devfileTimer.Reset(100 * time.Millisecond)
@@ -295,6 +373,70 @@ func (o *WatchClient) eventWatcher(
 			if err != nil {
 				return err
 			}
+			supervisor.Reset()
+
+		case <-healthTickerC:
+			if componentStatus.GetState() != StateReady || !componentStatus.RunExecuted || !supervisor.CanCheck() {
+				continue
+			}
+			generation := supervisor.StartCheck()
+			go func() {
+				healthy, reason, err := parameters.HealthCheckHandler(ctx, parameters.StartOptions)
+				healthResults <- healthCheckResult{
+					generation: generation,
+					healthy:    healthy,
+					reason:     reason,
+					err:        err,
+				}
+			}()
+
+		case result := <-healthResults:
+			supervisor.EndCheck(result, componentStatus.GetState() == StateReady && componentStatus.RunExecuted)
+
+		case <-portsTickerC:
+			if portsScanning || componentStatus.GetState() != StateReady {
+				continue
+			}
+			portsScanning = true
+			go func() {
+				portsResults <- detector.Scan(func() ([]api.ForwardedPort, error) {
+					return parameters.PortsDetectionHandler(ctx, parameters.StartOptions)
+				}, func(port api.ForwardedPort) (api.ForwardedPort, error) {
+					return parameters.DetectedPortForwardHandler(ctx, parameters.StartOptions, port)
+				})
+			}()
+
+		case scan := <-portsResults:
+			portsScanning = false
+			detector.Update(scan)
+
+		case <-supervisor.RestartC():
+			supervisor.StartRestart()
+			restartedAt := time.Now()
+			err := parameters.RunCommandRestartHandler(ctx, parameters.StartOptions)
+			if err != nil {
+				log.Fwarningf(out, "Unable to restart the application: %v", err)
+				continue
+			}
+			if o.applicationStatus != nil {
+				o.applicationStatus.CommandStarted(restartedAt)
+				o.saveApplicationStatus(ctx, parameters)
+				o.holdUntilApplicationReady(parameters, &componentStatus)
+			}
+
+		case line := <-parameters.StartOptions.ApplicationLogs:
+			ready, changed := o.applicationStatus.Update(line)
+			if changed {
+				o.saveApplicationStatus(ctx, parameters)
+			}
+			if ready && componentStatus.GetState() == StateWaitApplicationReady {
+				componentStatus.SetState(StateReady)
+				if notifier := parameters.StartOptions.EventsNotifier; notifier != nil {
+					notifier.NotifyStatusChanged(string(componentStatus.GetState()))
+				}
+				log.Finfof(out, "The application is ready")
+				o.printInfoMessage(out, path, parameters.StartOptions.WatchFiles)
+			}
 
 		case ev := <-o.podWatcher.ResultChan():
 			switch ev.Type {
@@ -336,9 +478,31 @@ func (o *WatchClient) eventWatcher(
 	}
 }
 
+// getMaxSyncBatchDuration returns the maximum time during which file events are coalesced before being synced,
+// so that a sync delay longer than defaultMaxSyncBatchDuration is honored
+func getMaxSyncBatchDuration(syncDelay time.Duration) time.Duration {
+	if 2*syncDelay > defaultMaxSyncBatchDuration {
+		return 2 * syncDelay
+	}
+	return defaultMaxSyncBatchDuration
+}
+
+// getSyncTimerDuration returns the duration to wait for more file events before syncing the files,
+// when the first event of the batch has been received elapsed ago
+func getSyncTimerDuration(syncDelay time.Duration, elapsed time.Duration) time.Duration {
+	remaining := getMaxSyncBatchDuration(syncDelay) - elapsed
+	if remaining < 0 {
+		return 0
+	}
+	if syncDelay < remaining {
+		return syncDelay
+	}
+	return remaining
+}
+
 // evaluateFileChanges evaluates any file changes for the events. It ignores the files in fileIgnores slice related to path, and removes
 // any deleted paths from the watcher
-func evaluateFileChanges(events []fsnotify.Event, path string, fileIgnores []string, watcher *fsnotify.Watcher) ([]string, []string) {
+func evaluateFileChanges(events []fsnotify.Event, path string, fileIgnores []string, watcher SourcesWatcher) ([]string, []string) {
 	var changedFiles []string
 	var deletedPaths []string
 
@@ -391,7 +555,7 @@ func evaluateFileChanges(events []fsnotify.Event, path string, fileIgnores []str
 			}
 		} else {
 			// On other ops, recursively watch the resource (if applicable)
-			if e := addRecursiveWatch(watcher, path, event.Name, fileIgnores); e != nil && watchError == nil {
+			if e := watcher.AddRecursive(path, event.Name, fileIgnores); e != nil && watchError == nil {
 				klog.V(4).Infof("Error occurred in addRecursiveWatch, setting watchError to %v", e)
 				watchError = e
 			}
@@ -429,7 +593,22 @@ func (o *WatchClient) processEvents(
 		DevfileScanIndexForWatch: !hasFirstSuccessfulPushOccurred,
 	}
 	oldStatus := *componentStatus
+	// The platform-specific handlers are not aware of the readiness of the application
+	if componentStatus.GetState() == StateWaitApplicationReady {
+		componentStatus.SetState(StateReady)
+	}
+	// The command is started by the handler, the lines of logs written before are not related to this execution
+	pushedAt := time.Now()
 	err := parameters.DevfileWatchHandler(ctx, pushParams, componentStatus)
+	if o.applicationStatus != nil {
+		if componentStatus.RunCommandStarts != oldStatus.RunCommandStarts {
+			o.applicationStatus.CommandStarted(pushedAt)
+			o.saveApplicationStatus(ctx, parameters)
+		}
+		if componentStatus.GetState() == StateReady && o.applicationStatus.IsWaitingReady() {
+			componentStatus.SetState(StateWaitApplicationReady)
+		}
+	}
 	if err != nil {
 		if isFatal(err) {
 			return err
@@ -452,6 +631,14 @@ func (o *WatchClient) processEvents(
 		}
 		return nil
 	}
+	if notifier := parameters.StartOptions.EventsNotifier; notifier != nil {
+		if oldStatus.GetState() != componentStatus.GetState() {
+			notifier.NotifyStatusChanged(string(componentStatus.GetState()))
+		}
+		if len(changedFiles) != 0 || len(deletedPaths) != 0 {
+			notifier.NotifyFilesSynced(changedFiles, deletedPaths)
+		}
+	}
 	if oldStatus.GetState() != StateReady && componentStatus.GetState() == StateReady ||
 		!reflect.DeepEqual(oldStatus.EndpointsForwarded, componentStatus.EndpointsForwarded) {
 
@@ -460,6 +647,29 @@ func (o *WatchClient) processEvents(
 	return nil
 }
 
+// holdUntilApplicationReady sets the state of a Ready component to StateWaitApplicationReady,
+// when the application is not ready yet
+func (o *WatchClient) holdUntilApplicationReady(parameters WatchParameters, componentStatus *ComponentStatus) {
+	if componentStatus.GetState() != StateReady || !o.applicationStatus.IsWaitingReady() {
+		return
+	}
+	componentStatus.SetState(StateWaitApplicationReady)
+	if notifier := parameters.StartOptions.EventsNotifier; notifier != nil {
+		notifier.NotifyStatusChanged(string(componentStatus.GetState()))
+	}
+}
+
+// saveApplicationStatus saves the status of the application with parameters.ApplicationStatusHandler, if set
+func (o *WatchClient) saveApplicationStatus(ctx context.Context, parameters WatchParameters) {
+	if parameters.ApplicationStatusHandler == nil {
+		return
+	}
+	err := parameters.ApplicationStatusHandler(ctx, o.applicationStatus.Status())
+	if err != nil {
+		klog.V(4).Infof("unable to save the status of the application: %v", err)
+	}
+}
+
 func shouldIgnoreEvent(event fsnotify.Event) (ignoreEvent bool) {
 	if !(event.Op&fsnotify.Remove == fsnotify.Remove || event.Op&fsnotify.Rename == fsnotify.Rename) {
 		stat, err := os.Lstat(event.Name)
@@ -511,6 +721,9 @@ func (o *WatchClient) printInfoMessage(out io.Writer, path string, watchFiles bo
 			path,
 		)
 	}
+	if status := o.applicationStatus.StatusLine(); status != "" {
+		fmt.Fprintf(out, " %s %s\n\n", log.Sbold("Application:"), status)
+	}
 	fmt.Fprint(
 		out,
 		o.informerClient.GetInfo(),
@@ -15,7 +15,7 @@ import (
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
 )
 
-func evaluateChangesHandler(events []fsnotify.Event, path string, fileIgnores []string, watcher *fsnotify.Watcher) ([]string, []string) {
+func evaluateChangesHandler(events []fsnotify.Event, path string, fileIgnores []string, watcher SourcesWatcher) ([]string, []string) {
 	var changedFiles []string
 	var deletedPaths []string
 
@@ -66,14 +66,20 @@ func Test_eventWatcher(t *testing.T) {
 		wantOut       string
 		wantErr       bool
 		watcherEvents []fsnotify.Event
-		watcherError  error
+		// eventsInterval is the delay between two watcher events
+		eventsInterval time.Duration
+		watcherError   error
 	}{
 		{
 			name: "Case 1: Multiple events, no errors",
 			args: args{
-				parameters: WatchParameters{},
+				parameters: WatchParameters{
+					StartOptions: dev.StartOptions{
+						SyncDelay: 100 * time.Millisecond,
+					},
+				},
 			},
-			wantOut:       "Pushing files...\n\nchangedFiles [file1 file2] deletedPaths []\n",
+			wantOut:       "Pushing files...\n\n2 file events coalesced into this push\nchangedFiles [file1 file2] deletedPaths []\n",
 			wantErr:       false,
 			watcherEvents: []fsnotify.Event{{Name: "file1", Op: fsnotify.Create}, {Name: "file2", Op: fsnotify.Write}},
 			watcherError:  nil,
@@ -81,7 +87,11 @@ func Test_eventWatcher(t *testing.T) {
 		{
 			name: "Case 2: Multiple events, one error",
 			args: args{
-				parameters: WatchParameters{},
+				parameters: WatchParameters{
+					StartOptions: dev.StartOptions{
+						SyncDelay: 100 * time.Millisecond,
+					},
+				},
 			},
 			wantOut:       "",
 			wantErr:       true,
@@ -94,10 +104,11 @@ func Test_eventWatcher(t *testing.T) {
 				parameters: WatchParameters{
 					StartOptions: dev.StartOptions{
 						IgnorePaths: []string{"file1"},
+						SyncDelay:   100 * time.Millisecond,
 					},
 				},
 			},
-			wantOut:       "Pushing files...\n\nchangedFiles [] deletedPaths [file1 file2]\n",
+			wantOut:       "Pushing files...\n\n2 file events coalesced into this push\nchangedFiles [] deletedPaths [file1 file2]\n",
 			wantErr:       false,
 			watcherEvents: []fsnotify.Event{{Name: "file1", Op: fsnotify.Remove}, {Name: "file2", Op: fsnotify.Rename}},
 			watcherError:  nil,
@@ -112,6 +123,30 @@ func Test_eventWatcher(t *testing.T) {
 			watcherEvents: nil,
 			watcherError:  fmt.Errorf("error1"),
 		},
+		{
+			name: "Case 5: Events separated by less than the sync delay are pushed once",
+			args: args{
+				parameters: WatchParameters{
+					StartOptions: dev.StartOptions{
+						SyncDelay: 300 * time.Millisecond,
+					},
+				},
+			},
+			wantOut:        "Pushing files...\n\n3 file events coalesced into this push\nchangedFiles [file1 file2 file3] deletedPaths []\n",
+			wantErr:        false,
+			watcherEvents:  []fsnotify.Event{{Name: "file1", Op: fsnotify.Create}, {Name: "file2", Op: fsnotify.Write}, {Name: "file3", Op: fsnotify.Write}},
+			eventsInterval: 150 * time.Millisecond,
+		},
+		{
+			name: "Case 6: Zero sync delay pushes the first event without waiting for more events",
+			args: args{
+				parameters: WatchParameters{},
+			},
+			wantOut:        "Pushing files...\n\nchangedFiles [file1] deletedPaths []\n",
+			wantErr:        false,
+			watcherEvents:  []fsnotify.Event{{Name: "file1", Op: fsnotify.Create}, {Name: "file2", Op: fsnotify.Write}},
+			eventsInterval: 50 * time.Millisecond,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -127,6 +162,7 @@ func Test_eventWatcher(t *testing.T) {
 			go func() {
 				for _, event := range tt.watcherEvents {
 					watcher.Events <- event
+					time.Sleep(tt.eventsInterval)
 				}
 
 				if tt.watcherError != nil {
@@ -140,7 +176,7 @@ func Test_eventWatcher(t *testing.T) {
 			componentStatus.SetState(StateReady)
 
 			o := WatchClient{
-				sourcesWatcher:    watcher,
+				sourcesWatcher:    &fsnotifySourcesWatcher{watcher: watcher},
 				deploymentWatcher: fakeWatcher{},
 				podWatcher:        fakeWatcher{},
 				warningsWatcher:   fakeWatcher{},
@@ -161,3 +197,50 @@ func Test_eventWatcher(t *testing.T) {
 		})
 	}
 }
+
+func Test_getSyncTimerDuration(t *testing.T) {
+	tests := []struct {
+		name      string
+		syncDelay time.Duration
+		elapsed   time.Duration
+		want      time.Duration
+	}{
+		{
+			name:      "first event of the batch",
+			syncDelay: time.Second,
+			elapsed:   0,
+			want:      time.Second,
+		},
+		{
+			name:      "deadline of the batch approaching",
+			syncDelay: time.Second,
+			elapsed:   defaultMaxSyncBatchDuration - 300*time.Millisecond,
+			want:      300 * time.Millisecond,
+		},
+		{
+			name:      "deadline of the batch exceeded",
+			syncDelay: time.Second,
+			elapsed:   defaultMaxSyncBatchDuration + time.Second,
+			want:      0,
+		},
+		{
+			name:      "sync delay longer than the default deadline",
+			syncDelay: 10 * time.Second,
+			elapsed:   0,
+			want:      10 * time.Second,
+		},
+		{
+			name:      "deadline of the batch approaching, with a sync delay longer than the default deadline",
+			syncDelay: 10 * time.Second,
+			elapsed:   17 * time.Second,
+			want:      3 * time.Second,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getSyncTimerDuration(tt.syncDelay, tt.elapsed); got != tt.want {
+				t.Errorf("getSyncTimerDuration() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}